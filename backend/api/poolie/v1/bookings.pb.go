@@ -0,0 +1,821 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: poolie/v1/bookings.proto
+
+package pooliev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateBookingRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RideId          string                 `protobuf:"bytes,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	PassengerCount  int32                  `protobuf:"varint,2,opt,name=passenger_count,json=passengerCount,proto3" json:"passenger_count,omitempty"`
+	Message         string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	PromoCode       string                 `protobuf:"bytes,4,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	DisplayCurrency string                 `protobuf:"bytes,5,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateBookingRequest) Reset() {
+	*x = CreateBookingRequest{}
+	mi := &file_poolie_v1_bookings_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingRequest) ProtoMessage() {}
+
+func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_bookings_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_bookings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateBookingRequest) GetRideId() string {
+	if x != nil {
+		return x.RideId
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetPassengerCount() int32 {
+	if x != nil {
+		return x.PassengerCount
+	}
+	return 0
+}
+
+func (x *CreateBookingRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+type GetBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingRequest) Reset() {
+	*x = GetBookingRequest{}
+	mi := &file_poolie_v1_bookings_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingRequest) ProtoMessage() {}
+
+func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_bookings_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingRequest) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_bookings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type RespondToBookingRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BookingId string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// accept or reject
+	Action        string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToBookingRequest) Reset() {
+	*x = RespondToBookingRequest{}
+	mi := &file_poolie_v1_bookings_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToBookingRequest) ProtoMessage() {}
+
+func (x *RespondToBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_bookings_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToBookingRequest.ProtoReflect.Descriptor instead.
+func (*RespondToBookingRequest) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_bookings_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RespondToBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *RespondToBookingRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RespondToBookingRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_poolie_v1_bookings_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_bookings_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_bookings_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CancelBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CancelBookingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type WatchBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchBookingRequest) Reset() {
+	*x = WatchBookingRequest{}
+	mi := &file_poolie_v1_bookings_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBookingRequest) ProtoMessage() {}
+
+func (x *WatchBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_bookings_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBookingRequest.ProtoReflect.Descriptor instead.
+func (*WatchBookingRequest) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_bookings_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type Booking struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BookingId      string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	RideId         string                 `protobuf:"bytes,2,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	PassengerCount int32                  `protobuf:"varint,4,opt,name=passenger_count,json=passengerCount,proto3" json:"passenger_count,omitempty"`
+	TotalPrice     *Price                 `protobuf:"bytes,5,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	DisplayPrice   *DisplayPrice          `protobuf:"bytes,6,opt,name=display_price,json=displayPrice,proto3" json:"display_price,omitempty"`
+	PriceBreakdown *PriceBreakdown        `protobuf:"bytes,7,opt,name=price_breakdown,json=priceBreakdown,proto3" json:"price_breakdown,omitempty"`
+	PaymentStatus  string                 `protobuf:"bytes,8,opt,name=payment_status,json=paymentStatus,proto3" json:"payment_status,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RespondedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	RideDetails    *RideSummary           `protobuf:"bytes,11,opt,name=ride_details,json=rideDetails,proto3" json:"ride_details,omitempty"`
+	Cancellation   *BookingCancellation   `protobuf:"bytes,12,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	mi := &file_poolie_v1_bookings_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Booking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Booking) ProtoMessage() {}
+
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_bookings_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_bookings_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Booking) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *Booking) GetRideId() string {
+	if x != nil {
+		return x.RideId
+	}
+	return ""
+}
+
+func (x *Booking) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Booking) GetPassengerCount() int32 {
+	if x != nil {
+		return x.PassengerCount
+	}
+	return 0
+}
+
+func (x *Booking) GetTotalPrice() *Price {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+func (x *Booking) GetDisplayPrice() *DisplayPrice {
+	if x != nil {
+		return x.DisplayPrice
+	}
+	return nil
+}
+
+func (x *Booking) GetPriceBreakdown() *PriceBreakdown {
+	if x != nil {
+		return x.PriceBreakdown
+	}
+	return nil
+}
+
+func (x *Booking) GetPaymentStatus() string {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return ""
+}
+
+func (x *Booking) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Booking) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+func (x *Booking) GetRideDetails() *RideSummary {
+	if x != nil {
+		return x.RideDetails
+	}
+	return nil
+}
+
+func (x *Booking) GetCancellation() *BookingCancellation {
+	if x != nil {
+		return x.Cancellation
+	}
+	return nil
+}
+
+// PriceBreakdown is how a booking's total price was computed
+type PriceBreakdown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subtotal      *Price                 `protobuf:"bytes,1,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	PromoCode     string                 `protobuf:"bytes,2,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	Discount      *Price                 `protobuf:"bytes,3,opt,name=discount,proto3" json:"discount,omitempty"`
+	Credits       *Price                 `protobuf:"bytes,4,opt,name=credits,proto3" json:"credits,omitempty"`
+	Total         *Price                 `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceBreakdown) Reset() {
+	*x = PriceBreakdown{}
+	mi := &file_poolie_v1_bookings_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBreakdown) ProtoMessage() {}
+
+func (x *PriceBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_bookings_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBreakdown.ProtoReflect.Descriptor instead.
+func (*PriceBreakdown) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_bookings_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PriceBreakdown) GetSubtotal() *Price {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *PriceBreakdown) GetDiscount() *Price {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetCredits() *Price {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetTotal() *Price {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type RideSummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RideId          string                 `protobuf:"bytes,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	DepartureTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	ArrivalTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	OriginCity      string                 `protobuf:"bytes,4,opt,name=origin_city,json=originCity,proto3" json:"origin_city,omitempty"`
+	DestinationCity string                 `protobuf:"bytes,5,opt,name=destination_city,json=destinationCity,proto3" json:"destination_city,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RideSummary) Reset() {
+	*x = RideSummary{}
+	mi := &file_poolie_v1_bookings_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RideSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RideSummary) ProtoMessage() {}
+
+func (x *RideSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_bookings_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RideSummary.ProtoReflect.Descriptor instead.
+func (*RideSummary) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_bookings_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RideSummary) GetRideId() string {
+	if x != nil {
+		return x.RideId
+	}
+	return ""
+}
+
+func (x *RideSummary) GetDepartureTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return nil
+}
+
+func (x *RideSummary) GetArrivalTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return nil
+}
+
+func (x *RideSummary) GetOriginCity() string {
+	if x != nil {
+		return x.OriginCity
+	}
+	return ""
+}
+
+func (x *RideSummary) GetDestinationCity() string {
+	if x != nil {
+		return x.DestinationCity
+	}
+	return ""
+}
+
+// BookingCancellation is the outcome of a cancelled booking
+type BookingCancellation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// passenger or driver
+	CancelledBy   string                 `protobuf:"bytes,1,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	CancelledAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Refund        *Price                 `protobuf:"bytes,4,opt,name=refund,proto3" json:"refund,omitempty"`
+	Penalty       *Price                 `protobuf:"bytes,5,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingCancellation) Reset() {
+	*x = BookingCancellation{}
+	mi := &file_poolie_v1_bookings_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingCancellation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingCancellation) ProtoMessage() {}
+
+func (x *BookingCancellation) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_bookings_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingCancellation.ProtoReflect.Descriptor instead.
+func (*BookingCancellation) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_bookings_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BookingCancellation) GetCancelledBy() string {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return ""
+}
+
+func (x *BookingCancellation) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *BookingCancellation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BookingCancellation) GetRefund() *Price {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *BookingCancellation) GetPenalty() *Price {
+	if x != nil {
+		return x.Penalty
+	}
+	return nil
+}
+
+var File_poolie_v1_bookings_proto protoreflect.FileDescriptor
+
+const file_poolie_v1_bookings_proto_rawDesc = "" +
+	"\n" +
+	"\x18poolie/v1/bookings.proto\x12\tpoolie.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16poolie/v1/common.proto\"\xbc\x01\n" +
+	"\x14CreateBookingRequest\x12\x17\n" +
+	"\aride_id\x18\x01 \x01(\tR\x06rideId\x12'\n" +
+	"\x0fpassenger_count\x18\x02 \x01(\x05R\x0epassengerCount\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\x04 \x01(\tR\tpromoCode\x12)\n" +
+	"\x10display_currency\x18\x05 \x01(\tR\x0fdisplayCurrency\"2\n" +
+	"\x11GetBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\"j\n" +
+	"\x17RespondToBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"M\n" +
+	"\x14CancelBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"4\n" +
+	"\x13WatchBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\"\xd7\x04\n" +
+	"\aBooking\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x17\n" +
+	"\aride_id\x18\x02 \x01(\tR\x06rideId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12'\n" +
+	"\x0fpassenger_count\x18\x04 \x01(\x05R\x0epassengerCount\x121\n" +
+	"\vtotal_price\x18\x05 \x01(\v2\x10.poolie.v1.PriceR\n" +
+	"totalPrice\x12<\n" +
+	"\rdisplay_price\x18\x06 \x01(\v2\x17.poolie.v1.DisplayPriceR\fdisplayPrice\x12B\n" +
+	"\x0fprice_breakdown\x18\a \x01(\v2\x19.poolie.v1.PriceBreakdownR\x0epriceBreakdown\x12%\n" +
+	"\x0epayment_status\x18\b \x01(\tR\rpaymentStatus\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fresponded_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vrespondedAt\x129\n" +
+	"\fride_details\x18\v \x01(\v2\x16.poolie.v1.RideSummaryR\vrideDetails\x12B\n" +
+	"\fcancellation\x18\f \x01(\v2\x1e.poolie.v1.BookingCancellationR\fcancellation\"\xdf\x01\n" +
+	"\x0ePriceBreakdown\x12,\n" +
+	"\bsubtotal\x18\x01 \x01(\v2\x10.poolie.v1.PriceR\bsubtotal\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\x02 \x01(\tR\tpromoCode\x12,\n" +
+	"\bdiscount\x18\x03 \x01(\v2\x10.poolie.v1.PriceR\bdiscount\x12*\n" +
+	"\acredits\x18\x04 \x01(\v2\x10.poolie.v1.PriceR\acredits\x12&\n" +
+	"\x05total\x18\x05 \x01(\v2\x10.poolie.v1.PriceR\x05total\"\xf4\x01\n" +
+	"\vRideSummary\x12\x17\n" +
+	"\aride_id\x18\x01 \x01(\tR\x06rideId\x12A\n" +
+	"\x0edeparture_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rdepartureTime\x12=\n" +
+	"\farrival_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\varrivalTime\x12\x1f\n" +
+	"\vorigin_city\x18\x04 \x01(\tR\n" +
+	"originCity\x12)\n" +
+	"\x10destination_city\x18\x05 \x01(\tR\x0fdestinationCity\"\xe5\x01\n" +
+	"\x13BookingCancellation\x12!\n" +
+	"\fcancelled_by\x18\x01 \x01(\tR\vcancelledBy\x12=\n" +
+	"\fcancelled_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12(\n" +
+	"\x06refund\x18\x04 \x01(\v2\x10.poolie.v1.PriceR\x06refund\x12*\n" +
+	"\apenalty\x18\x05 \x01(\v2\x10.poolie.v1.PriceR\apenalty2\xee\x02\n" +
+	"\x0eBookingService\x12D\n" +
+	"\rCreateBooking\x12\x1f.poolie.v1.CreateBookingRequest\x1a\x12.poolie.v1.Booking\x12>\n" +
+	"\n" +
+	"GetBooking\x12\x1c.poolie.v1.GetBookingRequest\x1a\x12.poolie.v1.Booking\x12J\n" +
+	"\x10RespondToBooking\x12\".poolie.v1.RespondToBookingRequest\x1a\x12.poolie.v1.Booking\x12D\n" +
+	"\rCancelBooking\x12\x1f.poolie.v1.CancelBookingRequest\x1a\x12.poolie.v1.Booking\x12D\n" +
+	"\fWatchBooking\x12\x1e.poolie.v1.WatchBookingRequest\x1a\x12.poolie.v1.Booking0\x01B<Z:github.com/slowtyper/poolie/backend/api/poolie/v1;pooliev1b\x06proto3"
+
+var (
+	file_poolie_v1_bookings_proto_rawDescOnce sync.Once
+	file_poolie_v1_bookings_proto_rawDescData []byte
+)
+
+func file_poolie_v1_bookings_proto_rawDescGZIP() []byte {
+	file_poolie_v1_bookings_proto_rawDescOnce.Do(func() {
+		file_poolie_v1_bookings_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_poolie_v1_bookings_proto_rawDesc), len(file_poolie_v1_bookings_proto_rawDesc)))
+	})
+	return file_poolie_v1_bookings_proto_rawDescData
+}
+
+var file_poolie_v1_bookings_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_poolie_v1_bookings_proto_goTypes = []any{
+	(*CreateBookingRequest)(nil),    // 0: poolie.v1.CreateBookingRequest
+	(*GetBookingRequest)(nil),       // 1: poolie.v1.GetBookingRequest
+	(*RespondToBookingRequest)(nil), // 2: poolie.v1.RespondToBookingRequest
+	(*CancelBookingRequest)(nil),    // 3: poolie.v1.CancelBookingRequest
+	(*WatchBookingRequest)(nil),     // 4: poolie.v1.WatchBookingRequest
+	(*Booking)(nil),                 // 5: poolie.v1.Booking
+	(*PriceBreakdown)(nil),          // 6: poolie.v1.PriceBreakdown
+	(*RideSummary)(nil),             // 7: poolie.v1.RideSummary
+	(*BookingCancellation)(nil),     // 8: poolie.v1.BookingCancellation
+	(*Price)(nil),                   // 9: poolie.v1.Price
+	(*DisplayPrice)(nil),            // 10: poolie.v1.DisplayPrice
+	(*timestamppb.Timestamp)(nil),   // 11: google.protobuf.Timestamp
+}
+var file_poolie_v1_bookings_proto_depIdxs = []int32{
+	9,  // 0: poolie.v1.Booking.total_price:type_name -> poolie.v1.Price
+	10, // 1: poolie.v1.Booking.display_price:type_name -> poolie.v1.DisplayPrice
+	6,  // 2: poolie.v1.Booking.price_breakdown:type_name -> poolie.v1.PriceBreakdown
+	11, // 3: poolie.v1.Booking.created_at:type_name -> google.protobuf.Timestamp
+	11, // 4: poolie.v1.Booking.responded_at:type_name -> google.protobuf.Timestamp
+	7,  // 5: poolie.v1.Booking.ride_details:type_name -> poolie.v1.RideSummary
+	8,  // 6: poolie.v1.Booking.cancellation:type_name -> poolie.v1.BookingCancellation
+	9,  // 7: poolie.v1.PriceBreakdown.subtotal:type_name -> poolie.v1.Price
+	9,  // 8: poolie.v1.PriceBreakdown.discount:type_name -> poolie.v1.Price
+	9,  // 9: poolie.v1.PriceBreakdown.credits:type_name -> poolie.v1.Price
+	9,  // 10: poolie.v1.PriceBreakdown.total:type_name -> poolie.v1.Price
+	11, // 11: poolie.v1.RideSummary.departure_time:type_name -> google.protobuf.Timestamp
+	11, // 12: poolie.v1.RideSummary.arrival_time:type_name -> google.protobuf.Timestamp
+	11, // 13: poolie.v1.BookingCancellation.cancelled_at:type_name -> google.protobuf.Timestamp
+	9,  // 14: poolie.v1.BookingCancellation.refund:type_name -> poolie.v1.Price
+	9,  // 15: poolie.v1.BookingCancellation.penalty:type_name -> poolie.v1.Price
+	0,  // 16: poolie.v1.BookingService.CreateBooking:input_type -> poolie.v1.CreateBookingRequest
+	1,  // 17: poolie.v1.BookingService.GetBooking:input_type -> poolie.v1.GetBookingRequest
+	2,  // 18: poolie.v1.BookingService.RespondToBooking:input_type -> poolie.v1.RespondToBookingRequest
+	3,  // 19: poolie.v1.BookingService.CancelBooking:input_type -> poolie.v1.CancelBookingRequest
+	4,  // 20: poolie.v1.BookingService.WatchBooking:input_type -> poolie.v1.WatchBookingRequest
+	5,  // 21: poolie.v1.BookingService.CreateBooking:output_type -> poolie.v1.Booking
+	5,  // 22: poolie.v1.BookingService.GetBooking:output_type -> poolie.v1.Booking
+	5,  // 23: poolie.v1.BookingService.RespondToBooking:output_type -> poolie.v1.Booking
+	5,  // 24: poolie.v1.BookingService.CancelBooking:output_type -> poolie.v1.Booking
+	5,  // 25: poolie.v1.BookingService.WatchBooking:output_type -> poolie.v1.Booking
+	21, // [21:26] is the sub-list for method output_type
+	16, // [16:21] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_poolie_v1_bookings_proto_init() }
+func file_poolie_v1_bookings_proto_init() {
+	if File_poolie_v1_bookings_proto != nil {
+		return
+	}
+	file_poolie_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_poolie_v1_bookings_proto_rawDesc), len(file_poolie_v1_bookings_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_poolie_v1_bookings_proto_goTypes,
+		DependencyIndexes: file_poolie_v1_bookings_proto_depIdxs,
+		MessageInfos:      file_poolie_v1_bookings_proto_msgTypes,
+	}.Build()
+	File_poolie_v1_bookings_proto = out.File
+	file_poolie_v1_bookings_proto_goTypes = nil
+	file_poolie_v1_bookings_proto_depIdxs = nil
+}
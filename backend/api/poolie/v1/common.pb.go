@@ -0,0 +1,346 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: poolie/v1/common.proto
+
+package pooliev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Location is a place with city, address and an optional point
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	LocationPoint string                 `protobuf:"bytes,3,opt,name=location_point,json=locationPoint,proto3" json:"location_point,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_poolie_v1_common_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_common_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Location) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Location) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Location) GetLocationPoint() string {
+	if x != nil {
+		return x.LocationPoint
+	}
+	return ""
+}
+
+// Price is an amount in the minor units of its currency
+type Price struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	mi := &file_poolie_v1_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Price) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Price) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// DisplayPrice is a price converted to a caller-requested currency
+type DisplayPrice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExchangeRate  string                 `protobuf:"bytes,3,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	RateAsOf      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=rate_as_of,json=rateAsOf,proto3" json:"rate_as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisplayPrice) Reset() {
+	*x = DisplayPrice{}
+	mi := &file_poolie_v1_common_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisplayPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisplayPrice) ProtoMessage() {}
+
+func (x *DisplayPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_common_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisplayPrice.ProtoReflect.Descriptor instead.
+func (*DisplayPrice) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DisplayPrice) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DisplayPrice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DisplayPrice) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *DisplayPrice) GetRateAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RateAsOf
+	}
+	return nil
+}
+
+// Recurrence is the schedule of a recurring ride. Dates are YYYY-MM-DD.
+type Recurrence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DaysOfWeek    []string               `protobuf:"bytes,1,rep,name=days_of_week,json=daysOfWeek,proto3" json:"days_of_week,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_poolie_v1_common_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_common_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_common_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Recurrence) GetDaysOfWeek() []string {
+	if x != nil {
+		return x.DaysOfWeek
+	}
+	return nil
+}
+
+func (x *Recurrence) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Recurrence) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+var File_poolie_v1_common_proto protoreflect.FileDescriptor
+
+const file_poolie_v1_common_proto_rawDesc = "" +
+	"\n" +
+	"\x16poolie/v1/common.proto\x12\tpoolie.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"_\n" +
+	"\bLocation\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12%\n" +
+	"\x0elocation_point\x18\x03 \x01(\tR\rlocationPoint\";\n" +
+	"\x05Price\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xa1\x01\n" +
+	"\fDisplayPrice\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12#\n" +
+	"\rexchange_rate\x18\x03 \x01(\tR\fexchangeRate\x128\n" +
+	"\n" +
+	"rate_as_of\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\brateAsOf\"h\n" +
+	"\n" +
+	"Recurrence\x12 \n" +
+	"\fdays_of_week\x18\x01 \x03(\tR\n" +
+	"daysOfWeek\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDateB<Z:github.com/slowtyper/poolie/backend/api/poolie/v1;pooliev1b\x06proto3"
+
+var (
+	file_poolie_v1_common_proto_rawDescOnce sync.Once
+	file_poolie_v1_common_proto_rawDescData []byte
+)
+
+func file_poolie_v1_common_proto_rawDescGZIP() []byte {
+	file_poolie_v1_common_proto_rawDescOnce.Do(func() {
+		file_poolie_v1_common_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_poolie_v1_common_proto_rawDesc), len(file_poolie_v1_common_proto_rawDesc)))
+	})
+	return file_poolie_v1_common_proto_rawDescData
+}
+
+var file_poolie_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_poolie_v1_common_proto_goTypes = []any{
+	(*Location)(nil),              // 0: poolie.v1.Location
+	(*Price)(nil),                 // 1: poolie.v1.Price
+	(*DisplayPrice)(nil),          // 2: poolie.v1.DisplayPrice
+	(*Recurrence)(nil),            // 3: poolie.v1.Recurrence
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_poolie_v1_common_proto_depIdxs = []int32{
+	4, // 0: poolie.v1.DisplayPrice.rate_as_of:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_poolie_v1_common_proto_init() }
+func file_poolie_v1_common_proto_init() {
+	if File_poolie_v1_common_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_poolie_v1_common_proto_rawDesc), len(file_poolie_v1_common_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_poolie_v1_common_proto_goTypes,
+		DependencyIndexes: file_poolie_v1_common_proto_depIdxs,
+		MessageInfos:      file_poolie_v1_common_proto_msgTypes,
+	}.Build()
+	File_poolie_v1_common_proto = out.File
+	file_poolie_v1_common_proto_goTypes = nil
+	file_poolie_v1_common_proto_depIdxs = nil
+}
@@ -0,0 +1,1294 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: poolie/v1/rides.proto
+
+package pooliev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SearchRidesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Origin      string                 `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// YYYY-MM-DD
+	Date       string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Passengers int32  `protobuf:"varint,4,opt,name=passengers,proto3" json:"passengers,omitempty"`
+	// all, carpool or bus; empty means all
+	Type          string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRidesRequest) Reset() {
+	*x = SearchRidesRequest{}
+	mi := &file_poolie_v1_rides_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRidesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRidesRequest) ProtoMessage() {}
+
+func (x *SearchRidesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_rides_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRidesRequest.ProtoReflect.Descriptor instead.
+func (*SearchRidesRequest) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_rides_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchRidesRequest) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *SearchRidesRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *SearchRidesRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SearchRidesRequest) GetPassengers() int32 {
+	if x != nil {
+		return x.Passengers
+	}
+	return 0
+}
+
+func (x *SearchRidesRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type SearchRidesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalCount    int32                  `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	CarpoolCount  int32                  `protobuf:"varint,2,opt,name=carpool_count,json=carpoolCount,proto3" json:"carpool_count,omitempty"`
+	BusCount      int32                  `protobuf:"varint,3,opt,name=bus_count,json=busCount,proto3" json:"bus_count,omitempty"`
+	Rides         []*RidePreview         `protobuf:"bytes,4,rep,name=rides,proto3" json:"rides,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRidesResponse) Reset() {
+	*x = SearchRidesResponse{}
+	mi := &file_poolie_v1_rides_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRidesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRidesResponse) ProtoMessage() {}
+
+func (x *SearchRidesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_rides_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRidesResponse.ProtoReflect.Descriptor instead.
+func (*SearchRidesResponse) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_rides_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchRidesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchRidesResponse) GetCarpoolCount() int32 {
+	if x != nil {
+		return x.CarpoolCount
+	}
+	return 0
+}
+
+func (x *SearchRidesResponse) GetBusCount() int32 {
+	if x != nil {
+		return x.BusCount
+	}
+	return 0
+}
+
+func (x *SearchRidesResponse) GetRides() []*RidePreview {
+	if x != nil {
+		return x.Rides
+	}
+	return nil
+}
+
+type GetRideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RideId        string                 `protobuf:"bytes,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRideRequest) Reset() {
+	*x = GetRideRequest{}
+	mi := &file_poolie_v1_rides_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRideRequest) ProtoMessage() {}
+
+func (x *GetRideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_rides_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRideRequest.ProtoReflect.Descriptor instead.
+func (*GetRideRequest) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_rides_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetRideRequest) GetRideId() string {
+	if x != nil {
+		return x.RideId
+	}
+	return ""
+}
+
+type CreateRideRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// one_time or recurring; empty means one_time
+	RideType           string                 `protobuf:"bytes,1,opt,name=ride_type,json=rideType,proto3" json:"ride_type,omitempty"`
+	Recurrence         *Recurrence            `protobuf:"bytes,2,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	Origin             *Location              `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination        *Location              `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	DepartureTime      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	ArrivalTime        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	AvailableSeats     int32                  `protobuf:"varint,7,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	PricePerSeat       *Price                 `protobuf:"bytes,8,opt,name=price_per_seat,json=pricePerSeat,proto3" json:"price_per_seat,omitempty"`
+	Amenities          *Amenities             `protobuf:"bytes,9,opt,name=amenities,proto3" json:"amenities,omitempty"`
+	Description        string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	CancellationPolicy string                 `protobuf:"bytes,11,opt,name=cancellation_policy,json=cancellationPolicy,proto3" json:"cancellation_policy,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateRideRequest) Reset() {
+	*x = CreateRideRequest{}
+	mi := &file_poolie_v1_rides_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRideRequest) ProtoMessage() {}
+
+func (x *CreateRideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_rides_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRideRequest.ProtoReflect.Descriptor instead.
+func (*CreateRideRequest) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_rides_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateRideRequest) GetRideType() string {
+	if x != nil {
+		return x.RideType
+	}
+	return ""
+}
+
+func (x *CreateRideRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *CreateRideRequest) GetOrigin() *Location {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *CreateRideRequest) GetDestination() *Location {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *CreateRideRequest) GetDepartureTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return nil
+}
+
+func (x *CreateRideRequest) GetArrivalTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return nil
+}
+
+func (x *CreateRideRequest) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+func (x *CreateRideRequest) GetPricePerSeat() *Price {
+	if x != nil {
+		return x.PricePerSeat
+	}
+	return nil
+}
+
+func (x *CreateRideRequest) GetAmenities() *Amenities {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+func (x *CreateRideRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRideRequest) GetCancellationPolicy() string {
+	if x != nil {
+		return x.CancellationPolicy
+	}
+	return ""
+}
+
+type CompleteRideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RideId        string                 `protobuf:"bytes,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteRideRequest) Reset() {
+	*x = CompleteRideRequest{}
+	mi := &file_poolie_v1_rides_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteRideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteRideRequest) ProtoMessage() {}
+
+func (x *CompleteRideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_rides_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteRideRequest.ProtoReflect.Descriptor instead.
+func (*CompleteRideRequest) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_rides_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CompleteRideRequest) GetRideId() string {
+	if x != nil {
+		return x.RideId
+	}
+	return ""
+}
+
+// RidePreview is a ride in search results
+type RidePreview struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RideId          string                 `protobuf:"bytes,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	RideType        string                 `protobuf:"bytes,3,opt,name=ride_type,json=rideType,proto3" json:"ride_type,omitempty"`
+	Recurrence      *Recurrence            `protobuf:"bytes,4,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	DepartureTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	ArrivalTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	DurationMinutes *int32                 `protobuf:"varint,7,opt,name=duration_minutes,json=durationMinutes,proto3,oneof" json:"duration_minutes,omitempty"`
+	Origin          *Location              `protobuf:"bytes,8,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination     *Location              `protobuf:"bytes,9,opt,name=destination,proto3" json:"destination,omitempty"`
+	Price           *Price                 `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
+	Driver          *Driver                `protobuf:"bytes,11,opt,name=driver,proto3" json:"driver,omitempty"`
+	Amenities       *Amenities             `protobuf:"bytes,12,opt,name=amenities,proto3" json:"amenities,omitempty"`
+	AvailableSeats  int32                  `protobuf:"varint,13,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RidePreview) Reset() {
+	*x = RidePreview{}
+	mi := &file_poolie_v1_rides_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RidePreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RidePreview) ProtoMessage() {}
+
+func (x *RidePreview) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_rides_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RidePreview.ProtoReflect.Descriptor instead.
+func (*RidePreview) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_rides_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RidePreview) GetRideId() string {
+	if x != nil {
+		return x.RideId
+	}
+	return ""
+}
+
+func (x *RidePreview) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RidePreview) GetRideType() string {
+	if x != nil {
+		return x.RideType
+	}
+	return ""
+}
+
+func (x *RidePreview) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *RidePreview) GetDepartureTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return nil
+}
+
+func (x *RidePreview) GetArrivalTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return nil
+}
+
+func (x *RidePreview) GetDurationMinutes() int32 {
+	if x != nil && x.DurationMinutes != nil {
+		return *x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *RidePreview) GetOrigin() *Location {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *RidePreview) GetDestination() *Location {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *RidePreview) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *RidePreview) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+func (x *RidePreview) GetAmenities() *Amenities {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+func (x *RidePreview) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+// Ride is the full description of a ride
+type Ride struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RideId          string                 `protobuf:"bytes,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	RideType        string                 `protobuf:"bytes,3,opt,name=ride_type,json=rideType,proto3" json:"ride_type,omitempty"`
+	Recurrence      *Recurrence            `protobuf:"bytes,4,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	DepartureTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	ArrivalTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+	DurationMinutes *int32                 `protobuf:"varint,7,opt,name=duration_minutes,json=durationMinutes,proto3,oneof" json:"duration_minutes,omitempty"`
+	Origin          *Location              `protobuf:"bytes,8,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination     *Location              `protobuf:"bytes,9,opt,name=destination,proto3" json:"destination,omitempty"`
+	Price           *Price                 `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
+	Driver          *Driver                `protobuf:"bytes,11,opt,name=driver,proto3" json:"driver,omitempty"`
+	Amenities       *Amenities             `protobuf:"bytes,12,opt,name=amenities,proto3" json:"amenities,omitempty"`
+	AvailableSeats  int32                  `protobuf:"varint,13,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	Stops           []*Stop                `protobuf:"bytes,14,rep,name=stops,proto3" json:"stops,omitempty"`
+	Vehicle         *Vehicle               `protobuf:"bytes,15,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	BookingPolicies *BookingPolicies       `protobuf:"bytes,16,opt,name=booking_policies,json=bookingPolicies,proto3" json:"booking_policies,omitempty"`
+	Status          string                 `protobuf:"bytes,17,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Ride) Reset() {
+	*x = Ride{}
+	mi := &file_poolie_v1_rides_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ride) ProtoMessage() {}
+
+func (x *Ride) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_rides_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ride.ProtoReflect.Descriptor instead.
+func (*Ride) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_rides_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Ride) GetRideId() string {
+	if x != nil {
+		return x.RideId
+	}
+	return ""
+}
+
+func (x *Ride) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Ride) GetRideType() string {
+	if x != nil {
+		return x.RideType
+	}
+	return ""
+}
+
+func (x *Ride) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *Ride) GetDepartureTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return nil
+}
+
+func (x *Ride) GetArrivalTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return nil
+}
+
+func (x *Ride) GetDurationMinutes() int32 {
+	if x != nil && x.DurationMinutes != nil {
+		return *x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *Ride) GetOrigin() *Location {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *Ride) GetDestination() *Location {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *Ride) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Ride) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+func (x *Ride) GetAmenities() *Amenities {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+func (x *Ride) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+func (x *Ride) GetStops() []*Stop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *Ride) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+func (x *Ride) GetBookingPolicies() *BookingPolicies {
+	if x != nil {
+		return x.BookingPolicies
+	}
+	return nil
+}
+
+func (x *Ride) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type Driver struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rating            float64                `protobuf:"fixed64,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	RatingCount       int32                  `protobuf:"varint,4,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	ProfilePictureUrl string                 `protobuf:"bytes,5,opt,name=profile_picture_url,json=profilePictureUrl,proto3" json:"profile_picture_url,omitempty"`
+	IsVerified        bool                   `protobuf:"varint,6,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Driver) Reset() {
+	*x = Driver{}
+	mi := &file_poolie_v1_rides_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Driver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Driver) ProtoMessage() {}
+
+func (x *Driver) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_rides_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Driver.ProtoReflect.Descriptor instead.
+func (*Driver) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_rides_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Driver) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Driver) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Driver) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Driver) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *Driver) GetProfilePictureUrl() string {
+	if x != nil {
+		return x.ProfilePictureUrl
+	}
+	return ""
+}
+
+func (x *Driver) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+type Vehicle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Make          string                 `protobuf:"bytes,1,opt,name=make,proto3" json:"make,omitempty"`
+	Model         string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vehicle) Reset() {
+	*x = Vehicle{}
+	mi := &file_poolie_v1_rides_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vehicle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vehicle) ProtoMessage() {}
+
+func (x *Vehicle) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_rides_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vehicle.ProtoReflect.Descriptor instead.
+func (*Vehicle) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_rides_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Vehicle) GetMake() string {
+	if x != nil {
+		return x.Make
+	}
+	return ""
+}
+
+func (x *Vehicle) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Vehicle) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type Amenities struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SmokingAllowed bool                   `protobuf:"varint,1,opt,name=smoking_allowed,json=smokingAllowed,proto3" json:"smoking_allowed,omitempty"`
+	AirConditioner bool                   `protobuf:"varint,2,opt,name=air_conditioner,json=airConditioner,proto3" json:"air_conditioner,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Amenities) Reset() {
+	*x = Amenities{}
+	mi := &file_poolie_v1_rides_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Amenities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Amenities) ProtoMessage() {}
+
+func (x *Amenities) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_rides_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Amenities.ProtoReflect.Descriptor instead.
+func (*Amenities) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_rides_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Amenities) GetSmokingAllowed() bool {
+	if x != nil {
+		return x.SmokingAllowed
+	}
+	return false
+}
+
+func (x *Amenities) GetAirConditioner() bool {
+	if x != nil {
+		return x.AirConditioner
+	}
+	return false
+}
+
+// Stop is an intermediate stop on a ride
+type Stop struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stop) Reset() {
+	*x = Stop{}
+	mi := &file_poolie_v1_rides_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stop) ProtoMessage() {}
+
+func (x *Stop) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_rides_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stop.ProtoReflect.Descriptor instead.
+func (*Stop) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_rides_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Stop) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Stop) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Stop) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type BookingPolicies struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	InstantConfirmation bool                   `protobuf:"varint,1,opt,name=instant_confirmation,json=instantConfirmation,proto3" json:"instant_confirmation,omitempty"`
+	CancellationPolicy  string                 `protobuf:"bytes,2,opt,name=cancellation_policy,json=cancellationPolicy,proto3" json:"cancellation_policy,omitempty"`
+	RefundSchedule      []*RefundTier          `protobuf:"bytes,3,rep,name=refund_schedule,json=refundSchedule,proto3" json:"refund_schedule,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *BookingPolicies) Reset() {
+	*x = BookingPolicies{}
+	mi := &file_poolie_v1_rides_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingPolicies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingPolicies) ProtoMessage() {}
+
+func (x *BookingPolicies) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_rides_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingPolicies.ProtoReflect.Descriptor instead.
+func (*BookingPolicies) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_rides_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BookingPolicies) GetInstantConfirmation() bool {
+	if x != nil {
+		return x.InstantConfirmation
+	}
+	return false
+}
+
+func (x *BookingPolicies) GetCancellationPolicy() string {
+	if x != nil {
+		return x.CancellationPolicy
+	}
+	return ""
+}
+
+func (x *BookingPolicies) GetRefundSchedule() []*RefundTier {
+	if x != nil {
+		return x.RefundSchedule
+	}
+	return nil
+}
+
+// RefundTier is the refund a passenger receives for one seat when cancelling
+// at least hours_before_departure hours before departure
+type RefundTier struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	HoursBeforeDeparture int32                  `protobuf:"varint,1,opt,name=hours_before_departure,json=hoursBeforeDeparture,proto3" json:"hours_before_departure,omitempty"`
+	RefundPercent        int32                  `protobuf:"varint,2,opt,name=refund_percent,json=refundPercent,proto3" json:"refund_percent,omitempty"`
+	RefundAmount         *Price                 `protobuf:"bytes,3,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RefundTier) Reset() {
+	*x = RefundTier{}
+	mi := &file_poolie_v1_rides_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundTier) ProtoMessage() {}
+
+func (x *RefundTier) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_rides_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundTier.ProtoReflect.Descriptor instead.
+func (*RefundTier) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_rides_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RefundTier) GetHoursBeforeDeparture() int32 {
+	if x != nil {
+		return x.HoursBeforeDeparture
+	}
+	return 0
+}
+
+func (x *RefundTier) GetRefundPercent() int32 {
+	if x != nil {
+		return x.RefundPercent
+	}
+	return 0
+}
+
+func (x *RefundTier) GetRefundAmount() *Price {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return nil
+}
+
+var File_poolie_v1_rides_proto protoreflect.FileDescriptor
+
+const file_poolie_v1_rides_proto_rawDesc = "" +
+	"\n" +
+	"\x15poolie/v1/rides.proto\x12\tpoolie.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16poolie/v1/common.proto\"\x96\x01\n" +
+	"\x12SearchRidesRequest\x12\x16\n" +
+	"\x06origin\x18\x01 \x01(\tR\x06origin\x12 \n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x1e\n" +
+	"\n" +
+	"passengers\x18\x04 \x01(\x05R\n" +
+	"passengers\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\"\xa6\x01\n" +
+	"\x13SearchRidesResponse\x12\x1f\n" +
+	"\vtotal_count\x18\x01 \x01(\x05R\n" +
+	"totalCount\x12#\n" +
+	"\rcarpool_count\x18\x02 \x01(\x05R\fcarpoolCount\x12\x1b\n" +
+	"\tbus_count\x18\x03 \x01(\x05R\bbusCount\x12,\n" +
+	"\x05rides\x18\x04 \x03(\v2\x16.poolie.v1.RidePreviewR\x05rides\")\n" +
+	"\x0eGetRideRequest\x12\x17\n" +
+	"\aride_id\x18\x01 \x01(\tR\x06rideId\"\xb5\x04\n" +
+	"\x11CreateRideRequest\x12\x1b\n" +
+	"\tride_type\x18\x01 \x01(\tR\brideType\x125\n" +
+	"\n" +
+	"recurrence\x18\x02 \x01(\v2\x15.poolie.v1.RecurrenceR\n" +
+	"recurrence\x12+\n" +
+	"\x06origin\x18\x03 \x01(\v2\x13.poolie.v1.LocationR\x06origin\x125\n" +
+	"\vdestination\x18\x04 \x01(\v2\x13.poolie.v1.LocationR\vdestination\x12A\n" +
+	"\x0edeparture_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rdepartureTime\x12=\n" +
+	"\farrival_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\varrivalTime\x12'\n" +
+	"\x0favailable_seats\x18\a \x01(\x05R\x0eavailableSeats\x126\n" +
+	"\x0eprice_per_seat\x18\b \x01(\v2\x10.poolie.v1.PriceR\fpricePerSeat\x122\n" +
+	"\tamenities\x18\t \x01(\v2\x14.poolie.v1.AmenitiesR\tamenities\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\x12/\n" +
+	"\x13cancellation_policy\x18\v \x01(\tR\x12cancellationPolicy\".\n" +
+	"\x13CompleteRideRequest\x12\x17\n" +
+	"\aride_id\x18\x01 \x01(\tR\x06rideId\"\xe9\x04\n" +
+	"\vRidePreview\x12\x17\n" +
+	"\aride_id\x18\x01 \x01(\tR\x06rideId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1b\n" +
+	"\tride_type\x18\x03 \x01(\tR\brideType\x125\n" +
+	"\n" +
+	"recurrence\x18\x04 \x01(\v2\x15.poolie.v1.RecurrenceR\n" +
+	"recurrence\x12A\n" +
+	"\x0edeparture_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rdepartureTime\x12=\n" +
+	"\farrival_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\varrivalTime\x12.\n" +
+	"\x10duration_minutes\x18\a \x01(\x05H\x00R\x0fdurationMinutes\x88\x01\x01\x12+\n" +
+	"\x06origin\x18\b \x01(\v2\x13.poolie.v1.LocationR\x06origin\x125\n" +
+	"\vdestination\x18\t \x01(\v2\x13.poolie.v1.LocationR\vdestination\x12&\n" +
+	"\x05price\x18\n" +
+	" \x01(\v2\x10.poolie.v1.PriceR\x05price\x12)\n" +
+	"\x06driver\x18\v \x01(\v2\x11.poolie.v1.DriverR\x06driver\x122\n" +
+	"\tamenities\x18\f \x01(\v2\x14.poolie.v1.AmenitiesR\tamenities\x12'\n" +
+	"\x0favailable_seats\x18\r \x01(\x05R\x0eavailableSeatsB\x13\n" +
+	"\x11_duration_minutes\"\x96\x06\n" +
+	"\x04Ride\x12\x17\n" +
+	"\aride_id\x18\x01 \x01(\tR\x06rideId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1b\n" +
+	"\tride_type\x18\x03 \x01(\tR\brideType\x125\n" +
+	"\n" +
+	"recurrence\x18\x04 \x01(\v2\x15.poolie.v1.RecurrenceR\n" +
+	"recurrence\x12A\n" +
+	"\x0edeparture_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rdepartureTime\x12=\n" +
+	"\farrival_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\varrivalTime\x12.\n" +
+	"\x10duration_minutes\x18\a \x01(\x05H\x00R\x0fdurationMinutes\x88\x01\x01\x12+\n" +
+	"\x06origin\x18\b \x01(\v2\x13.poolie.v1.LocationR\x06origin\x125\n" +
+	"\vdestination\x18\t \x01(\v2\x13.poolie.v1.LocationR\vdestination\x12&\n" +
+	"\x05price\x18\n" +
+	" \x01(\v2\x10.poolie.v1.PriceR\x05price\x12)\n" +
+	"\x06driver\x18\v \x01(\v2\x11.poolie.v1.DriverR\x06driver\x122\n" +
+	"\tamenities\x18\f \x01(\v2\x14.poolie.v1.AmenitiesR\tamenities\x12'\n" +
+	"\x0favailable_seats\x18\r \x01(\x05R\x0eavailableSeats\x12%\n" +
+	"\x05stops\x18\x0e \x03(\v2\x0f.poolie.v1.StopR\x05stops\x12,\n" +
+	"\avehicle\x18\x0f \x01(\v2\x12.poolie.v1.VehicleR\avehicle\x12E\n" +
+	"\x10booking_policies\x18\x10 \x01(\v2\x1a.poolie.v1.BookingPoliciesR\x0fbookingPolicies\x12\x16\n" +
+	"\x06status\x18\x11 \x01(\tR\x06statusB\x13\n" +
+	"\x11_duration_minutes\"\xc1\x01\n" +
+	"\x06Driver\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x01R\x06rating\x12!\n" +
+	"\frating_count\x18\x04 \x01(\x05R\vratingCount\x12.\n" +
+	"\x13profile_picture_url\x18\x05 \x01(\tR\x11profilePictureUrl\x12\x1f\n" +
+	"\vis_verified\x18\x06 \x01(\bR\n" +
+	"isVerified\"I\n" +
+	"\aVehicle\x12\x12\n" +
+	"\x04make\x18\x01 \x01(\tR\x04make\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\"]\n" +
+	"\tAmenities\x12'\n" +
+	"\x0fsmoking_allowed\x18\x01 \x01(\bR\x0esmokingAllowed\x12'\n" +
+	"\x0fair_conditioner\x18\x02 \x01(\bR\x0eairConditioner\"{\n" +
+	"\x04Stop\x12/\n" +
+	"\blocation\x18\x01 \x01(\v2\x13.poolie.v1.LocationR\blocation\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"\xb5\x01\n" +
+	"\x0fBookingPolicies\x121\n" +
+	"\x14instant_confirmation\x18\x01 \x01(\bR\x13instantConfirmation\x12/\n" +
+	"\x13cancellation_policy\x18\x02 \x01(\tR\x12cancellationPolicy\x12>\n" +
+	"\x0frefund_schedule\x18\x03 \x03(\v2\x15.poolie.v1.RefundTierR\x0erefundSchedule\"\xa0\x01\n" +
+	"\n" +
+	"RefundTier\x124\n" +
+	"\x16hours_before_departure\x18\x01 \x01(\x05R\x14hoursBeforeDeparture\x12%\n" +
+	"\x0erefund_percent\x18\x02 \x01(\x05R\rrefundPercent\x125\n" +
+	"\rrefund_amount\x18\x03 \x01(\v2\x10.poolie.v1.PriceR\frefundAmount2\x90\x02\n" +
+	"\vRideService\x12L\n" +
+	"\vSearchRides\x12\x1d.poolie.v1.SearchRidesRequest\x1a\x1e.poolie.v1.SearchRidesResponse\x125\n" +
+	"\aGetRide\x12\x19.poolie.v1.GetRideRequest\x1a\x0f.poolie.v1.Ride\x12;\n" +
+	"\n" +
+	"CreateRide\x12\x1c.poolie.v1.CreateRideRequest\x1a\x0f.poolie.v1.Ride\x12?\n" +
+	"\fCompleteRide\x12\x1e.poolie.v1.CompleteRideRequest\x1a\x0f.poolie.v1.RideB<Z:github.com/slowtyper/poolie/backend/api/poolie/v1;pooliev1b\x06proto3"
+
+var (
+	file_poolie_v1_rides_proto_rawDescOnce sync.Once
+	file_poolie_v1_rides_proto_rawDescData []byte
+)
+
+func file_poolie_v1_rides_proto_rawDescGZIP() []byte {
+	file_poolie_v1_rides_proto_rawDescOnce.Do(func() {
+		file_poolie_v1_rides_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_poolie_v1_rides_proto_rawDesc), len(file_poolie_v1_rides_proto_rawDesc)))
+	})
+	return file_poolie_v1_rides_proto_rawDescData
+}
+
+var file_poolie_v1_rides_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_poolie_v1_rides_proto_goTypes = []any{
+	(*SearchRidesRequest)(nil),    // 0: poolie.v1.SearchRidesRequest
+	(*SearchRidesResponse)(nil),   // 1: poolie.v1.SearchRidesResponse
+	(*GetRideRequest)(nil),        // 2: poolie.v1.GetRideRequest
+	(*CreateRideRequest)(nil),     // 3: poolie.v1.CreateRideRequest
+	(*CompleteRideRequest)(nil),   // 4: poolie.v1.CompleteRideRequest
+	(*RidePreview)(nil),           // 5: poolie.v1.RidePreview
+	(*Ride)(nil),                  // 6: poolie.v1.Ride
+	(*Driver)(nil),                // 7: poolie.v1.Driver
+	(*Vehicle)(nil),               // 8: poolie.v1.Vehicle
+	(*Amenities)(nil),             // 9: poolie.v1.Amenities
+	(*Stop)(nil),                  // 10: poolie.v1.Stop
+	(*BookingPolicies)(nil),       // 11: poolie.v1.BookingPolicies
+	(*RefundTier)(nil),            // 12: poolie.v1.RefundTier
+	(*Recurrence)(nil),            // 13: poolie.v1.Recurrence
+	(*Location)(nil),              // 14: poolie.v1.Location
+	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
+	(*Price)(nil),                 // 16: poolie.v1.Price
+}
+var file_poolie_v1_rides_proto_depIdxs = []int32{
+	5,  // 0: poolie.v1.SearchRidesResponse.rides:type_name -> poolie.v1.RidePreview
+	13, // 1: poolie.v1.CreateRideRequest.recurrence:type_name -> poolie.v1.Recurrence
+	14, // 2: poolie.v1.CreateRideRequest.origin:type_name -> poolie.v1.Location
+	14, // 3: poolie.v1.CreateRideRequest.destination:type_name -> poolie.v1.Location
+	15, // 4: poolie.v1.CreateRideRequest.departure_time:type_name -> google.protobuf.Timestamp
+	15, // 5: poolie.v1.CreateRideRequest.arrival_time:type_name -> google.protobuf.Timestamp
+	16, // 6: poolie.v1.CreateRideRequest.price_per_seat:type_name -> poolie.v1.Price
+	9,  // 7: poolie.v1.CreateRideRequest.amenities:type_name -> poolie.v1.Amenities
+	13, // 8: poolie.v1.RidePreview.recurrence:type_name -> poolie.v1.Recurrence
+	15, // 9: poolie.v1.RidePreview.departure_time:type_name -> google.protobuf.Timestamp
+	15, // 10: poolie.v1.RidePreview.arrival_time:type_name -> google.protobuf.Timestamp
+	14, // 11: poolie.v1.RidePreview.origin:type_name -> poolie.v1.Location
+	14, // 12: poolie.v1.RidePreview.destination:type_name -> poolie.v1.Location
+	16, // 13: poolie.v1.RidePreview.price:type_name -> poolie.v1.Price
+	7,  // 14: poolie.v1.RidePreview.driver:type_name -> poolie.v1.Driver
+	9,  // 15: poolie.v1.RidePreview.amenities:type_name -> poolie.v1.Amenities
+	13, // 16: poolie.v1.Ride.recurrence:type_name -> poolie.v1.Recurrence
+	15, // 17: poolie.v1.Ride.departure_time:type_name -> google.protobuf.Timestamp
+	15, // 18: poolie.v1.Ride.arrival_time:type_name -> google.protobuf.Timestamp
+	14, // 19: poolie.v1.Ride.origin:type_name -> poolie.v1.Location
+	14, // 20: poolie.v1.Ride.destination:type_name -> poolie.v1.Location
+	16, // 21: poolie.v1.Ride.price:type_name -> poolie.v1.Price
+	7,  // 22: poolie.v1.Ride.driver:type_name -> poolie.v1.Driver
+	9,  // 23: poolie.v1.Ride.amenities:type_name -> poolie.v1.Amenities
+	10, // 24: poolie.v1.Ride.stops:type_name -> poolie.v1.Stop
+	8,  // 25: poolie.v1.Ride.vehicle:type_name -> poolie.v1.Vehicle
+	11, // 26: poolie.v1.Ride.booking_policies:type_name -> poolie.v1.BookingPolicies
+	14, // 27: poolie.v1.Stop.location:type_name -> poolie.v1.Location
+	15, // 28: poolie.v1.Stop.time:type_name -> google.protobuf.Timestamp
+	12, // 29: poolie.v1.BookingPolicies.refund_schedule:type_name -> poolie.v1.RefundTier
+	16, // 30: poolie.v1.RefundTier.refund_amount:type_name -> poolie.v1.Price
+	0,  // 31: poolie.v1.RideService.SearchRides:input_type -> poolie.v1.SearchRidesRequest
+	2,  // 32: poolie.v1.RideService.GetRide:input_type -> poolie.v1.GetRideRequest
+	3,  // 33: poolie.v1.RideService.CreateRide:input_type -> poolie.v1.CreateRideRequest
+	4,  // 34: poolie.v1.RideService.CompleteRide:input_type -> poolie.v1.CompleteRideRequest
+	1,  // 35: poolie.v1.RideService.SearchRides:output_type -> poolie.v1.SearchRidesResponse
+	6,  // 36: poolie.v1.RideService.GetRide:output_type -> poolie.v1.Ride
+	6,  // 37: poolie.v1.RideService.CreateRide:output_type -> poolie.v1.Ride
+	6,  // 38: poolie.v1.RideService.CompleteRide:output_type -> poolie.v1.Ride
+	35, // [35:39] is the sub-list for method output_type
+	31, // [31:35] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
+}
+
+func init() { file_poolie_v1_rides_proto_init() }
+func file_poolie_v1_rides_proto_init() {
+	if File_poolie_v1_rides_proto != nil {
+		return
+	}
+	file_poolie_v1_common_proto_init()
+	file_poolie_v1_rides_proto_msgTypes[5].OneofWrappers = []any{}
+	file_poolie_v1_rides_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_poolie_v1_rides_proto_rawDesc), len(file_poolie_v1_rides_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_poolie_v1_rides_proto_goTypes,
+		DependencyIndexes: file_poolie_v1_rides_proto_depIdxs,
+		MessageInfos:      file_poolie_v1_rides_proto_msgTypes,
+	}.Build()
+	File_poolie_v1_rides_proto = out.File
+	file_poolie_v1_rides_proto_goTypes = nil
+	file_poolie_v1_rides_proto_depIdxs = nil
+}
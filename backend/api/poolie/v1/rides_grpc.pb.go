@@ -0,0 +1,241 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: poolie/v1/rides.proto
+
+package pooliev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RideService_SearchRides_FullMethodName  = "/poolie.v1.RideService/SearchRides"
+	RideService_GetRide_FullMethodName      = "/poolie.v1.RideService/GetRide"
+	RideService_CreateRide_FullMethodName   = "/poolie.v1.RideService/CreateRide"
+	RideService_CompleteRide_FullMethodName = "/poolie.v1.RideService/CompleteRide"
+)
+
+// RideServiceClient is the client API for RideService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RideService publishes, finds and completes rides. SearchRides and GetRide
+// may be called without a token.
+type RideServiceClient interface {
+	SearchRides(ctx context.Context, in *SearchRidesRequest, opts ...grpc.CallOption) (*SearchRidesResponse, error)
+	GetRide(ctx context.Context, in *GetRideRequest, opts ...grpc.CallOption) (*Ride, error)
+	CreateRide(ctx context.Context, in *CreateRideRequest, opts ...grpc.CallOption) (*Ride, error)
+	CompleteRide(ctx context.Context, in *CompleteRideRequest, opts ...grpc.CallOption) (*Ride, error)
+}
+
+type rideServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRideServiceClient(cc grpc.ClientConnInterface) RideServiceClient {
+	return &rideServiceClient{cc}
+}
+
+func (c *rideServiceClient) SearchRides(ctx context.Context, in *SearchRidesRequest, opts ...grpc.CallOption) (*SearchRidesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchRidesResponse)
+	err := c.cc.Invoke(ctx, RideService_SearchRides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) GetRide(ctx context.Context, in *GetRideRequest, opts ...grpc.CallOption) (*Ride, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ride)
+	err := c.cc.Invoke(ctx, RideService_GetRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) CreateRide(ctx context.Context, in *CreateRideRequest, opts ...grpc.CallOption) (*Ride, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ride)
+	err := c.cc.Invoke(ctx, RideService_CreateRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) CompleteRide(ctx context.Context, in *CompleteRideRequest, opts ...grpc.CallOption) (*Ride, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ride)
+	err := c.cc.Invoke(ctx, RideService_CompleteRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RideServiceServer is the server API for RideService service.
+// All implementations must embed UnimplementedRideServiceServer
+// for forward compatibility.
+//
+// RideService publishes, finds and completes rides. SearchRides and GetRide
+// may be called without a token.
+type RideServiceServer interface {
+	SearchRides(context.Context, *SearchRidesRequest) (*SearchRidesResponse, error)
+	GetRide(context.Context, *GetRideRequest) (*Ride, error)
+	CreateRide(context.Context, *CreateRideRequest) (*Ride, error)
+	CompleteRide(context.Context, *CompleteRideRequest) (*Ride, error)
+	mustEmbedUnimplementedRideServiceServer()
+}
+
+// UnimplementedRideServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRideServiceServer struct{}
+
+func (UnimplementedRideServiceServer) SearchRides(context.Context, *SearchRidesRequest) (*SearchRidesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchRides not implemented")
+}
+func (UnimplementedRideServiceServer) GetRide(context.Context, *GetRideRequest) (*Ride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRide not implemented")
+}
+func (UnimplementedRideServiceServer) CreateRide(context.Context, *CreateRideRequest) (*Ride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRide not implemented")
+}
+func (UnimplementedRideServiceServer) CompleteRide(context.Context, *CompleteRideRequest) (*Ride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteRide not implemented")
+}
+func (UnimplementedRideServiceServer) mustEmbedUnimplementedRideServiceServer() {}
+func (UnimplementedRideServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeRideServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RideServiceServer will
+// result in compilation errors.
+type UnsafeRideServiceServer interface {
+	mustEmbedUnimplementedRideServiceServer()
+}
+
+func RegisterRideServiceServer(s grpc.ServiceRegistrar, srv RideServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRideServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RideService_ServiceDesc, srv)
+}
+
+func _RideService_SearchRides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRidesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).SearchRides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_SearchRides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).SearchRides(ctx, req.(*SearchRidesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_GetRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).GetRide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_GetRide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).GetRide(ctx, req.(*GetRideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_CreateRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).CreateRide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_CreateRide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).CreateRide(ctx, req.(*CreateRideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_CompleteRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteRideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).CompleteRide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_CompleteRide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).CompleteRide(ctx, req.(*CompleteRideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RideService_ServiceDesc is the grpc.ServiceDesc for RideService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RideService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "poolie.v1.RideService",
+	HandlerType: (*RideServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchRides",
+			Handler:    _RideService_SearchRides_Handler,
+		},
+		{
+			MethodName: "GetRide",
+			Handler:    _RideService_GetRide_Handler,
+		},
+		{
+			MethodName: "CreateRide",
+			Handler:    _RideService_CreateRide_Handler,
+		},
+		{
+			MethodName: "CompleteRide",
+			Handler:    _RideService_CompleteRide_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "poolie/v1/rides.proto",
+}
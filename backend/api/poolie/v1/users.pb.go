@@ -0,0 +1,504 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: poolie/v1/users.proto
+
+package pooliev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetUserProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileRequest) Reset() {
+	*x = GetUserProfileRequest{}
+	mi := &file_poolie_v1_users_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileRequest) ProtoMessage() {}
+
+func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_users_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_users_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetUserProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UserProfile struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Age               *int32                 `protobuf:"varint,3,opt,name=age,proto3,oneof" json:"age,omitempty"`
+	ExperienceLevel   string                 `protobuf:"bytes,4,opt,name=experience_level,json=experienceLevel,proto3" json:"experience_level,omitempty"`
+	Rating            float64                `protobuf:"fixed64,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	RatingCount       int32                  `protobuf:"varint,6,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	DrivingRating     string                 `protobuf:"bytes,7,opt,name=driving_rating,json=drivingRating,proto3" json:"driving_rating,omitempty"`
+	ProfilePictureUrl string                 `protobuf:"bytes,8,opt,name=profile_picture_url,json=profilePictureUrl,proto3" json:"profile_picture_url,omitempty"`
+	Verification      *UserVerification      `protobuf:"bytes,9,opt,name=verification,proto3" json:"verification,omitempty"`
+	Bio               string                 `protobuf:"bytes,10,opt,name=bio,proto3" json:"bio,omitempty"`
+	Preferences       *UserPreferences       `protobuf:"bytes,11,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	MembershipType    string                 `protobuf:"bytes,12,opt,name=membership_type,json=membershipType,proto3" json:"membership_type,omitempty"`
+	Stats             *UserStats             `protobuf:"bytes,13,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_poolie_v1_users_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_users_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_users_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserProfile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserProfile) GetAge() int32 {
+	if x != nil && x.Age != nil {
+		return *x.Age
+	}
+	return 0
+}
+
+func (x *UserProfile) GetExperienceLevel() string {
+	if x != nil {
+		return x.ExperienceLevel
+	}
+	return ""
+}
+
+func (x *UserProfile) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *UserProfile) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *UserProfile) GetDrivingRating() string {
+	if x != nil {
+		return x.DrivingRating
+	}
+	return ""
+}
+
+func (x *UserProfile) GetProfilePictureUrl() string {
+	if x != nil {
+		return x.ProfilePictureUrl
+	}
+	return ""
+}
+
+func (x *UserProfile) GetVerification() *UserVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+func (x *UserProfile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UserProfile) GetPreferences() *UserPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *UserProfile) GetMembershipType() string {
+	if x != nil {
+		return x.MembershipType
+	}
+	return ""
+}
+
+func (x *UserProfile) GetStats() *UserStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type UserVerification struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IsVerified     bool                   `protobuf:"varint,1,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	VerifiedId     bool                   `protobuf:"varint,2,opt,name=verified_id,json=verifiedId,proto3" json:"verified_id,omitempty"`
+	ConfirmedEmail bool                   `protobuf:"varint,3,opt,name=confirmed_email,json=confirmedEmail,proto3" json:"confirmed_email,omitempty"`
+	ConfirmedPhone bool                   `protobuf:"varint,4,opt,name=confirmed_phone,json=confirmedPhone,proto3" json:"confirmed_phone,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserVerification) Reset() {
+	*x = UserVerification{}
+	mi := &file_poolie_v1_users_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserVerification) ProtoMessage() {}
+
+func (x *UserVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_users_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserVerification.ProtoReflect.Descriptor instead.
+func (*UserVerification) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_users_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UserVerification) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *UserVerification) GetVerifiedId() bool {
+	if x != nil {
+		return x.VerifiedId
+	}
+	return false
+}
+
+func (x *UserVerification) GetConfirmedEmail() bool {
+	if x != nil {
+		return x.ConfirmedEmail
+	}
+	return false
+}
+
+func (x *UserVerification) GetConfirmedPhone() bool {
+	if x != nil {
+		return x.ConfirmedPhone
+	}
+	return false
+}
+
+type UserPreferences struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chattiness    string                 `protobuf:"bytes,1,opt,name=chattiness,proto3" json:"chattiness,omitempty"`
+	Music         string                 `protobuf:"bytes,2,opt,name=music,proto3" json:"music,omitempty"`
+	Smoking       bool                   `protobuf:"varint,3,opt,name=smoking,proto3" json:"smoking,omitempty"`
+	Pets          bool                   `protobuf:"varint,4,opt,name=pets,proto3" json:"pets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPreferences) Reset() {
+	*x = UserPreferences{}
+	mi := &file_poolie_v1_users_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPreferences) ProtoMessage() {}
+
+func (x *UserPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_users_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPreferences.ProtoReflect.Descriptor instead.
+func (*UserPreferences) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_users_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UserPreferences) GetChattiness() string {
+	if x != nil {
+		return x.Chattiness
+	}
+	return ""
+}
+
+func (x *UserPreferences) GetMusic() string {
+	if x != nil {
+		return x.Music
+	}
+	return ""
+}
+
+func (x *UserPreferences) GetSmoking() bool {
+	if x != nil {
+		return x.Smoking
+	}
+	return false
+}
+
+func (x *UserPreferences) GetPets() bool {
+	if x != nil {
+		return x.Pets
+	}
+	return false
+}
+
+type UserStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PublishedRides int32                  `protobuf:"varint,1,opt,name=published_rides,json=publishedRides,proto3" json:"published_rides,omitempty"`
+	CompletedRides int32                  `protobuf:"varint,2,opt,name=completed_rides,json=completedRides,proto3" json:"completed_rides,omitempty"`
+	NeverCancels   bool                   `protobuf:"varint,3,opt,name=never_cancels,json=neverCancels,proto3" json:"never_cancels,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserStats) Reset() {
+	*x = UserStats{}
+	mi := &file_poolie_v1_users_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStats) ProtoMessage() {}
+
+func (x *UserStats) ProtoReflect() protoreflect.Message {
+	mi := &file_poolie_v1_users_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStats.ProtoReflect.Descriptor instead.
+func (*UserStats) Descriptor() ([]byte, []int) {
+	return file_poolie_v1_users_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UserStats) GetPublishedRides() int32 {
+	if x != nil {
+		return x.PublishedRides
+	}
+	return 0
+}
+
+func (x *UserStats) GetCompletedRides() int32 {
+	if x != nil {
+		return x.CompletedRides
+	}
+	return 0
+}
+
+func (x *UserStats) GetNeverCancels() bool {
+	if x != nil {
+		return x.NeverCancels
+	}
+	return false
+}
+
+var File_poolie_v1_users_proto protoreflect.FileDescriptor
+
+const file_poolie_v1_users_proto_rawDesc = "" +
+	"\n" +
+	"\x15poolie/v1/users.proto\x12\tpoolie.v1\"0\n" +
+	"\x15GetUserProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xfc\x03\n" +
+	"\vUserProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x15\n" +
+	"\x03age\x18\x03 \x01(\x05H\x00R\x03age\x88\x01\x01\x12)\n" +
+	"\x10experience_level\x18\x04 \x01(\tR\x0fexperienceLevel\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x01R\x06rating\x12!\n" +
+	"\frating_count\x18\x06 \x01(\x05R\vratingCount\x12%\n" +
+	"\x0edriving_rating\x18\a \x01(\tR\rdrivingRating\x12.\n" +
+	"\x13profile_picture_url\x18\b \x01(\tR\x11profilePictureUrl\x12?\n" +
+	"\fverification\x18\t \x01(\v2\x1b.poolie.v1.UserVerificationR\fverification\x12\x10\n" +
+	"\x03bio\x18\n" +
+	" \x01(\tR\x03bio\x12<\n" +
+	"\vpreferences\x18\v \x01(\v2\x1a.poolie.v1.UserPreferencesR\vpreferences\x12'\n" +
+	"\x0fmembership_type\x18\f \x01(\tR\x0emembershipType\x12*\n" +
+	"\x05stats\x18\r \x01(\v2\x14.poolie.v1.UserStatsR\x05statsB\x06\n" +
+	"\x04_age\"\xa6\x01\n" +
+	"\x10UserVerification\x12\x1f\n" +
+	"\vis_verified\x18\x01 \x01(\bR\n" +
+	"isVerified\x12\x1f\n" +
+	"\vverified_id\x18\x02 \x01(\bR\n" +
+	"verifiedId\x12'\n" +
+	"\x0fconfirmed_email\x18\x03 \x01(\bR\x0econfirmedEmail\x12'\n" +
+	"\x0fconfirmed_phone\x18\x04 \x01(\bR\x0econfirmedPhone\"u\n" +
+	"\x0fUserPreferences\x12\x1e\n" +
+	"\n" +
+	"chattiness\x18\x01 \x01(\tR\n" +
+	"chattiness\x12\x14\n" +
+	"\x05music\x18\x02 \x01(\tR\x05music\x12\x18\n" +
+	"\asmoking\x18\x03 \x01(\bR\asmoking\x12\x12\n" +
+	"\x04pets\x18\x04 \x01(\bR\x04pets\"\x82\x01\n" +
+	"\tUserStats\x12'\n" +
+	"\x0fpublished_rides\x18\x01 \x01(\x05R\x0epublishedRides\x12'\n" +
+	"\x0fcompleted_rides\x18\x02 \x01(\x05R\x0ecompletedRides\x12#\n" +
+	"\rnever_cancels\x18\x03 \x01(\bR\fneverCancels2Y\n" +
+	"\vUserService\x12J\n" +
+	"\x0eGetUserProfile\x12 .poolie.v1.GetUserProfileRequest\x1a\x16.poolie.v1.UserProfileB<Z:github.com/slowtyper/poolie/backend/api/poolie/v1;pooliev1b\x06proto3"
+
+var (
+	file_poolie_v1_users_proto_rawDescOnce sync.Once
+	file_poolie_v1_users_proto_rawDescData []byte
+)
+
+func file_poolie_v1_users_proto_rawDescGZIP() []byte {
+	file_poolie_v1_users_proto_rawDescOnce.Do(func() {
+		file_poolie_v1_users_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_poolie_v1_users_proto_rawDesc), len(file_poolie_v1_users_proto_rawDesc)))
+	})
+	return file_poolie_v1_users_proto_rawDescData
+}
+
+var file_poolie_v1_users_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_poolie_v1_users_proto_goTypes = []any{
+	(*GetUserProfileRequest)(nil), // 0: poolie.v1.GetUserProfileRequest
+	(*UserProfile)(nil),           // 1: poolie.v1.UserProfile
+	(*UserVerification)(nil),      // 2: poolie.v1.UserVerification
+	(*UserPreferences)(nil),       // 3: poolie.v1.UserPreferences
+	(*UserStats)(nil),             // 4: poolie.v1.UserStats
+}
+var file_poolie_v1_users_proto_depIdxs = []int32{
+	2, // 0: poolie.v1.UserProfile.verification:type_name -> poolie.v1.UserVerification
+	3, // 1: poolie.v1.UserProfile.preferences:type_name -> poolie.v1.UserPreferences
+	4, // 2: poolie.v1.UserProfile.stats:type_name -> poolie.v1.UserStats
+	0, // 3: poolie.v1.UserService.GetUserProfile:input_type -> poolie.v1.GetUserProfileRequest
+	1, // 4: poolie.v1.UserService.GetUserProfile:output_type -> poolie.v1.UserProfile
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_poolie_v1_users_proto_init() }
+func file_poolie_v1_users_proto_init() {
+	if File_poolie_v1_users_proto != nil {
+		return
+	}
+	file_poolie_v1_users_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_poolie_v1_users_proto_rawDesc), len(file_poolie_v1_users_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_poolie_v1_users_proto_goTypes,
+		DependencyIndexes: file_poolie_v1_users_proto_depIdxs,
+		MessageInfos:      file_poolie_v1_users_proto_msgTypes,
+	}.Build()
+	File_poolie_v1_users_proto = out.File
+	file_poolie_v1_users_proto_goTypes = nil
+	file_poolie_v1_users_proto_depIdxs = nil
+}
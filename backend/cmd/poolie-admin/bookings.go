@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+)
+
+// setBookingStatus moves a booking to a new status on behalf of its driver
+// or passenger. Transitions go through the bookings service, so the status
+// rules and the seat, payment and promotion side effects match the API;
+// only the ownership checks are skipped.
+func setBookingStatus(ctx context.Context, a *admin, args []string) error {
+	flags := flag.NewFlagSet("booking-status", flag.ContinueOnError)
+	by := flags.String("by", "", "party cancelling the booking: passenger or driver")
+	message := flags.String("message", "", "driver response message when confirming or rejecting")
+	reason := flags.String("reason", "", "cancellation reason")
+	if err := flags.Parse(args); err != nil || flags.NArg() != 2 {
+		return errUsage
+	}
+	bookingID, status := flags.Arg(0), flags.Arg(1)
+
+	b, err := a.db.Booking.Query().
+		Where(booking.IDEQ(bookingID)).
+		WithRide().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case bookings.StatusConfirmed, bookings.StatusRejected:
+		err = a.bookings.Respond(ctx, b, status == bookings.StatusConfirmed, *message)
+	case bookings.StatusCancelled:
+		if *by != bookings.CancelledByPassenger && *by != bookings.CancelledByDriver {
+			return fmt.Errorf("--by must be %q or %q when cancelling", bookings.CancelledByPassenger, bookings.CancelledByDriver)
+		}
+		err = a.bookings.Cancel(ctx, b, *by, *reason)
+	default:
+		if err := bookings.CheckTransition(b.Status, status); err != nil {
+			return err
+		}
+		return fmt.Errorf("bookings are moved to %s automatically and cannot be set by hand", status)
+	}
+	if err != nil {
+		return err
+	}
+
+	return lookupBooking(ctx, a, []string{bookingID})
+}
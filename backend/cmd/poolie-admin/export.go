@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	entmigrate "github.com/slowtyper/poolie/backend/ent/migrate"
+)
+
+// sensitiveColumns are never exported
+var sensitiveColumns = map[string]bool{
+	"users.password_hash": true,
+}
+
+// export writes every row of a table to stdout or a file as CSV or JSON.
+// Only tables defined by the ent schema can be exported.
+func export(ctx context.Context, a *admin, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := flags.String("format", "csv", "output format: csv or json")
+	out := flags.String("out", "", "file to write to instead of stdout")
+	if err := flags.Parse(args); err != nil || flags.NArg() != 1 {
+		return errUsage
+	}
+	if *format != "csv" && *format != "json" {
+		return fmt.Errorf("unsupported format %q", *format)
+	}
+
+	table := flags.Arg(0)
+	var columns []string
+	for _, t := range entmigrate.Tables {
+		if t.Name != table {
+			continue
+		}
+		for _, c := range t.Columns {
+			if !sensitiveColumns[table+"."+c.Name] {
+				columns = append(columns, c.Name)
+			}
+		}
+	}
+	if columns == nil {
+		return fmt.Errorf("unknown table %q", table)
+	}
+
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = strconv.Quote(c)
+	}
+	rows, err := a.sqlDB.QueryContext(ctx, fmt.Sprintf(
+		"SELECT %s FROM %s ORDER BY 1", strings.Join(quoted, ", "), strconv.Quote(table),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to query %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	values := make([]any, len(columns))
+	ptrs := make([]any, len(columns))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+
+	var records []map[string]any
+	var csvw *csv.Writer
+	if *format == "csv" {
+		csvw = csv.NewWriter(w)
+		if err := csvw.Write(columns); err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	for rows.Next() {
+		if err := rows.Scan(ptrs...); err != nil {
+			return fmt.Errorf("failed to read %s: %w", table, err)
+		}
+		count++
+
+		if csvw != nil {
+			record := make([]string, len(values))
+			for i, v := range values {
+				record[i] = csvValue(v)
+			}
+			if err := csvw.Write(record); err != nil {
+				return err
+			}
+			continue
+		}
+
+		record := make(map[string]any, len(columns))
+		for i, c := range columns {
+			record[c] = jsonValue(values[i])
+		}
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read %s: %w", table, err)
+	}
+
+	if csvw != nil {
+		csvw.Flush()
+		if err := csvw.Error(); err != nil {
+			return err
+		}
+	} else {
+		if records == nil {
+			records = []map[string]any{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(records); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Exported %d rows from %s\n", count, table)
+	return nil
+}
+
+// csvValue formats a scanned column value for a CSV cell
+func csvValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// jsonValue converts a scanned column value for JSON encoding. JSON
+// columns are embedded as-is rather than as strings.
+func jsonValue(v any) any {
+	b, ok := v.([]byte)
+	if !ok {
+		return v
+	}
+	if json.Valid(b) && len(b) > 0 && (b[0] == '{' || b[0] == '[') {
+		return json.RawMessage(b)
+	}
+	return string(b)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/ride"
+	"github.com/slowtyper/poolie/backend/ent/user"
+)
+
+// lookupUser prints a user and their vehicles, by ID or email
+func lookupUser(ctx context.Context, a *admin, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	where := user.IDEQ(args[0])
+	if strings.Contains(args[0], "@") {
+		where = user.EmailEQ(args[0])
+	}
+
+	u, err := a.db.User.Query().
+		Where(where).
+		WithVehicles().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(u)
+}
+
+// lookupRide prints a ride with its driver, vehicle and bookings
+func lookupRide(ctx context.Context, a *admin, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	r, err := a.db.Ride.Query().
+		Where(ride.IDEQ(args[0])).
+		WithDriver().
+		WithVehicle().
+		WithBookings().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(r)
+}
+
+// lookupBooking prints a booking with its ride, passenger and payment
+func lookupBooking(ctx context.Context, a *admin, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	b, err := a.db.Booking.Query().
+		Where(booking.IDEQ(args[0])).
+		WithRide().
+		WithPassenger().
+		WithPayment().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	return printJSON(b)
+}
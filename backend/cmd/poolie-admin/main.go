@@ -0,0 +1,137 @@
+// Command poolie-admin runs operational tasks against the Poolie database
+// with the same configuration, ent client and business rules as the API.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/internal/auth"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+	"github.com/slowtyper/poolie/backend/internal/config"
+	"github.com/slowtyper/poolie/backend/internal/currency"
+	"github.com/slowtyper/poolie/backend/internal/db"
+	"github.com/slowtyper/poolie/backend/internal/ledger"
+	"github.com/slowtyper/poolie/backend/internal/logger"
+	"github.com/slowtyper/poolie/backend/internal/payments"
+	"github.com/slowtyper/poolie/backend/internal/promotions"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+	"go.uber.org/zap"
+)
+
+// errUsage is returned by commands called with the wrong arguments
+var errUsage = errors.New("invalid arguments")
+
+// admin holds the dependencies shared by every command
+type admin struct {
+	db       *ent.Client
+	sqlDB    *sql.DB
+	bookings *bookings.Service
+	tokens   *auth.Tokens
+}
+
+// command is a poolie-admin subcommand
+type command struct {
+	usage string
+	run   func(ctx context.Context, a *admin, args []string) error
+}
+
+var commands = map[string]command{
+	"user":            {"user <id|email>", lookupUser},
+	"ride":            {"ride <id>", lookupRide},
+	"booking":         {"booking <id>", lookupBooking},
+	"booking-status":  {"booking-status [--by passenger|driver] [--message text] [--reason text] <id> confirmed|rejected|cancelled", setBookingStatus},
+	"recompute-stats": {"recompute-stats [user-id...]", recomputeStats},
+	"seed":            {"seed", seed},
+	"export":          {"export [--format csv|json] [--out file] <table>", export},
+	"token":           {"token <user-id>", issueToken},
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	log, err := logger.New(cfg.Server.Environment)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer log.Sync()
+
+	dbClient, sqlDB, err := db.New(&cfg.Database, log)
+	if err != nil {
+		log.Fatal("failed to connect to database", zap.Error(err))
+	}
+	defer db.Close(dbClient, log)
+
+	bookLedger := ledger.New(dbClient, cfg.Ledger.CommissionBps, log)
+	paymentProvider, err := payments.NewProvider(&cfg.Payments)
+	if err != nil {
+		log.Fatal("failed to initialize payment provider", zap.Error(err))
+	}
+	paymentService := payments.NewService(dbClient, paymentProvider, bookLedger, log)
+	promotionService := promotions.NewService(dbClient, &cfg.Promotions, log)
+
+	// No command converts prices, so no exchange rates are loaded
+	rates := currency.NewRates()
+
+	a := &admin{
+		db:       dbClient,
+		sqlDB:    sqlDB,
+		bookings: bookings.NewService(dbClient, paymentService, bookLedger, promotionService, rates, log),
+		tokens:   auth.NewTokens(&cfg.JWT),
+	}
+
+	ctx := requestctx.WithLogger(context.Background(), log)
+	if err := cmd.run(ctx, a, flag.Args()[1:]); err != nil {
+		db.Close(dbClient, log)
+		if errors.Is(err, errUsage) {
+			fmt.Fprintf(os.Stderr, "usage: poolie-admin %s\n", cmd.usage)
+			os.Exit(2)
+		}
+		fmt.Fprintf(os.Stderr, "%s: %v\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("usage: poolie-admin <command> [arguments]\n\ncommands:\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "  %s\n", commands[name].usage)
+	}
+	fmt.Fprint(os.Stderr, b.String())
+}
+
+// printJSON writes v to stdout as indented JSON
+func printJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/ride"
+	"github.com/slowtyper/poolie/backend/ent/user"
+	"github.com/slowtyper/poolie/backend/ent/vehicle"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+)
+
+// Demo users, vehicles and rides created by seed. Departure times are
+// relative to when seed runs so the rides show up in search.
+var (
+	demoUsers = []struct {
+		id, name, email, level, membership, bio string
+		age                                     int
+		rating                                  float64
+		ratingCount                             int
+	}{
+		{"user_demo_driver1", "Dewi Lestari", "dewi.lestari@example.com", "expert", "professional", "Weekly Jakarta–Bandung commuter. Punctual and AC always on.", 38, 4.9, 86},
+		{"user_demo_driver2", "Rizky Pratama", "rizky.pratama@example.com", "intermediate", "non_professional", "Driving home to Surabaya most weekends, happy to share the ride.", 29, 4.6, 14},
+		{"user_demo_passenger1", "Putri Anggraini", "putri.anggraini@example.com", "beginner", "non_professional", "Student in Bandung, travels light.", 22, 5.0, 3},
+		{"user_demo_passenger2", "Agus Santoso", "agus.santoso@example.com", "beginner", "non_professional", "Business trips around Java.", 41, 4.7, 9},
+	}
+
+	demoVehicles = []struct {
+		id, userID, make, model, color, plate string
+		year                                  int
+	}{
+		{"vehicle_demo1", "user_demo_driver1", "Toyota", "Innova Zenix", "White", "B 2024 DWL", 2024},
+		{"vehicle_demo2", "user_demo_driver2", "Mitsubishi", "Xpander", "Grey", "L 1717 RZP", 2021},
+	}
+
+	demoRides = []struct {
+		id, driverID, vehicleID                  string
+		departIn                                 time.Duration
+		minutes                                  int
+		fromCity, fromAddress, toCity, toAddress string
+		price                                    int64
+		seats                                    int
+		policy, description                      string
+	}{
+		{"ride_demo1", "user_demo_driver1", "vehicle_demo1", 24 * time.Hour, 180, "Jakarta", "Jl. M.H. Thamrin No. 1", "Bandung", "Jl. Braga No. 10", 120000, 4, "moderate", "Morning run to Bandung via Cipularang."},
+		{"ride_demo2", "user_demo_driver1", "vehicle_demo1", 72 * time.Hour, 180, "Bandung", "Jl. Braga No. 10", "Jakarta", "Jl. M.H. Thamrin No. 1", 120000, 4, "moderate", "Return trip to Jakarta."},
+		{"ride_demo3", "user_demo_driver2", "vehicle_demo2", 48 * time.Hour, 100, "Surabaya", "Jl. Tunjungan No. 5", "Malang", "Jl. Ijen No. 20", 75000, 3, "flexible", "Relaxed weekend drive, snacks provided."},
+	}
+
+	demoBookings = []struct {
+		id, rideID, passengerID, status, message string
+		passengers                               int
+	}{
+		{"booking_demo1", "ride_demo1", "user_demo_passenger1", bookings.StatusConfirmed, "Could you pick me up near Sarinah?", 1},
+		{"booking_demo2", "ride_demo3", "user_demo_passenger2", bookings.StatusPending, "Travelling with one colleague.", 2},
+	}
+)
+
+// seed creates the demo data set. Rows that already exist are left alone,
+// so running it twice is safe.
+func seed(ctx context.Context, a *admin, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	tx, err := a.db.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	created, err := seedTx(ctx, tx.Client())
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit demo data: %w", err)
+	}
+
+	fmt.Printf("Created %d demo rows\n", created)
+	return nil
+}
+
+func seedTx(ctx context.Context, client *ent.Client) (int, error) {
+	created := 0
+	now := time.Now().Truncate(time.Hour)
+
+	for _, u := range demoUsers {
+		exists, err := client.User.Query().Where(user.IDEQ(u.id)).Exist(ctx)
+		if err != nil {
+			return created, err
+		}
+		if exists {
+			continue
+		}
+		err = client.User.Create().
+			SetID(u.id).
+			SetName(u.name).
+			SetEmail(u.email).
+			SetPasswordHash("").
+			SetAge(u.age).
+			SetExperienceLevel(u.level).
+			SetMembershipType(u.membership).
+			SetBio(u.bio).
+			SetRating(u.rating).
+			SetRatingCount(u.ratingCount).
+			SetIsVerified(true).
+			SetConfirmedEmail(true).
+			Exec(ctx)
+		if err != nil {
+			return created, fmt.Errorf("failed to create user %s: %w", u.id, err)
+		}
+		created++
+	}
+
+	for _, v := range demoVehicles {
+		exists, err := client.Vehicle.Query().Where(vehicle.IDEQ(v.id)).Exist(ctx)
+		if err != nil {
+			return created, err
+		}
+		if exists {
+			continue
+		}
+		err = client.Vehicle.Create().
+			SetID(v.id).
+			SetUserID(v.userID).
+			SetMake(v.make).
+			SetModel(v.model).
+			SetColor(v.color).
+			SetLicensePlate(v.plate).
+			SetYear(v.year).
+			Exec(ctx)
+		if err != nil {
+			return created, fmt.Errorf("failed to create vehicle %s: %w", v.id, err)
+		}
+		created++
+	}
+
+	for _, r := range demoRides {
+		exists, err := client.Ride.Query().Where(ride.IDEQ(r.id)).Exist(ctx)
+		if err != nil {
+			return created, err
+		}
+		if exists {
+			continue
+		}
+
+		// Confirmed demo bookings already hold seats on the ride
+		available := r.seats
+		for _, b := range demoBookings {
+			if b.rideID == r.id && b.status == bookings.StatusConfirmed {
+				available -= b.passengers
+			}
+		}
+
+		departure := now.Add(r.departIn)
+		err = client.Ride.Create().
+			SetID(r.id).
+			SetDriverID(r.driverID).
+			SetVehicleID(r.vehicleID).
+			SetDepartureTime(departure).
+			SetArrivalTime(departure.Add(time.Duration(r.minutes) * time.Minute)).
+			SetDurationMinutes(r.minutes).
+			SetOriginCity(r.fromCity).
+			SetOriginAddress(r.fromAddress).
+			SetDestinationCity(r.toCity).
+			SetDestinationAddress(r.toAddress).
+			SetPriceAmount(r.price).
+			SetPriceCurrency("IDR").
+			SetTotalSeats(r.seats).
+			SetAvailableSeats(available).
+			SetAmenities(map[string]interface{}{"smoking_allowed": false, "air_conditioner": true}).
+			SetCancellationPolicy(r.policy).
+			SetDescription(r.description).
+			Exec(ctx)
+		if err != nil {
+			return created, fmt.Errorf("failed to create ride %s: %w", r.id, err)
+		}
+		if err := client.User.UpdateOneID(r.driverID).AddPublishedRides(1).Exec(ctx); err != nil {
+			return created, err
+		}
+		created++
+	}
+
+	for _, b := range demoBookings {
+		exists, err := client.Booking.Query().Where(booking.IDEQ(b.id)).Exist(ctx)
+		if err != nil {
+			return created, err
+		}
+		if exists {
+			continue
+		}
+
+		r, err := client.Ride.Get(ctx, b.rideID)
+		if err != nil {
+			return created, err
+		}
+		total := r.PriceAmount * int64(b.passengers)
+
+		builder := client.Booking.Create().
+			SetID(b.id).
+			SetRideID(b.rideID).
+			SetPassengerID(b.passengerID).
+			SetStatus(b.status).
+			SetPassengerCount(b.passengers).
+			SetSubtotalAmount(total).
+			SetTotalPriceAmount(total).
+			SetTotalPriceCurrency(r.PriceCurrency).
+			SetDisplayCurrency(r.PriceCurrency).
+			SetMessage(b.message)
+		if b.status == bookings.StatusConfirmed {
+			builder = builder.SetRespondedAt(now)
+		}
+		if err := builder.Exec(ctx); err != nil {
+			return created, fmt.Errorf("failed to create booking %s: %w", b.id, err)
+		}
+		created++
+	}
+
+	return created, nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/ride"
+	"github.com/slowtyper/poolie/backend/ent/user"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+)
+
+// userStats are the counters kept on the user row
+type userStats struct {
+	PublishedRides int
+	CompletedRides int
+	NeverCancels   bool
+}
+
+// recomputeStats rebuilds the ride counters and cancellation flag of the
+// given users, or of every user, from their rides and bookings
+func recomputeStats(ctx context.Context, a *admin, args []string) error {
+	query := a.db.User.Query()
+	if len(args) > 0 {
+		query = query.Where(user.IDIn(args...))
+	}
+	users, err := query.Order(ent.Asc(user.FieldID)).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated := 0
+	for _, u := range users {
+		stats, err := computeStats(ctx, a.db, u.ID)
+		if err != nil {
+			return fmt.Errorf("failed to compute stats for %s: %w", u.ID, err)
+		}
+
+		current := userStats{
+			PublishedRides: u.PublishedRides,
+			CompletedRides: u.CompletedRides,
+			NeverCancels:   u.NeverCancels,
+		}
+		if stats == current {
+			continue
+		}
+
+		_, err = a.db.User.UpdateOne(u).
+			SetPublishedRides(stats.PublishedRides).
+			SetCompletedRides(stats.CompletedRides).
+			SetNeverCancels(stats.NeverCancels).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update %s: %w", u.ID, err)
+		}
+		fmt.Printf("%s: %+v -> %+v\n", u.ID, current, stats)
+		updated++
+	}
+
+	fmt.Printf("Checked %d users, updated %d\n", len(users), updated)
+	return nil
+}
+
+func computeStats(ctx context.Context, client *ent.Client, userID string) (userStats, error) {
+	var stats userStats
+	var err error
+
+	stats.PublishedRides, err = client.Ride.Query().
+		Where(ride.DriverIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		return stats, err
+	}
+
+	driven, err := client.Ride.Query().
+		Where(
+			ride.DriverIDEQ(userID),
+			ride.StatusEQ("completed"),
+		).
+		Count(ctx)
+	if err != nil {
+		return stats, err
+	}
+	travelled, err := client.Booking.Query().
+		Where(
+			booking.PassengerIDEQ(userID),
+			booking.StatusEQ(bookings.StatusCompleted),
+		).
+		Count(ctx)
+	if err != nil {
+		return stats, err
+	}
+	stats.CompletedRides = driven + travelled
+
+	// Only cancelling a confirmed booking counts against the canceller, and
+	// confirmed bookings are the only cancelled ones with a driver response
+	cancelled, err := client.Booking.Query().
+		Where(
+			booking.StatusEQ(bookings.StatusCancelled),
+			booking.RespondedAtNotNil(),
+			booking.Or(
+				booking.And(
+					booking.CancelledByEQ(bookings.CancelledByPassenger),
+					booking.PassengerIDEQ(userID),
+				),
+				booking.And(
+					booking.CancelledByEQ(bookings.CancelledByDriver),
+					booking.HasRideWith(ride.DriverIDEQ(userID)),
+				),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return stats, err
+	}
+	stats.NeverCancels = !cancelled
+
+	return stats, nil
+}
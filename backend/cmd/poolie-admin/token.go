@@ -0,0 +1,25 @@
+package main
+
+import (
+	"context"
+	"fmt"
+)
+
+// issueToken prints a bearer token authenticating an existing user, for
+// calling the API by hand
+func issueToken(ctx context.Context, a *admin, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+
+	u, err := a.db.User.Get(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	token, err := a.tokens.Issue(u.ID)
+	if err != nil {
+		return err
+	}
+	fmt.Println(token)
+	return nil
+}
@@ -0,0 +1,121 @@
+// Command poolie-schema checks that the goose migrations and the ent schemas
+// describe the same database, and drafts the migration that reconciles them.
+//
+//	poolie-schema diff             print the drift, exit 1 if there is any
+//	poolie-schema generate <name>  write the drift as the next goose migration
+//
+// Both commands rebuild a scratch database from the embedded migrations. It
+// is named by --scratch-db and reached with the POOLIE_DATABASE_* settings.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/slowtyper/poolie/backend/internal/config"
+	"github.com/slowtyper/poolie/backend/internal/db"
+	"github.com/slowtyper/poolie/backend/internal/logger"
+	"go.uber.org/zap"
+)
+
+const usage = "usage: poolie-schema [--scratch-db name] [--dir migrations] diff|generate <name>"
+
+var migrationName = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+func main() {
+	scratchDB := flag.String("scratch-db", "poolie_scratch", "database rebuilt from the migrations; its contents are dropped")
+	dir := flag.String("dir", "migrations", "directory new migrations are written to")
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	log, err := logger.New(cfg.Server.Environment)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer log.Sync()
+
+	drifted, err := run(cfg, log, *scratchDB, *dir, flag.Args())
+	if err != nil {
+		log.Fatal("schema check failed", zap.Error(err))
+	}
+	if drifted {
+		os.Exit(1)
+	}
+}
+
+// run executes a command and reports whether drift was found by diff
+func run(cfg *config.Config, log *zap.Logger, scratchDB, dir string, args []string) (bool, error) {
+	if len(args) == 0 {
+		return false, errors.New(usage)
+	}
+	switch {
+	case args[0] == "diff" && len(args) == 1:
+	case args[0] == "generate" && len(args) == 2:
+		if !migrationName.MatchString(args[1]) {
+			return false, fmt.Errorf("migration name %q must be lower_snake_case", args[1])
+		}
+	default:
+		return false, errors.New(usage)
+	}
+
+	if scratchDB == cfg.Database.DBName {
+		return false, fmt.Errorf("scratch database %q is the configured application database", scratchDB)
+	}
+	scratch := cfg.Database
+	scratch.DBName = scratchDB
+	// Drift checks run DDL that can outlast the application's statement limit
+	scratch.StatementTimeout = 0
+
+	dbClient, sqlDB, err := db.New(&scratch, log)
+	if err != nil {
+		return false, err
+	}
+	defer db.Close(dbClient, log)
+
+	drift, err := db.CheckDrift(context.Background(), sqlDB, log)
+	if err != nil {
+		return false, err
+	}
+
+	if args[0] == "diff" {
+		if drift.Empty() {
+			fmt.Println("Migrations and ent schemas are in sync")
+			return false, nil
+		}
+		os.Stdout.Write(drift.Migration())
+		return true, nil
+	}
+
+	if drift.Empty() {
+		fmt.Println("Migrations and ent schemas are in sync; nothing to generate")
+		return false, nil
+	}
+
+	latest, err := db.LatestMigrationVersion()
+	if err != nil {
+		return false, err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%05d_%s.sql", latest+1, args[1]))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return false, fmt.Errorf("failed to create migration: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(drift.Migration()); err != nil {
+		return false, fmt.Errorf("failed to write migration: %w", err)
+	}
+
+	fmt.Printf("Created %s; review it before committing\n", path)
+	return false, nil
+}
@@ -1,24 +1,45 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/gofiber/fiber/v3"
-	"github.com/gofiber/fiber/v3/middleware/cors"
-	"github.com/gofiber/fiber/v3/middleware/recover"
+	"github.com/slowtyper/poolie/backend/internal/auth"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
 	"github.com/slowtyper/poolie/backend/internal/config"
+	"github.com/slowtyper/poolie/backend/internal/currency"
 	"github.com/slowtyper/poolie/backend/internal/db"
+	"github.com/slowtyper/poolie/backend/internal/graph"
+	"github.com/slowtyper/poolie/backend/internal/grpcapi"
 	"github.com/slowtyper/poolie/backend/internal/handlers"
+	"github.com/slowtyper/poolie/backend/internal/idempotency"
+	"github.com/slowtyper/poolie/backend/internal/ledger"
 	"github.com/slowtyper/poolie/backend/internal/logger"
+	"github.com/slowtyper/poolie/backend/internal/metrics"
 	"github.com/slowtyper/poolie/backend/internal/middleware"
+	"github.com/slowtyper/poolie/backend/internal/payments"
+	"github.com/slowtyper/poolie/backend/internal/promotions"
+	"github.com/slowtyper/poolie/backend/internal/ratelimit"
+	"github.com/slowtyper/poolie/backend/internal/rides"
+	"github.com/slowtyper/poolie/backend/internal/server"
+	"github.com/slowtyper/poolie/backend/internal/tracing"
+	"github.com/slowtyper/poolie/backend/internal/users"
 	"go.uber.org/zap"
 )
 
 func main() {
+	migrateOnStart := flag.Bool("migrate-on-start", false, "apply pending database migrations before serving")
+	flag.Parse()
+
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
@@ -33,77 +54,199 @@ func main() {
 		os.Exit(1)
 	}
 	defer log.Sync()
+	zap.ReplaceGlobals(log)
+
+	if flag.Arg(0) == "migrate" {
+		if err := migrate(cfg, log, flag.Args()[1:]); err != nil {
+			log.Fatal("migration failed", zap.Error(err))
+		}
+		return
+	}
 
 	log.Info("starting Poolie API server",
 		zap.String("environment", cfg.Server.Environment),
 		zap.String("port", cfg.Server.Port),
 	)
 
+	// Initialize tracing
+	shutdownTracing, err := tracing.Setup(context.Background(), &cfg.Tracing)
+	if err != nil {
+		log.Fatal("failed to initialize tracing", zap.Error(err))
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			log.Error("failed to flush traces", zap.Error(err))
+		}
+	}()
+
+	// Replicas started together serialize on the migration lock, so only the
+	// first one applies anything
+	if *migrateOnStart {
+		migrator, closeDB, err := openMigrator(&cfg.Database, log)
+		if err != nil {
+			log.Fatal("failed to load migrations", zap.Error(err))
+		}
+		err = migrator.Up(context.Background())
+		closeDB()
+		if err != nil {
+			log.Fatal("failed to run database migrations", zap.Error(err))
+		}
+	}
+
 	// Initialize database
-	dbClient, err := db.New(&cfg.Database, log)
+	dbClient, sqlDB, err := db.New(&cfg.Database, log)
 	if err != nil {
 		log.Fatal("failed to connect to database", zap.Error(err))
 	}
 	defer db.Close(dbClient, log)
 
-	// NOTE: Using Goose for migrations instead of Ent auto-migrations
-	// Run Goose migrations separately with: make migrate-up
-	// or: goose -dir migrations postgres "$DATABASE_URL" up
-	//
-	// Ent auto-migrations are disabled to avoid conflicts with Goose
-	// ctx := context.Background()
-	// if err := db.RunMigrations(ctx, dbClient, log); err != nil {
-	// 	log.Fatal("failed to run database migrations", zap.Error(err))
-	// }
-
 	// Initialize Fiber app
-	app := fiber.New(fiber.Config{
-		ErrorHandler: errorHandler(log),
-		ReadTimeout:  time.Duration(cfg.Server.ReadTimeout) * time.Second,
-		WriteTimeout: time.Duration(cfg.Server.WriteTimeout) * time.Second,
-		AppName:      "Poolie API v1.0.0",
-	})
-
-	// Global middleware
-	app.Use(recover.New())
-	app.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{"*"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		AllowCredentials: false,
-	}))
-	app.Use(middleware.Logger(log))
+	app := server.New(&cfg.Server, log)
+
+	// Initialize ledger and payments
+	bookLedger := ledger.New(dbClient, cfg.Ledger.CommissionBps, log)
+
+	paymentProvider, err := payments.NewProvider(&cfg.Payments)
+	if err != nil {
+		log.Fatal("failed to initialize payment provider", zap.Error(err))
+	}
+	paymentService := payments.NewService(dbClient, paymentProvider, bookLedger, log)
+
+	// Initialize promotions; expired bookings give back their promo code
+	// usage and credits
+	promotionService := promotions.NewService(dbClient, &cfg.Promotions, log)
+	paymentService.OnExpire(promotionService.Release)
+
+	// Load exchange rates used to display prices in other currencies. Rates
+	// stored through the admin API take precedence over the rates file.
+	rates := currency.NewRates()
+	if cfg.Currency.RatesFile != "" {
+		if err := rates.LoadFile(cfg.Currency.RatesFile); err != nil {
+			log.Fatal("failed to load exchange rates", zap.Error(err))
+		}
+	}
+	rateStore := currency.NewStore(dbClient, rates, log)
+	if _, err := rateStore.Load(context.Background()); err != nil {
+		// Retried by the refresh job, e.g. once pending migrations are applied
+		log.Error("failed to load stored exchange rates", zap.Error(err))
+	}
+
+	payoutProvider, err := ledger.NewPayoutProvider(&cfg.Ledger, log)
+	if err != nil {
+		log.Fatal("failed to initialize payout provider", zap.Error(err))
+	}
+	payoutJob := ledger.NewPayoutJob(bookLedger, payoutProvider, cfg.Ledger.PayoutMinimum, log)
+
+	// Background jobs: expire stale pending bookings and release their held
+	// funds, and settle driver balances
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	go paymentService.RunSweeper(jobsCtx,
+		time.Duration(cfg.Payments.SweepInterval)*time.Second,
+		time.Duration(cfg.Payments.PendingExpiry)*time.Second,
+	)
+	go payoutJob.Run(jobsCtx, time.Duration(cfg.Ledger.PayoutInterval)*time.Second)
+
+	// Rates updated through another server are picked up here
+	go rateStore.Run(jobsCtx, time.Duration(cfg.Currency.RefreshInterval)*time.Second)
+
+	// Idempotency keys of retried POSTs, deleted once expired
+	idempotencyKeys := idempotency.NewService(dbClient, &cfg.Idempotency, log)
+	go idempotencyKeys.RunSweeper(jobsCtx, time.Duration(cfg.Idempotency.SweepInterval)*time.Second)
+
+	// Initialize services
+	rideService := rides.NewService(dbClient, promotionService)
+	bookingService := bookings.NewService(dbClient, paymentService, bookLedger, promotionService, rates, log)
+	userService := users.NewService(dbClient)
+
+	tokens := auth.NewTokens(&cfg.JWT)
 
 	// Initialize handlers
-	rideHandler := handlers.NewRideHandler(dbClient, log)
-	bookingHandler := handlers.NewBookingHandler(dbClient, log)
-	userHandler := handlers.NewUserHandler(dbClient, log)
-
-	// API routes
-	api := app.Group("/v1")
-
-	// Health check endpoint (no auth required)
-	api.Get("/health", func(c fiber.Ctx) error {
-		return c.JSON(fiber.Map{
-			"status":    "ok",
-			"timestamp": time.Now().UTC().Format(time.RFC3339),
-		})
-	})
-
-	// Rides endpoints
-	rides := api.Group("/rides")
-	rides.Get("/search", rideHandler.SearchRides)
-	rides.Get("/:rideId", rideHandler.GetRide)
-	rides.Post("", middleware.AuthMiddleware(), rideHandler.CreateRide)
-
-	// Bookings endpoints
-	bookings := api.Group("/bookings", middleware.AuthMiddleware())
-	bookings.Post("", bookingHandler.CreateBooking)
-	bookings.Post("/:bookingId/respond", bookingHandler.RespondToBooking)
-
-	// Users endpoints
-	users := api.Group("/users")
-	users.Get("/:userId/profile", userHandler.GetUserProfile)
+	rideHandler := handlers.NewRideHandler(rideService, rates)
+	bookingHandler := handlers.NewBookingHandler(bookingService)
+	userHandler := handlers.NewUserHandler(userService)
+	paymentHandler := handlers.NewPaymentHandler(paymentService)
+	earningsHandler := handlers.NewEarningsHandler(bookLedger)
+	currencyHandler := handlers.NewCurrencyHandler(rates, rateStore)
+	graphQLHandler := handlers.NewGraphQLHandler(graph.NewServer(dbClient, &cfg.GraphQL))
+
+	expectedVersion, err := db.LatestMigrationVersion()
+	if err != nil {
+		log.Warn("migration version will not be checked by readiness probe", zap.Error(err))
+	}
+	healthHandler := handlers.NewHealthHandler(sqlDB, expectedVersion,
+		time.Duration(cfg.Server.HealthTimeout)*time.Second,
+	)
+
+	// Rate limiting
+	rateLimitStore, err := ratelimit.NewStore(&cfg.RateLimit)
+	if err != nil {
+		log.Fatal("failed to initialize rate limit store", zap.Error(err))
+	}
+	rateLimit := func(name string, limit int) fiber.Handler {
+		if !cfg.RateLimit.Enabled {
+			return nil
+		}
+		return middleware.RateLimit(rateLimitStore, ratelimit.Policy{
+			Name:   name,
+			Limit:  limit,
+			Window: time.Duration(cfg.RateLimit.Window) * time.Second,
+		}, log)
+	}
+
+	// Register routes
+	server.Routes(app, server.Handlers{
+		Rides:    rideHandler,
+		Bookings: bookingHandler,
+		Users:    userHandler,
+		Payments: paymentHandler,
+		Earnings: earningsHandler,
+		Currency: currencyHandler,
+		Health:   healthHandler,
+		GraphQL:  graphQLHandler,
+	}, server.Limits{
+		Authenticated: rateLimit("authenticated", cfg.RateLimit.AuthenticatedLimit),
+		Search:        rateLimit("search", cfg.RateLimit.SearchLimit),
+		Public:        rateLimit("public", cfg.RateLimit.PublicLimit),
+	}, server.Auth{
+		Tokens:     tokens,
+		AdminToken: cfg.Admin.Token,
+	}, idempotencyKeys)
+
+	// gRPC server for internal services, on its own port next to the REST API
+	grpcServer := grpcapi.New(grpcapi.Services{
+		Rides:    rideService,
+		Bookings: bookingService,
+		Users:    userService,
+	}, grpcapi.Config{Tokens: tokens}, log)
+	grpcListener, err := net.Listen("tcp", fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.GRPCPort))
+	if err != nil {
+		log.Fatal("failed to listen for gRPC", zap.Error(err))
+	}
+	go func() {
+		log.Info("gRPC server is listening", zap.String("address", grpcListener.Addr().String()))
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			log.Fatal("failed to start gRPC server", zap.Error(err))
+		}
+	}()
+
+	// Admin server exposing metrics on its own port, so it is not reachable
+	// through the public load balancer
+	adminMux := http.NewServeMux()
+	adminMux.Handle("/metrics", metrics.Handler(metrics.NewRegistry(sqlDB)))
+	adminServer := &http.Server{
+		Addr:              fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.AdminPort),
+		Handler:           adminMux,
+		ReadHeaderTimeout: time.Duration(cfg.Server.ReadTimeout) * time.Second,
+	}
+	go func() {
+		log.Info("admin server is listening", zap.String("address", adminServer.Addr))
+		if err := adminServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("failed to start admin server", zap.Error(err))
+		}
+	}()
 
 	// Start server
 	addr := fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port)
@@ -116,9 +259,32 @@ func main() {
 
 		log.Info("shutting down server gracefully...")
 
+		// Fail readiness first and keep serving while load balancers notice
+		healthHandler.Drain()
+		time.Sleep(time.Duration(cfg.Server.ShutdownDelay) * time.Second)
+
+		stopJobs()
+
 		if err := app.Shutdown(); err != nil {
 			log.Error("server shutdown error", zap.Error(err))
 		}
+
+		// Let in-flight calls finish, then cut open WatchBooking streams,
+		// which never end on their own
+		grpcStopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(grpcStopped)
+		}()
+		select {
+		case <-grpcStopped:
+		case <-time.After(time.Duration(cfg.Server.RequestTimeout) * time.Second):
+			grpcServer.Stop()
+		}
+
+		if err := adminServer.Shutdown(context.Background()); err != nil {
+			log.Error("admin server shutdown error", zap.Error(err))
+		}
 	}()
 
 	log.Info("server is listening", zap.String("address", addr))
@@ -128,29 +294,3 @@ func main() {
 
 	log.Info("server stopped")
 }
-
-// errorHandler handles errors globally
-func errorHandler(log *zap.Logger) fiber.ErrorHandler {
-	return func(c fiber.Ctx, err error) error {
-		code := fiber.StatusInternalServerError
-		message := "Internal Server Error"
-
-		if e, ok := err.(*fiber.Error); ok {
-			code = e.Code
-			message = e.Message
-		}
-
-		log.Error("request error",
-			zap.String("path", c.Path()),
-			zap.Int("status", code),
-			zap.Error(err),
-		)
-
-		return c.Status(code).JSON(fiber.Map{
-			"error": fiber.Map{
-				"code":    "ERROR",
-				"message": message,
-			},
-		})
-	}
-}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/internal/config"
+	"github.com/slowtyper/poolie/backend/internal/db"
+	"go.uber.org/zap"
+)
+
+const migrateUsage = "usage: poolie-server migrate up|down|status|redo"
+
+// migrate runs the migrate subcommand against the configured database
+func migrate(cfg *config.Config, log *zap.Logger, args []string) error {
+	if len(args) != 1 {
+		return errors.New(migrateUsage)
+	}
+
+	migrator, closeDB, err := openMigrator(&cfg.Database, log)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		return migrator.Up(ctx)
+	case "down":
+		return migrator.Down(ctx)
+	case "redo":
+		return migrator.Redo(ctx)
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "APPLIED AT\tMIGRATION")
+		for _, s := range statuses {
+			appliedAt := "Pending"
+			if !s.AppliedAt.IsZero() {
+				appliedAt = s.AppliedAt.UTC().Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\n", appliedAt, s.Source.Path)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown migrate command %q; %s", args[0], migrateUsage)
+	}
+}
+
+// openMigrator connects a Migrator to the configured database. Its
+// connection has no statement timeout: migrations may run longer than the
+// application's queries, and waiting for the migration lock of another
+// replica counts as a running statement.
+func openMigrator(cfg *config.DatabaseConfig, log *zap.Logger) (*db.Migrator, func(), error) {
+	migrationCfg := *cfg
+	migrationCfg.StatementTimeout = 0
+
+	dbClient, sqlDB, err := db.New(&migrationCfg, log)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	migrator, err := db.NewMigrator(sqlDB, log)
+	if err != nil {
+		db.Close(dbClient, log)
+		return nil, nil, err
+	}
+	return migrator, func() { db.Close(dbClient, log) }, nil
+}
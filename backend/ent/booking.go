@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/payment"
 	"github.com/slowtyper/poolie/backend/ent/ride"
 	"github.com/slowtyper/poolie/backend/ent/user"
 )
@@ -27,10 +28,26 @@ type Booking struct {
 	Status string `json:"status,omitempty"`
 	// PassengerCount holds the value of the "passenger_count" field.
 	PassengerCount int `json:"passenger_count,omitempty"`
+	// SubtotalAmount holds the value of the "subtotal_amount" field.
+	SubtotalAmount int64 `json:"subtotal_amount,omitempty"`
+	// PromoCode holds the value of the "promo_code" field.
+	PromoCode string `json:"promo_code,omitempty"`
+	// DiscountAmount holds the value of the "discount_amount" field.
+	DiscountAmount int64 `json:"discount_amount,omitempty"`
+	// CreditAmount holds the value of the "credit_amount" field.
+	CreditAmount int64 `json:"credit_amount,omitempty"`
 	// TotalPriceAmount holds the value of the "total_price_amount" field.
 	TotalPriceAmount int64 `json:"total_price_amount,omitempty"`
 	// TotalPriceCurrency holds the value of the "total_price_currency" field.
 	TotalPriceCurrency string `json:"total_price_currency,omitempty"`
+	// DisplayCurrency holds the value of the "display_currency" field.
+	DisplayCurrency string `json:"display_currency,omitempty"`
+	// DisplayTotalAmount holds the value of the "display_total_amount" field.
+	DisplayTotalAmount *int64 `json:"display_total_amount,omitempty"`
+	// ExchangeRate holds the value of the "exchange_rate" field.
+	ExchangeRate string `json:"exchange_rate,omitempty"`
+	// ExchangeRateAsOf holds the value of the "exchange_rate_as_of" field.
+	ExchangeRateAsOf *time.Time `json:"exchange_rate_as_of,omitempty"`
 	// Message holds the value of the "message" field.
 	Message string `json:"message,omitempty"`
 	// DriverResponseMessage holds the value of the "driver_response_message" field.
@@ -39,6 +56,16 @@ type Booking struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// RespondedAt holds the value of the "responded_at" field.
 	RespondedAt *time.Time `json:"responded_at,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// CancelledBy holds the value of the "cancelled_by" field.
+	CancelledBy string `json:"cancelled_by,omitempty"`
+	// CancellationReason holds the value of the "cancellation_reason" field.
+	CancellationReason string `json:"cancellation_reason,omitempty"`
+	// RefundAmount holds the value of the "refund_amount" field.
+	RefundAmount int64 `json:"refund_amount,omitempty"`
+	// PenaltyAmount holds the value of the "penalty_amount" field.
+	PenaltyAmount int64 `json:"penalty_amount,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -53,9 +80,13 @@ type BookingEdges struct {
 	Ride *Ride `json:"ride,omitempty"`
 	// Passenger holds the value of the passenger edge.
 	Passenger *User `json:"passenger,omitempty"`
+	// Payment holds the value of the payment edge.
+	Payment *Payment `json:"payment,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
 }
 
 // RideOrErr returns the Ride value or an error if the edge
@@ -80,16 +111,27 @@ func (e BookingEdges) PassengerOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "passenger"}
 }
 
+// PaymentOrErr returns the Payment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookingEdges) PaymentOrErr() (*Payment, error) {
+	if e.Payment != nil {
+		return e.Payment, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: payment.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Booking) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case booking.FieldPassengerCount, booking.FieldTotalPriceAmount:
+		case booking.FieldPassengerCount, booking.FieldSubtotalAmount, booking.FieldDiscountAmount, booking.FieldCreditAmount, booking.FieldTotalPriceAmount, booking.FieldDisplayTotalAmount, booking.FieldRefundAmount, booking.FieldPenaltyAmount:
 			values[i] = new(sql.NullInt64)
-		case booking.FieldID, booking.FieldRideID, booking.FieldPassengerID, booking.FieldStatus, booking.FieldTotalPriceCurrency, booking.FieldMessage, booking.FieldDriverResponseMessage:
+		case booking.FieldID, booking.FieldRideID, booking.FieldPassengerID, booking.FieldStatus, booking.FieldPromoCode, booking.FieldTotalPriceCurrency, booking.FieldDisplayCurrency, booking.FieldExchangeRate, booking.FieldMessage, booking.FieldDriverResponseMessage, booking.FieldCancelledBy, booking.FieldCancellationReason:
 			values[i] = new(sql.NullString)
-		case booking.FieldCreatedAt, booking.FieldRespondedAt, booking.FieldUpdatedAt:
+		case booking.FieldExchangeRateAsOf, booking.FieldCreatedAt, booking.FieldRespondedAt, booking.FieldCancelledAt, booking.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -136,6 +178,30 @@ func (_m *Booking) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PassengerCount = int(value.Int64)
 			}
+		case booking.FieldSubtotalAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subtotal_amount", values[i])
+			} else if value.Valid {
+				_m.SubtotalAmount = value.Int64
+			}
+		case booking.FieldPromoCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field promo_code", values[i])
+			} else if value.Valid {
+				_m.PromoCode = value.String
+			}
+		case booking.FieldDiscountAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[i])
+			} else if value.Valid {
+				_m.DiscountAmount = value.Int64
+			}
+		case booking.FieldCreditAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_amount", values[i])
+			} else if value.Valid {
+				_m.CreditAmount = value.Int64
+			}
 		case booking.FieldTotalPriceAmount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field total_price_amount", values[i])
@@ -148,6 +214,32 @@ func (_m *Booking) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TotalPriceCurrency = value.String
 			}
+		case booking.FieldDisplayCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_currency", values[i])
+			} else if value.Valid {
+				_m.DisplayCurrency = value.String
+			}
+		case booking.FieldDisplayTotalAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field display_total_amount", values[i])
+			} else if value.Valid {
+				_m.DisplayTotalAmount = new(int64)
+				*_m.DisplayTotalAmount = value.Int64
+			}
+		case booking.FieldExchangeRate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate", values[i])
+			} else if value.Valid {
+				_m.ExchangeRate = value.String
+			}
+		case booking.FieldExchangeRateAsOf:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate_as_of", values[i])
+			} else if value.Valid {
+				_m.ExchangeRateAsOf = new(time.Time)
+				*_m.ExchangeRateAsOf = value.Time
+			}
 		case booking.FieldMessage:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field message", values[i])
@@ -173,6 +265,37 @@ func (_m *Booking) assignValues(columns []string, values []any) error {
 				_m.RespondedAt = new(time.Time)
 				*_m.RespondedAt = value.Time
 			}
+		case booking.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				_m.CancelledAt = new(time.Time)
+				*_m.CancelledAt = value.Time
+			}
+		case booking.FieldCancelledBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_by", values[i])
+			} else if value.Valid {
+				_m.CancelledBy = value.String
+			}
+		case booking.FieldCancellationReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancellation_reason", values[i])
+			} else if value.Valid {
+				_m.CancellationReason = value.String
+			}
+		case booking.FieldRefundAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_amount", values[i])
+			} else if value.Valid {
+				_m.RefundAmount = value.Int64
+			}
+		case booking.FieldPenaltyAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field penalty_amount", values[i])
+			} else if value.Valid {
+				_m.PenaltyAmount = value.Int64
+			}
 		case booking.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -202,6 +325,11 @@ func (_m *Booking) QueryPassenger() *UserQuery {
 	return NewBookingClient(_m.config).QueryPassenger(_m)
 }
 
+// QueryPayment queries the "payment" edge of the Booking entity.
+func (_m *Booking) QueryPayment() *PaymentQuery {
+	return NewBookingClient(_m.config).QueryPayment(_m)
+}
+
 // Update returns a builder for updating this Booking.
 // Note that you need to call Booking.Unwrap() before calling this method if this Booking
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -237,12 +365,40 @@ func (_m *Booking) String() string {
 	builder.WriteString("passenger_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PassengerCount))
 	builder.WriteString(", ")
+	builder.WriteString("subtotal_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SubtotalAmount))
+	builder.WriteString(", ")
+	builder.WriteString("promo_code=")
+	builder.WriteString(_m.PromoCode)
+	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountAmount))
+	builder.WriteString(", ")
+	builder.WriteString("credit_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreditAmount))
+	builder.WriteString(", ")
 	builder.WriteString("total_price_amount=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TotalPriceAmount))
 	builder.WriteString(", ")
 	builder.WriteString("total_price_currency=")
 	builder.WriteString(_m.TotalPriceCurrency)
 	builder.WriteString(", ")
+	builder.WriteString("display_currency=")
+	builder.WriteString(_m.DisplayCurrency)
+	builder.WriteString(", ")
+	if v := _m.DisplayTotalAmount; v != nil {
+		builder.WriteString("display_total_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("exchange_rate=")
+	builder.WriteString(_m.ExchangeRate)
+	builder.WriteString(", ")
+	if v := _m.ExchangeRateAsOf; v != nil {
+		builder.WriteString("exchange_rate_as_of=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("message=")
 	builder.WriteString(_m.Message)
 	builder.WriteString(", ")
@@ -257,6 +413,23 @@ func (_m *Booking) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := _m.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cancelled_by=")
+	builder.WriteString(_m.CancelledBy)
+	builder.WriteString(", ")
+	builder.WriteString("cancellation_reason=")
+	builder.WriteString(_m.CancellationReason)
+	builder.WriteString(", ")
+	builder.WriteString("refund_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefundAmount))
+	builder.WriteString(", ")
+	builder.WriteString("penalty_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PenaltyAmount))
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -22,10 +22,26 @@ const (
 	FieldStatus = "status"
 	// FieldPassengerCount holds the string denoting the passenger_count field in the database.
 	FieldPassengerCount = "passenger_count"
+	// FieldSubtotalAmount holds the string denoting the subtotal_amount field in the database.
+	FieldSubtotalAmount = "subtotal_amount"
+	// FieldPromoCode holds the string denoting the promo_code field in the database.
+	FieldPromoCode = "promo_code"
+	// FieldDiscountAmount holds the string denoting the discount_amount field in the database.
+	FieldDiscountAmount = "discount_amount"
+	// FieldCreditAmount holds the string denoting the credit_amount field in the database.
+	FieldCreditAmount = "credit_amount"
 	// FieldTotalPriceAmount holds the string denoting the total_price_amount field in the database.
 	FieldTotalPriceAmount = "total_price_amount"
 	// FieldTotalPriceCurrency holds the string denoting the total_price_currency field in the database.
 	FieldTotalPriceCurrency = "total_price_currency"
+	// FieldDisplayCurrency holds the string denoting the display_currency field in the database.
+	FieldDisplayCurrency = "display_currency"
+	// FieldDisplayTotalAmount holds the string denoting the display_total_amount field in the database.
+	FieldDisplayTotalAmount = "display_total_amount"
+	// FieldExchangeRate holds the string denoting the exchange_rate field in the database.
+	FieldExchangeRate = "exchange_rate"
+	// FieldExchangeRateAsOf holds the string denoting the exchange_rate_as_of field in the database.
+	FieldExchangeRateAsOf = "exchange_rate_as_of"
 	// FieldMessage holds the string denoting the message field in the database.
 	FieldMessage = "message"
 	// FieldDriverResponseMessage holds the string denoting the driver_response_message field in the database.
@@ -34,12 +50,24 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldRespondedAt holds the string denoting the responded_at field in the database.
 	FieldRespondedAt = "responded_at"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldCancelledBy holds the string denoting the cancelled_by field in the database.
+	FieldCancelledBy = "cancelled_by"
+	// FieldCancellationReason holds the string denoting the cancellation_reason field in the database.
+	FieldCancellationReason = "cancellation_reason"
+	// FieldRefundAmount holds the string denoting the refund_amount field in the database.
+	FieldRefundAmount = "refund_amount"
+	// FieldPenaltyAmount holds the string denoting the penalty_amount field in the database.
+	FieldPenaltyAmount = "penalty_amount"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeRide holds the string denoting the ride edge name in mutations.
 	EdgeRide = "ride"
 	// EdgePassenger holds the string denoting the passenger edge name in mutations.
 	EdgePassenger = "passenger"
+	// EdgePayment holds the string denoting the payment edge name in mutations.
+	EdgePayment = "payment"
 	// Table holds the table name of the booking in the database.
 	Table = "bookings"
 	// RideTable is the table that holds the ride relation/edge.
@@ -56,6 +84,13 @@ const (
 	PassengerInverseTable = "users"
 	// PassengerColumn is the table column denoting the passenger relation/edge.
 	PassengerColumn = "passenger_id"
+	// PaymentTable is the table that holds the payment relation/edge.
+	PaymentTable = "payments"
+	// PaymentInverseTable is the table name for the Payment entity.
+	// It exists in this package in order to avoid circular dependency with the "payment" package.
+	PaymentInverseTable = "payments"
+	// PaymentColumn is the table column denoting the payment relation/edge.
+	PaymentColumn = "booking_id"
 )
 
 // Columns holds all SQL columns for booking fields.
@@ -65,12 +100,25 @@ var Columns = []string{
 	FieldPassengerID,
 	FieldStatus,
 	FieldPassengerCount,
+	FieldSubtotalAmount,
+	FieldPromoCode,
+	FieldDiscountAmount,
+	FieldCreditAmount,
 	FieldTotalPriceAmount,
 	FieldTotalPriceCurrency,
+	FieldDisplayCurrency,
+	FieldDisplayTotalAmount,
+	FieldExchangeRate,
+	FieldExchangeRateAsOf,
 	FieldMessage,
 	FieldDriverResponseMessage,
 	FieldCreatedAt,
 	FieldRespondedAt,
+	FieldCancelledAt,
+	FieldCancelledBy,
+	FieldCancellationReason,
+	FieldRefundAmount,
+	FieldPenaltyAmount,
 	FieldUpdatedAt,
 }
 
@@ -93,16 +141,38 @@ var (
 	DefaultStatus string
 	// PassengerCountValidator is a validator for the "passenger_count" field. It is called by the builders before save.
 	PassengerCountValidator func(int) error
+	// DefaultSubtotalAmount holds the default value on creation for the "subtotal_amount" field.
+	DefaultSubtotalAmount int64
+	// SubtotalAmountValidator is a validator for the "subtotal_amount" field. It is called by the builders before save.
+	SubtotalAmountValidator func(int64) error
+	// DefaultDiscountAmount holds the default value on creation for the "discount_amount" field.
+	DefaultDiscountAmount int64
+	// DiscountAmountValidator is a validator for the "discount_amount" field. It is called by the builders before save.
+	DiscountAmountValidator func(int64) error
+	// DefaultCreditAmount holds the default value on creation for the "credit_amount" field.
+	DefaultCreditAmount int64
+	// CreditAmountValidator is a validator for the "credit_amount" field. It is called by the builders before save.
+	CreditAmountValidator func(int64) error
 	// TotalPriceAmountValidator is a validator for the "total_price_amount" field. It is called by the builders before save.
 	TotalPriceAmountValidator func(int64) error
 	// DefaultTotalPriceCurrency holds the default value on creation for the "total_price_currency" field.
 	DefaultTotalPriceCurrency string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultRefundAmount holds the default value on creation for the "refund_amount" field.
+	DefaultRefundAmount int64
+	// RefundAmountValidator is a validator for the "refund_amount" field. It is called by the builders before save.
+	RefundAmountValidator func(int64) error
+	// DefaultPenaltyAmount holds the default value on creation for the "penalty_amount" field.
+	DefaultPenaltyAmount int64
+	// PenaltyAmountValidator is a validator for the "penalty_amount" field. It is called by the builders before save.
+	PenaltyAmountValidator func(int64) error
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
 )
 
 // OrderOption defines the ordering options for the Booking queries.
@@ -133,6 +203,26 @@ func ByPassengerCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPassengerCount, opts...).ToFunc()
 }
 
+// BySubtotalAmount orders the results by the subtotal_amount field.
+func BySubtotalAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubtotalAmount, opts...).ToFunc()
+}
+
+// ByPromoCode orders the results by the promo_code field.
+func ByPromoCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromoCode, opts...).ToFunc()
+}
+
+// ByDiscountAmount orders the results by the discount_amount field.
+func ByDiscountAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountAmount, opts...).ToFunc()
+}
+
+// ByCreditAmount orders the results by the credit_amount field.
+func ByCreditAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditAmount, opts...).ToFunc()
+}
+
 // ByTotalPriceAmount orders the results by the total_price_amount field.
 func ByTotalPriceAmount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotalPriceAmount, opts...).ToFunc()
@@ -143,6 +233,26 @@ func ByTotalPriceCurrency(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotalPriceCurrency, opts...).ToFunc()
 }
 
+// ByDisplayCurrency orders the results by the display_currency field.
+func ByDisplayCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayCurrency, opts...).ToFunc()
+}
+
+// ByDisplayTotalAmount orders the results by the display_total_amount field.
+func ByDisplayTotalAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayTotalAmount, opts...).ToFunc()
+}
+
+// ByExchangeRate orders the results by the exchange_rate field.
+func ByExchangeRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchangeRate, opts...).ToFunc()
+}
+
+// ByExchangeRateAsOf orders the results by the exchange_rate_as_of field.
+func ByExchangeRateAsOf(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchangeRateAsOf, opts...).ToFunc()
+}
+
 // ByMessage orders the results by the message field.
 func ByMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMessage, opts...).ToFunc()
@@ -163,6 +273,31 @@ func ByRespondedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRespondedAt, opts...).ToFunc()
 }
 
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByCancelledBy orders the results by the cancelled_by field.
+func ByCancelledBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledBy, opts...).ToFunc()
+}
+
+// ByCancellationReason orders the results by the cancellation_reason field.
+func ByCancellationReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancellationReason, opts...).ToFunc()
+}
+
+// ByRefundAmount orders the results by the refund_amount field.
+func ByRefundAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundAmount, opts...).ToFunc()
+}
+
+// ByPenaltyAmount orders the results by the penalty_amount field.
+func ByPenaltyAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPenaltyAmount, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
@@ -181,6 +316,13 @@ func ByPassengerField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPassengerStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByPaymentField orders the results by payment field.
+func ByPaymentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newRideStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -195,3 +337,10 @@ func newPassengerStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, PassengerTable, PassengerColumn),
 	)
 }
+func newPaymentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, PaymentTable, PaymentColumn),
+	)
+}
@@ -85,6 +85,26 @@ func PassengerCount(v int) predicate.Booking {
 	return predicate.Booking(sql.FieldEQ(FieldPassengerCount, v))
 }
 
+// SubtotalAmount applies equality check predicate on the "subtotal_amount" field. It's identical to SubtotalAmountEQ.
+func SubtotalAmount(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldSubtotalAmount, v))
+}
+
+// PromoCode applies equality check predicate on the "promo_code" field. It's identical to PromoCodeEQ.
+func PromoCode(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldPromoCode, v))
+}
+
+// DiscountAmount applies equality check predicate on the "discount_amount" field. It's identical to DiscountAmountEQ.
+func DiscountAmount(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// CreditAmount applies equality check predicate on the "credit_amount" field. It's identical to CreditAmountEQ.
+func CreditAmount(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldCreditAmount, v))
+}
+
 // TotalPriceAmount applies equality check predicate on the "total_price_amount" field. It's identical to TotalPriceAmountEQ.
 func TotalPriceAmount(v int64) predicate.Booking {
 	return predicate.Booking(sql.FieldEQ(FieldTotalPriceAmount, v))
@@ -95,6 +115,26 @@ func TotalPriceCurrency(v string) predicate.Booking {
 	return predicate.Booking(sql.FieldEQ(FieldTotalPriceCurrency, v))
 }
 
+// DisplayCurrency applies equality check predicate on the "display_currency" field. It's identical to DisplayCurrencyEQ.
+func DisplayCurrency(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldDisplayCurrency, v))
+}
+
+// DisplayTotalAmount applies equality check predicate on the "display_total_amount" field. It's identical to DisplayTotalAmountEQ.
+func DisplayTotalAmount(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldDisplayTotalAmount, v))
+}
+
+// ExchangeRate applies equality check predicate on the "exchange_rate" field. It's identical to ExchangeRateEQ.
+func ExchangeRate(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateAsOf applies equality check predicate on the "exchange_rate_as_of" field. It's identical to ExchangeRateAsOfEQ.
+func ExchangeRateAsOf(v time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldExchangeRateAsOf, v))
+}
+
 // Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
 func Message(v string) predicate.Booking {
 	return predicate.Booking(sql.FieldEQ(FieldMessage, v))
@@ -115,6 +155,31 @@ func RespondedAt(v time.Time) predicate.Booking {
 	return predicate.Booking(sql.FieldEQ(FieldRespondedAt, v))
 }
 
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledBy applies equality check predicate on the "cancelled_by" field. It's identical to CancelledByEQ.
+func CancelledBy(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldCancelledBy, v))
+}
+
+// CancellationReason applies equality check predicate on the "cancellation_reason" field. It's identical to CancellationReasonEQ.
+func CancellationReason(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldCancellationReason, v))
+}
+
+// RefundAmount applies equality check predicate on the "refund_amount" field. It's identical to RefundAmountEQ.
+func RefundAmount(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldRefundAmount, v))
+}
+
+// PenaltyAmount applies equality check predicate on the "penalty_amount" field. It's identical to PenaltyAmountEQ.
+func PenaltyAmount(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldPenaltyAmount, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.Booking {
 	return predicate.Booking(sql.FieldEQ(FieldUpdatedAt, v))
@@ -355,6 +420,201 @@ func PassengerCountLTE(v int) predicate.Booking {
 	return predicate.Booking(sql.FieldLTE(FieldPassengerCount, v))
 }
 
+// SubtotalAmountEQ applies the EQ predicate on the "subtotal_amount" field.
+func SubtotalAmountEQ(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldSubtotalAmount, v))
+}
+
+// SubtotalAmountNEQ applies the NEQ predicate on the "subtotal_amount" field.
+func SubtotalAmountNEQ(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldNEQ(FieldSubtotalAmount, v))
+}
+
+// SubtotalAmountIn applies the In predicate on the "subtotal_amount" field.
+func SubtotalAmountIn(vs ...int64) predicate.Booking {
+	return predicate.Booking(sql.FieldIn(FieldSubtotalAmount, vs...))
+}
+
+// SubtotalAmountNotIn applies the NotIn predicate on the "subtotal_amount" field.
+func SubtotalAmountNotIn(vs ...int64) predicate.Booking {
+	return predicate.Booking(sql.FieldNotIn(FieldSubtotalAmount, vs...))
+}
+
+// SubtotalAmountGT applies the GT predicate on the "subtotal_amount" field.
+func SubtotalAmountGT(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldGT(FieldSubtotalAmount, v))
+}
+
+// SubtotalAmountGTE applies the GTE predicate on the "subtotal_amount" field.
+func SubtotalAmountGTE(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldGTE(FieldSubtotalAmount, v))
+}
+
+// SubtotalAmountLT applies the LT predicate on the "subtotal_amount" field.
+func SubtotalAmountLT(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldLT(FieldSubtotalAmount, v))
+}
+
+// SubtotalAmountLTE applies the LTE predicate on the "subtotal_amount" field.
+func SubtotalAmountLTE(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldLTE(FieldSubtotalAmount, v))
+}
+
+// PromoCodeEQ applies the EQ predicate on the "promo_code" field.
+func PromoCodeEQ(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldPromoCode, v))
+}
+
+// PromoCodeNEQ applies the NEQ predicate on the "promo_code" field.
+func PromoCodeNEQ(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldNEQ(FieldPromoCode, v))
+}
+
+// PromoCodeIn applies the In predicate on the "promo_code" field.
+func PromoCodeIn(vs ...string) predicate.Booking {
+	return predicate.Booking(sql.FieldIn(FieldPromoCode, vs...))
+}
+
+// PromoCodeNotIn applies the NotIn predicate on the "promo_code" field.
+func PromoCodeNotIn(vs ...string) predicate.Booking {
+	return predicate.Booking(sql.FieldNotIn(FieldPromoCode, vs...))
+}
+
+// PromoCodeGT applies the GT predicate on the "promo_code" field.
+func PromoCodeGT(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldGT(FieldPromoCode, v))
+}
+
+// PromoCodeGTE applies the GTE predicate on the "promo_code" field.
+func PromoCodeGTE(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldGTE(FieldPromoCode, v))
+}
+
+// PromoCodeLT applies the LT predicate on the "promo_code" field.
+func PromoCodeLT(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldLT(FieldPromoCode, v))
+}
+
+// PromoCodeLTE applies the LTE predicate on the "promo_code" field.
+func PromoCodeLTE(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldLTE(FieldPromoCode, v))
+}
+
+// PromoCodeContains applies the Contains predicate on the "promo_code" field.
+func PromoCodeContains(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldContains(FieldPromoCode, v))
+}
+
+// PromoCodeHasPrefix applies the HasPrefix predicate on the "promo_code" field.
+func PromoCodeHasPrefix(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldHasPrefix(FieldPromoCode, v))
+}
+
+// PromoCodeHasSuffix applies the HasSuffix predicate on the "promo_code" field.
+func PromoCodeHasSuffix(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldHasSuffix(FieldPromoCode, v))
+}
+
+// PromoCodeIsNil applies the IsNil predicate on the "promo_code" field.
+func PromoCodeIsNil() predicate.Booking {
+	return predicate.Booking(sql.FieldIsNull(FieldPromoCode))
+}
+
+// PromoCodeNotNil applies the NotNil predicate on the "promo_code" field.
+func PromoCodeNotNil() predicate.Booking {
+	return predicate.Booking(sql.FieldNotNull(FieldPromoCode))
+}
+
+// PromoCodeEqualFold applies the EqualFold predicate on the "promo_code" field.
+func PromoCodeEqualFold(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEqualFold(FieldPromoCode, v))
+}
+
+// PromoCodeContainsFold applies the ContainsFold predicate on the "promo_code" field.
+func PromoCodeContainsFold(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldContainsFold(FieldPromoCode, v))
+}
+
+// DiscountAmountEQ applies the EQ predicate on the "discount_amount" field.
+func DiscountAmountEQ(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountNEQ applies the NEQ predicate on the "discount_amount" field.
+func DiscountAmountNEQ(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldNEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountIn applies the In predicate on the "discount_amount" field.
+func DiscountAmountIn(vs ...int64) predicate.Booking {
+	return predicate.Booking(sql.FieldIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountNotIn applies the NotIn predicate on the "discount_amount" field.
+func DiscountAmountNotIn(vs ...int64) predicate.Booking {
+	return predicate.Booking(sql.FieldNotIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountGT applies the GT predicate on the "discount_amount" field.
+func DiscountAmountGT(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldGT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountGTE applies the GTE predicate on the "discount_amount" field.
+func DiscountAmountGTE(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldGTE(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLT applies the LT predicate on the "discount_amount" field.
+func DiscountAmountLT(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldLT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLTE applies the LTE predicate on the "discount_amount" field.
+func DiscountAmountLTE(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldLTE(FieldDiscountAmount, v))
+}
+
+// CreditAmountEQ applies the EQ predicate on the "credit_amount" field.
+func CreditAmountEQ(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldCreditAmount, v))
+}
+
+// CreditAmountNEQ applies the NEQ predicate on the "credit_amount" field.
+func CreditAmountNEQ(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldNEQ(FieldCreditAmount, v))
+}
+
+// CreditAmountIn applies the In predicate on the "credit_amount" field.
+func CreditAmountIn(vs ...int64) predicate.Booking {
+	return predicate.Booking(sql.FieldIn(FieldCreditAmount, vs...))
+}
+
+// CreditAmountNotIn applies the NotIn predicate on the "credit_amount" field.
+func CreditAmountNotIn(vs ...int64) predicate.Booking {
+	return predicate.Booking(sql.FieldNotIn(FieldCreditAmount, vs...))
+}
+
+// CreditAmountGT applies the GT predicate on the "credit_amount" field.
+func CreditAmountGT(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldGT(FieldCreditAmount, v))
+}
+
+// CreditAmountGTE applies the GTE predicate on the "credit_amount" field.
+func CreditAmountGTE(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldGTE(FieldCreditAmount, v))
+}
+
+// CreditAmountLT applies the LT predicate on the "credit_amount" field.
+func CreditAmountLT(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldLT(FieldCreditAmount, v))
+}
+
+// CreditAmountLTE applies the LTE predicate on the "credit_amount" field.
+func CreditAmountLTE(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldLTE(FieldCreditAmount, v))
+}
+
 // TotalPriceAmountEQ applies the EQ predicate on the "total_price_amount" field.
 func TotalPriceAmountEQ(v int64) predicate.Booking {
 	return predicate.Booking(sql.FieldEQ(FieldTotalPriceAmount, v))
@@ -460,6 +720,256 @@ func TotalPriceCurrencyContainsFold(v string) predicate.Booking {
 	return predicate.Booking(sql.FieldContainsFold(FieldTotalPriceCurrency, v))
 }
 
+// DisplayCurrencyEQ applies the EQ predicate on the "display_currency" field.
+func DisplayCurrencyEQ(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyNEQ applies the NEQ predicate on the "display_currency" field.
+func DisplayCurrencyNEQ(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldNEQ(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyIn applies the In predicate on the "display_currency" field.
+func DisplayCurrencyIn(vs ...string) predicate.Booking {
+	return predicate.Booking(sql.FieldIn(FieldDisplayCurrency, vs...))
+}
+
+// DisplayCurrencyNotIn applies the NotIn predicate on the "display_currency" field.
+func DisplayCurrencyNotIn(vs ...string) predicate.Booking {
+	return predicate.Booking(sql.FieldNotIn(FieldDisplayCurrency, vs...))
+}
+
+// DisplayCurrencyGT applies the GT predicate on the "display_currency" field.
+func DisplayCurrencyGT(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldGT(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyGTE applies the GTE predicate on the "display_currency" field.
+func DisplayCurrencyGTE(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldGTE(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyLT applies the LT predicate on the "display_currency" field.
+func DisplayCurrencyLT(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldLT(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyLTE applies the LTE predicate on the "display_currency" field.
+func DisplayCurrencyLTE(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldLTE(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyContains applies the Contains predicate on the "display_currency" field.
+func DisplayCurrencyContains(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldContains(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyHasPrefix applies the HasPrefix predicate on the "display_currency" field.
+func DisplayCurrencyHasPrefix(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldHasPrefix(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyHasSuffix applies the HasSuffix predicate on the "display_currency" field.
+func DisplayCurrencyHasSuffix(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldHasSuffix(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyIsNil applies the IsNil predicate on the "display_currency" field.
+func DisplayCurrencyIsNil() predicate.Booking {
+	return predicate.Booking(sql.FieldIsNull(FieldDisplayCurrency))
+}
+
+// DisplayCurrencyNotNil applies the NotNil predicate on the "display_currency" field.
+func DisplayCurrencyNotNil() predicate.Booking {
+	return predicate.Booking(sql.FieldNotNull(FieldDisplayCurrency))
+}
+
+// DisplayCurrencyEqualFold applies the EqualFold predicate on the "display_currency" field.
+func DisplayCurrencyEqualFold(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEqualFold(FieldDisplayCurrency, v))
+}
+
+// DisplayCurrencyContainsFold applies the ContainsFold predicate on the "display_currency" field.
+func DisplayCurrencyContainsFold(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldContainsFold(FieldDisplayCurrency, v))
+}
+
+// DisplayTotalAmountEQ applies the EQ predicate on the "display_total_amount" field.
+func DisplayTotalAmountEQ(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldDisplayTotalAmount, v))
+}
+
+// DisplayTotalAmountNEQ applies the NEQ predicate on the "display_total_amount" field.
+func DisplayTotalAmountNEQ(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldNEQ(FieldDisplayTotalAmount, v))
+}
+
+// DisplayTotalAmountIn applies the In predicate on the "display_total_amount" field.
+func DisplayTotalAmountIn(vs ...int64) predicate.Booking {
+	return predicate.Booking(sql.FieldIn(FieldDisplayTotalAmount, vs...))
+}
+
+// DisplayTotalAmountNotIn applies the NotIn predicate on the "display_total_amount" field.
+func DisplayTotalAmountNotIn(vs ...int64) predicate.Booking {
+	return predicate.Booking(sql.FieldNotIn(FieldDisplayTotalAmount, vs...))
+}
+
+// DisplayTotalAmountGT applies the GT predicate on the "display_total_amount" field.
+func DisplayTotalAmountGT(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldGT(FieldDisplayTotalAmount, v))
+}
+
+// DisplayTotalAmountGTE applies the GTE predicate on the "display_total_amount" field.
+func DisplayTotalAmountGTE(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldGTE(FieldDisplayTotalAmount, v))
+}
+
+// DisplayTotalAmountLT applies the LT predicate on the "display_total_amount" field.
+func DisplayTotalAmountLT(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldLT(FieldDisplayTotalAmount, v))
+}
+
+// DisplayTotalAmountLTE applies the LTE predicate on the "display_total_amount" field.
+func DisplayTotalAmountLTE(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldLTE(FieldDisplayTotalAmount, v))
+}
+
+// DisplayTotalAmountIsNil applies the IsNil predicate on the "display_total_amount" field.
+func DisplayTotalAmountIsNil() predicate.Booking {
+	return predicate.Booking(sql.FieldIsNull(FieldDisplayTotalAmount))
+}
+
+// DisplayTotalAmountNotNil applies the NotNil predicate on the "display_total_amount" field.
+func DisplayTotalAmountNotNil() predicate.Booking {
+	return predicate.Booking(sql.FieldNotNull(FieldDisplayTotalAmount))
+}
+
+// ExchangeRateEQ applies the EQ predicate on the "exchange_rate" field.
+func ExchangeRateEQ(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateNEQ applies the NEQ predicate on the "exchange_rate" field.
+func ExchangeRateNEQ(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldNEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateIn applies the In predicate on the "exchange_rate" field.
+func ExchangeRateIn(vs ...string) predicate.Booking {
+	return predicate.Booking(sql.FieldIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateNotIn applies the NotIn predicate on the "exchange_rate" field.
+func ExchangeRateNotIn(vs ...string) predicate.Booking {
+	return predicate.Booking(sql.FieldNotIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateGT applies the GT predicate on the "exchange_rate" field.
+func ExchangeRateGT(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldGT(FieldExchangeRate, v))
+}
+
+// ExchangeRateGTE applies the GTE predicate on the "exchange_rate" field.
+func ExchangeRateGTE(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldGTE(FieldExchangeRate, v))
+}
+
+// ExchangeRateLT applies the LT predicate on the "exchange_rate" field.
+func ExchangeRateLT(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldLT(FieldExchangeRate, v))
+}
+
+// ExchangeRateLTE applies the LTE predicate on the "exchange_rate" field.
+func ExchangeRateLTE(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldLTE(FieldExchangeRate, v))
+}
+
+// ExchangeRateContains applies the Contains predicate on the "exchange_rate" field.
+func ExchangeRateContains(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldContains(FieldExchangeRate, v))
+}
+
+// ExchangeRateHasPrefix applies the HasPrefix predicate on the "exchange_rate" field.
+func ExchangeRateHasPrefix(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldHasPrefix(FieldExchangeRate, v))
+}
+
+// ExchangeRateHasSuffix applies the HasSuffix predicate on the "exchange_rate" field.
+func ExchangeRateHasSuffix(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldHasSuffix(FieldExchangeRate, v))
+}
+
+// ExchangeRateIsNil applies the IsNil predicate on the "exchange_rate" field.
+func ExchangeRateIsNil() predicate.Booking {
+	return predicate.Booking(sql.FieldIsNull(FieldExchangeRate))
+}
+
+// ExchangeRateNotNil applies the NotNil predicate on the "exchange_rate" field.
+func ExchangeRateNotNil() predicate.Booking {
+	return predicate.Booking(sql.FieldNotNull(FieldExchangeRate))
+}
+
+// ExchangeRateEqualFold applies the EqualFold predicate on the "exchange_rate" field.
+func ExchangeRateEqualFold(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEqualFold(FieldExchangeRate, v))
+}
+
+// ExchangeRateContainsFold applies the ContainsFold predicate on the "exchange_rate" field.
+func ExchangeRateContainsFold(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldContainsFold(FieldExchangeRate, v))
+}
+
+// ExchangeRateAsOfEQ applies the EQ predicate on the "exchange_rate_as_of" field.
+func ExchangeRateAsOfEQ(v time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldExchangeRateAsOf, v))
+}
+
+// ExchangeRateAsOfNEQ applies the NEQ predicate on the "exchange_rate_as_of" field.
+func ExchangeRateAsOfNEQ(v time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldNEQ(FieldExchangeRateAsOf, v))
+}
+
+// ExchangeRateAsOfIn applies the In predicate on the "exchange_rate_as_of" field.
+func ExchangeRateAsOfIn(vs ...time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldIn(FieldExchangeRateAsOf, vs...))
+}
+
+// ExchangeRateAsOfNotIn applies the NotIn predicate on the "exchange_rate_as_of" field.
+func ExchangeRateAsOfNotIn(vs ...time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldNotIn(FieldExchangeRateAsOf, vs...))
+}
+
+// ExchangeRateAsOfGT applies the GT predicate on the "exchange_rate_as_of" field.
+func ExchangeRateAsOfGT(v time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldGT(FieldExchangeRateAsOf, v))
+}
+
+// ExchangeRateAsOfGTE applies the GTE predicate on the "exchange_rate_as_of" field.
+func ExchangeRateAsOfGTE(v time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldGTE(FieldExchangeRateAsOf, v))
+}
+
+// ExchangeRateAsOfLT applies the LT predicate on the "exchange_rate_as_of" field.
+func ExchangeRateAsOfLT(v time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldLT(FieldExchangeRateAsOf, v))
+}
+
+// ExchangeRateAsOfLTE applies the LTE predicate on the "exchange_rate_as_of" field.
+func ExchangeRateAsOfLTE(v time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldLTE(FieldExchangeRateAsOf, v))
+}
+
+// ExchangeRateAsOfIsNil applies the IsNil predicate on the "exchange_rate_as_of" field.
+func ExchangeRateAsOfIsNil() predicate.Booking {
+	return predicate.Booking(sql.FieldIsNull(FieldExchangeRateAsOf))
+}
+
+// ExchangeRateAsOfNotNil applies the NotNil predicate on the "exchange_rate_as_of" field.
+func ExchangeRateAsOfNotNil() predicate.Booking {
+	return predicate.Booking(sql.FieldNotNull(FieldExchangeRateAsOf))
+}
+
 // MessageEQ applies the EQ predicate on the "message" field.
 func MessageEQ(v string) predicate.Booking {
 	return predicate.Booking(sql.FieldEQ(FieldMessage, v))
@@ -700,6 +1210,286 @@ func RespondedAtNotNil() predicate.Booking {
 	return predicate.Booking(sql.FieldNotNull(FieldRespondedAt))
 }
 
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.Booking {
+	return predicate.Booking(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.Booking {
+	return predicate.Booking(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.Booking {
+	return predicate.Booking(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// CancelledByEQ applies the EQ predicate on the "cancelled_by" field.
+func CancelledByEQ(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldCancelledBy, v))
+}
+
+// CancelledByNEQ applies the NEQ predicate on the "cancelled_by" field.
+func CancelledByNEQ(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldNEQ(FieldCancelledBy, v))
+}
+
+// CancelledByIn applies the In predicate on the "cancelled_by" field.
+func CancelledByIn(vs ...string) predicate.Booking {
+	return predicate.Booking(sql.FieldIn(FieldCancelledBy, vs...))
+}
+
+// CancelledByNotIn applies the NotIn predicate on the "cancelled_by" field.
+func CancelledByNotIn(vs ...string) predicate.Booking {
+	return predicate.Booking(sql.FieldNotIn(FieldCancelledBy, vs...))
+}
+
+// CancelledByGT applies the GT predicate on the "cancelled_by" field.
+func CancelledByGT(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldGT(FieldCancelledBy, v))
+}
+
+// CancelledByGTE applies the GTE predicate on the "cancelled_by" field.
+func CancelledByGTE(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldGTE(FieldCancelledBy, v))
+}
+
+// CancelledByLT applies the LT predicate on the "cancelled_by" field.
+func CancelledByLT(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldLT(FieldCancelledBy, v))
+}
+
+// CancelledByLTE applies the LTE predicate on the "cancelled_by" field.
+func CancelledByLTE(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldLTE(FieldCancelledBy, v))
+}
+
+// CancelledByContains applies the Contains predicate on the "cancelled_by" field.
+func CancelledByContains(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldContains(FieldCancelledBy, v))
+}
+
+// CancelledByHasPrefix applies the HasPrefix predicate on the "cancelled_by" field.
+func CancelledByHasPrefix(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldHasPrefix(FieldCancelledBy, v))
+}
+
+// CancelledByHasSuffix applies the HasSuffix predicate on the "cancelled_by" field.
+func CancelledByHasSuffix(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldHasSuffix(FieldCancelledBy, v))
+}
+
+// CancelledByIsNil applies the IsNil predicate on the "cancelled_by" field.
+func CancelledByIsNil() predicate.Booking {
+	return predicate.Booking(sql.FieldIsNull(FieldCancelledBy))
+}
+
+// CancelledByNotNil applies the NotNil predicate on the "cancelled_by" field.
+func CancelledByNotNil() predicate.Booking {
+	return predicate.Booking(sql.FieldNotNull(FieldCancelledBy))
+}
+
+// CancelledByEqualFold applies the EqualFold predicate on the "cancelled_by" field.
+func CancelledByEqualFold(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEqualFold(FieldCancelledBy, v))
+}
+
+// CancelledByContainsFold applies the ContainsFold predicate on the "cancelled_by" field.
+func CancelledByContainsFold(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldContainsFold(FieldCancelledBy, v))
+}
+
+// CancellationReasonEQ applies the EQ predicate on the "cancellation_reason" field.
+func CancellationReasonEQ(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldCancellationReason, v))
+}
+
+// CancellationReasonNEQ applies the NEQ predicate on the "cancellation_reason" field.
+func CancellationReasonNEQ(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldNEQ(FieldCancellationReason, v))
+}
+
+// CancellationReasonIn applies the In predicate on the "cancellation_reason" field.
+func CancellationReasonIn(vs ...string) predicate.Booking {
+	return predicate.Booking(sql.FieldIn(FieldCancellationReason, vs...))
+}
+
+// CancellationReasonNotIn applies the NotIn predicate on the "cancellation_reason" field.
+func CancellationReasonNotIn(vs ...string) predicate.Booking {
+	return predicate.Booking(sql.FieldNotIn(FieldCancellationReason, vs...))
+}
+
+// CancellationReasonGT applies the GT predicate on the "cancellation_reason" field.
+func CancellationReasonGT(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldGT(FieldCancellationReason, v))
+}
+
+// CancellationReasonGTE applies the GTE predicate on the "cancellation_reason" field.
+func CancellationReasonGTE(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldGTE(FieldCancellationReason, v))
+}
+
+// CancellationReasonLT applies the LT predicate on the "cancellation_reason" field.
+func CancellationReasonLT(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldLT(FieldCancellationReason, v))
+}
+
+// CancellationReasonLTE applies the LTE predicate on the "cancellation_reason" field.
+func CancellationReasonLTE(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldLTE(FieldCancellationReason, v))
+}
+
+// CancellationReasonContains applies the Contains predicate on the "cancellation_reason" field.
+func CancellationReasonContains(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldContains(FieldCancellationReason, v))
+}
+
+// CancellationReasonHasPrefix applies the HasPrefix predicate on the "cancellation_reason" field.
+func CancellationReasonHasPrefix(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldHasPrefix(FieldCancellationReason, v))
+}
+
+// CancellationReasonHasSuffix applies the HasSuffix predicate on the "cancellation_reason" field.
+func CancellationReasonHasSuffix(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldHasSuffix(FieldCancellationReason, v))
+}
+
+// CancellationReasonIsNil applies the IsNil predicate on the "cancellation_reason" field.
+func CancellationReasonIsNil() predicate.Booking {
+	return predicate.Booking(sql.FieldIsNull(FieldCancellationReason))
+}
+
+// CancellationReasonNotNil applies the NotNil predicate on the "cancellation_reason" field.
+func CancellationReasonNotNil() predicate.Booking {
+	return predicate.Booking(sql.FieldNotNull(FieldCancellationReason))
+}
+
+// CancellationReasonEqualFold applies the EqualFold predicate on the "cancellation_reason" field.
+func CancellationReasonEqualFold(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldEqualFold(FieldCancellationReason, v))
+}
+
+// CancellationReasonContainsFold applies the ContainsFold predicate on the "cancellation_reason" field.
+func CancellationReasonContainsFold(v string) predicate.Booking {
+	return predicate.Booking(sql.FieldContainsFold(FieldCancellationReason, v))
+}
+
+// RefundAmountEQ applies the EQ predicate on the "refund_amount" field.
+func RefundAmountEQ(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldRefundAmount, v))
+}
+
+// RefundAmountNEQ applies the NEQ predicate on the "refund_amount" field.
+func RefundAmountNEQ(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldNEQ(FieldRefundAmount, v))
+}
+
+// RefundAmountIn applies the In predicate on the "refund_amount" field.
+func RefundAmountIn(vs ...int64) predicate.Booking {
+	return predicate.Booking(sql.FieldIn(FieldRefundAmount, vs...))
+}
+
+// RefundAmountNotIn applies the NotIn predicate on the "refund_amount" field.
+func RefundAmountNotIn(vs ...int64) predicate.Booking {
+	return predicate.Booking(sql.FieldNotIn(FieldRefundAmount, vs...))
+}
+
+// RefundAmountGT applies the GT predicate on the "refund_amount" field.
+func RefundAmountGT(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldGT(FieldRefundAmount, v))
+}
+
+// RefundAmountGTE applies the GTE predicate on the "refund_amount" field.
+func RefundAmountGTE(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldGTE(FieldRefundAmount, v))
+}
+
+// RefundAmountLT applies the LT predicate on the "refund_amount" field.
+func RefundAmountLT(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldLT(FieldRefundAmount, v))
+}
+
+// RefundAmountLTE applies the LTE predicate on the "refund_amount" field.
+func RefundAmountLTE(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldLTE(FieldRefundAmount, v))
+}
+
+// PenaltyAmountEQ applies the EQ predicate on the "penalty_amount" field.
+func PenaltyAmountEQ(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldEQ(FieldPenaltyAmount, v))
+}
+
+// PenaltyAmountNEQ applies the NEQ predicate on the "penalty_amount" field.
+func PenaltyAmountNEQ(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldNEQ(FieldPenaltyAmount, v))
+}
+
+// PenaltyAmountIn applies the In predicate on the "penalty_amount" field.
+func PenaltyAmountIn(vs ...int64) predicate.Booking {
+	return predicate.Booking(sql.FieldIn(FieldPenaltyAmount, vs...))
+}
+
+// PenaltyAmountNotIn applies the NotIn predicate on the "penalty_amount" field.
+func PenaltyAmountNotIn(vs ...int64) predicate.Booking {
+	return predicate.Booking(sql.FieldNotIn(FieldPenaltyAmount, vs...))
+}
+
+// PenaltyAmountGT applies the GT predicate on the "penalty_amount" field.
+func PenaltyAmountGT(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldGT(FieldPenaltyAmount, v))
+}
+
+// PenaltyAmountGTE applies the GTE predicate on the "penalty_amount" field.
+func PenaltyAmountGTE(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldGTE(FieldPenaltyAmount, v))
+}
+
+// PenaltyAmountLT applies the LT predicate on the "penalty_amount" field.
+func PenaltyAmountLT(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldLT(FieldPenaltyAmount, v))
+}
+
+// PenaltyAmountLTE applies the LTE predicate on the "penalty_amount" field.
+func PenaltyAmountLTE(v int64) predicate.Booking {
+	return predicate.Booking(sql.FieldLTE(FieldPenaltyAmount, v))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.Booking {
 	return predicate.Booking(sql.FieldEQ(FieldUpdatedAt, v))
@@ -786,6 +1576,29 @@ func HasPassengerWith(preds ...predicate.User) predicate.Booking {
 	})
 }
 
+// HasPayment applies the HasEdge predicate on the "payment" edge.
+func HasPayment() predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, PaymentTable, PaymentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentWith applies the HasEdge predicate on the "payment" edge with a given conditions (other predicates).
+func HasPaymentWith(preds ...predicate.Payment) predicate.Booking {
+	return predicate.Booking(func(s *sql.Selector) {
+		step := newPaymentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Booking) predicate.Booking {
 	return predicate.Booking(sql.AndPredicates(predicates...))
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/payment"
 	"github.com/slowtyper/poolie/backend/ent/ride"
 	"github.com/slowtyper/poolie/backend/ent/user"
 )
@@ -54,6 +55,62 @@ func (_c *BookingCreate) SetPassengerCount(v int) *BookingCreate {
 	return _c
 }
 
+// SetSubtotalAmount sets the "subtotal_amount" field.
+func (_c *BookingCreate) SetSubtotalAmount(v int64) *BookingCreate {
+	_c.mutation.SetSubtotalAmount(v)
+	return _c
+}
+
+// SetNillableSubtotalAmount sets the "subtotal_amount" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableSubtotalAmount(v *int64) *BookingCreate {
+	if v != nil {
+		_c.SetSubtotalAmount(*v)
+	}
+	return _c
+}
+
+// SetPromoCode sets the "promo_code" field.
+func (_c *BookingCreate) SetPromoCode(v string) *BookingCreate {
+	_c.mutation.SetPromoCode(v)
+	return _c
+}
+
+// SetNillablePromoCode sets the "promo_code" field if the given value is not nil.
+func (_c *BookingCreate) SetNillablePromoCode(v *string) *BookingCreate {
+	if v != nil {
+		_c.SetPromoCode(*v)
+	}
+	return _c
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_c *BookingCreate) SetDiscountAmount(v int64) *BookingCreate {
+	_c.mutation.SetDiscountAmount(v)
+	return _c
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableDiscountAmount(v *int64) *BookingCreate {
+	if v != nil {
+		_c.SetDiscountAmount(*v)
+	}
+	return _c
+}
+
+// SetCreditAmount sets the "credit_amount" field.
+func (_c *BookingCreate) SetCreditAmount(v int64) *BookingCreate {
+	_c.mutation.SetCreditAmount(v)
+	return _c
+}
+
+// SetNillableCreditAmount sets the "credit_amount" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableCreditAmount(v *int64) *BookingCreate {
+	if v != nil {
+		_c.SetCreditAmount(*v)
+	}
+	return _c
+}
+
 // SetTotalPriceAmount sets the "total_price_amount" field.
 func (_c *BookingCreate) SetTotalPriceAmount(v int64) *BookingCreate {
 	_c.mutation.SetTotalPriceAmount(v)
@@ -74,6 +131,62 @@ func (_c *BookingCreate) SetNillableTotalPriceCurrency(v *string) *BookingCreate
 	return _c
 }
 
+// SetDisplayCurrency sets the "display_currency" field.
+func (_c *BookingCreate) SetDisplayCurrency(v string) *BookingCreate {
+	_c.mutation.SetDisplayCurrency(v)
+	return _c
+}
+
+// SetNillableDisplayCurrency sets the "display_currency" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableDisplayCurrency(v *string) *BookingCreate {
+	if v != nil {
+		_c.SetDisplayCurrency(*v)
+	}
+	return _c
+}
+
+// SetDisplayTotalAmount sets the "display_total_amount" field.
+func (_c *BookingCreate) SetDisplayTotalAmount(v int64) *BookingCreate {
+	_c.mutation.SetDisplayTotalAmount(v)
+	return _c
+}
+
+// SetNillableDisplayTotalAmount sets the "display_total_amount" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableDisplayTotalAmount(v *int64) *BookingCreate {
+	if v != nil {
+		_c.SetDisplayTotalAmount(*v)
+	}
+	return _c
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_c *BookingCreate) SetExchangeRate(v string) *BookingCreate {
+	_c.mutation.SetExchangeRate(v)
+	return _c
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableExchangeRate(v *string) *BookingCreate {
+	if v != nil {
+		_c.SetExchangeRate(*v)
+	}
+	return _c
+}
+
+// SetExchangeRateAsOf sets the "exchange_rate_as_of" field.
+func (_c *BookingCreate) SetExchangeRateAsOf(v time.Time) *BookingCreate {
+	_c.mutation.SetExchangeRateAsOf(v)
+	return _c
+}
+
+// SetNillableExchangeRateAsOf sets the "exchange_rate_as_of" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableExchangeRateAsOf(v *time.Time) *BookingCreate {
+	if v != nil {
+		_c.SetExchangeRateAsOf(*v)
+	}
+	return _c
+}
+
 // SetMessage sets the "message" field.
 func (_c *BookingCreate) SetMessage(v string) *BookingCreate {
 	_c.mutation.SetMessage(v)
@@ -130,6 +243,76 @@ func (_c *BookingCreate) SetNillableRespondedAt(v *time.Time) *BookingCreate {
 	return _c
 }
 
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *BookingCreate) SetCancelledAt(v time.Time) *BookingCreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableCancelledAt(v *time.Time) *BookingCreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetCancelledBy sets the "cancelled_by" field.
+func (_c *BookingCreate) SetCancelledBy(v string) *BookingCreate {
+	_c.mutation.SetCancelledBy(v)
+	return _c
+}
+
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableCancelledBy(v *string) *BookingCreate {
+	if v != nil {
+		_c.SetCancelledBy(*v)
+	}
+	return _c
+}
+
+// SetCancellationReason sets the "cancellation_reason" field.
+func (_c *BookingCreate) SetCancellationReason(v string) *BookingCreate {
+	_c.mutation.SetCancellationReason(v)
+	return _c
+}
+
+// SetNillableCancellationReason sets the "cancellation_reason" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableCancellationReason(v *string) *BookingCreate {
+	if v != nil {
+		_c.SetCancellationReason(*v)
+	}
+	return _c
+}
+
+// SetRefundAmount sets the "refund_amount" field.
+func (_c *BookingCreate) SetRefundAmount(v int64) *BookingCreate {
+	_c.mutation.SetRefundAmount(v)
+	return _c
+}
+
+// SetNillableRefundAmount sets the "refund_amount" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableRefundAmount(v *int64) *BookingCreate {
+	if v != nil {
+		_c.SetRefundAmount(*v)
+	}
+	return _c
+}
+
+// SetPenaltyAmount sets the "penalty_amount" field.
+func (_c *BookingCreate) SetPenaltyAmount(v int64) *BookingCreate {
+	_c.mutation.SetPenaltyAmount(v)
+	return _c
+}
+
+// SetNillablePenaltyAmount sets the "penalty_amount" field if the given value is not nil.
+func (_c *BookingCreate) SetNillablePenaltyAmount(v *int64) *BookingCreate {
+	if v != nil {
+		_c.SetPenaltyAmount(*v)
+	}
+	return _c
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_c *BookingCreate) SetUpdatedAt(v time.Time) *BookingCreate {
 	_c.mutation.SetUpdatedAt(v)
@@ -150,6 +333,14 @@ func (_c *BookingCreate) SetID(v string) *BookingCreate {
 	return _c
 }
 
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BookingCreate) SetNillableID(v *string) *BookingCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
 // SetRide sets the "ride" edge to the Ride entity.
 func (_c *BookingCreate) SetRide(v *Ride) *BookingCreate {
 	return _c.SetRideID(v.ID)
@@ -160,6 +351,25 @@ func (_c *BookingCreate) SetPassenger(v *User) *BookingCreate {
 	return _c.SetPassengerID(v.ID)
 }
 
+// SetPaymentID sets the "payment" edge to the Payment entity by ID.
+func (_c *BookingCreate) SetPaymentID(id string) *BookingCreate {
+	_c.mutation.SetPaymentID(id)
+	return _c
+}
+
+// SetNillablePaymentID sets the "payment" edge to the Payment entity by ID if the given value is not nil.
+func (_c *BookingCreate) SetNillablePaymentID(id *string) *BookingCreate {
+	if id != nil {
+		_c = _c.SetPaymentID(*id)
+	}
+	return _c
+}
+
+// SetPayment sets the "payment" edge to the Payment entity.
+func (_c *BookingCreate) SetPayment(v *Payment) *BookingCreate {
+	return _c.SetPaymentID(v.ID)
+}
+
 // Mutation returns the BookingMutation object of the builder.
 func (_c *BookingCreate) Mutation() *BookingMutation {
 	return _c.mutation
@@ -199,6 +409,18 @@ func (_c *BookingCreate) defaults() {
 		v := booking.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.SubtotalAmount(); !ok {
+		v := booking.DefaultSubtotalAmount
+		_c.mutation.SetSubtotalAmount(v)
+	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		v := booking.DefaultDiscountAmount
+		_c.mutation.SetDiscountAmount(v)
+	}
+	if _, ok := _c.mutation.CreditAmount(); !ok {
+		v := booking.DefaultCreditAmount
+		_c.mutation.SetCreditAmount(v)
+	}
 	if _, ok := _c.mutation.TotalPriceCurrency(); !ok {
 		v := booking.DefaultTotalPriceCurrency
 		_c.mutation.SetTotalPriceCurrency(v)
@@ -207,10 +429,22 @@ func (_c *BookingCreate) defaults() {
 		v := booking.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.RefundAmount(); !ok {
+		v := booking.DefaultRefundAmount
+		_c.mutation.SetRefundAmount(v)
+	}
+	if _, ok := _c.mutation.PenaltyAmount(); !ok {
+		v := booking.DefaultPenaltyAmount
+		_c.mutation.SetPenaltyAmount(v)
+	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		v := booking.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := booking.DefaultID()
+		_c.mutation.SetID(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -242,6 +476,30 @@ func (_c *BookingCreate) check() error {
 			return &ValidationError{Name: "passenger_count", err: fmt.Errorf(`ent: validator failed for field "Booking.passenger_count": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.SubtotalAmount(); !ok {
+		return &ValidationError{Name: "subtotal_amount", err: errors.New(`ent: missing required field "Booking.subtotal_amount"`)}
+	}
+	if v, ok := _c.mutation.SubtotalAmount(); ok {
+		if err := booking.SubtotalAmountValidator(v); err != nil {
+			return &ValidationError{Name: "subtotal_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.subtotal_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`ent: missing required field "Booking.discount_amount"`)}
+	}
+	if v, ok := _c.mutation.DiscountAmount(); ok {
+		if err := booking.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.discount_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreditAmount(); !ok {
+		return &ValidationError{Name: "credit_amount", err: errors.New(`ent: missing required field "Booking.credit_amount"`)}
+	}
+	if v, ok := _c.mutation.CreditAmount(); ok {
+		if err := booking.CreditAmountValidator(v); err != nil {
+			return &ValidationError{Name: "credit_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.credit_amount": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.TotalPriceAmount(); !ok {
 		return &ValidationError{Name: "total_price_amount", err: errors.New(`ent: missing required field "Booking.total_price_amount"`)}
 	}
@@ -256,6 +514,22 @@ func (_c *BookingCreate) check() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Booking.created_at"`)}
 	}
+	if _, ok := _c.mutation.RefundAmount(); !ok {
+		return &ValidationError{Name: "refund_amount", err: errors.New(`ent: missing required field "Booking.refund_amount"`)}
+	}
+	if v, ok := _c.mutation.RefundAmount(); ok {
+		if err := booking.RefundAmountValidator(v); err != nil {
+			return &ValidationError{Name: "refund_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.refund_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PenaltyAmount(); !ok {
+		return &ValidationError{Name: "penalty_amount", err: errors.New(`ent: missing required field "Booking.penalty_amount"`)}
+	}
+	if v, ok := _c.mutation.PenaltyAmount(); ok {
+		if err := booking.PenaltyAmountValidator(v); err != nil {
+			return &ValidationError{Name: "penalty_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.penalty_amount": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Booking.updated_at"`)}
 	}
@@ -308,6 +582,22 @@ func (_c *BookingCreate) createSpec() (*Booking, *sqlgraph.CreateSpec) {
 		_spec.SetField(booking.FieldPassengerCount, field.TypeInt, value)
 		_node.PassengerCount = value
 	}
+	if value, ok := _c.mutation.SubtotalAmount(); ok {
+		_spec.SetField(booking.FieldSubtotalAmount, field.TypeInt64, value)
+		_node.SubtotalAmount = value
+	}
+	if value, ok := _c.mutation.PromoCode(); ok {
+		_spec.SetField(booking.FieldPromoCode, field.TypeString, value)
+		_node.PromoCode = value
+	}
+	if value, ok := _c.mutation.DiscountAmount(); ok {
+		_spec.SetField(booking.FieldDiscountAmount, field.TypeInt64, value)
+		_node.DiscountAmount = value
+	}
+	if value, ok := _c.mutation.CreditAmount(); ok {
+		_spec.SetField(booking.FieldCreditAmount, field.TypeInt64, value)
+		_node.CreditAmount = value
+	}
 	if value, ok := _c.mutation.TotalPriceAmount(); ok {
 		_spec.SetField(booking.FieldTotalPriceAmount, field.TypeInt64, value)
 		_node.TotalPriceAmount = value
@@ -316,6 +606,22 @@ func (_c *BookingCreate) createSpec() (*Booking, *sqlgraph.CreateSpec) {
 		_spec.SetField(booking.FieldTotalPriceCurrency, field.TypeString, value)
 		_node.TotalPriceCurrency = value
 	}
+	if value, ok := _c.mutation.DisplayCurrency(); ok {
+		_spec.SetField(booking.FieldDisplayCurrency, field.TypeString, value)
+		_node.DisplayCurrency = value
+	}
+	if value, ok := _c.mutation.DisplayTotalAmount(); ok {
+		_spec.SetField(booking.FieldDisplayTotalAmount, field.TypeInt64, value)
+		_node.DisplayTotalAmount = &value
+	}
+	if value, ok := _c.mutation.ExchangeRate(); ok {
+		_spec.SetField(booking.FieldExchangeRate, field.TypeString, value)
+		_node.ExchangeRate = value
+	}
+	if value, ok := _c.mutation.ExchangeRateAsOf(); ok {
+		_spec.SetField(booking.FieldExchangeRateAsOf, field.TypeTime, value)
+		_node.ExchangeRateAsOf = &value
+	}
 	if value, ok := _c.mutation.Message(); ok {
 		_spec.SetField(booking.FieldMessage, field.TypeString, value)
 		_node.Message = value
@@ -332,6 +638,26 @@ func (_c *BookingCreate) createSpec() (*Booking, *sqlgraph.CreateSpec) {
 		_spec.SetField(booking.FieldRespondedAt, field.TypeTime, value)
 		_node.RespondedAt = &value
 	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(booking.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := _c.mutation.CancelledBy(); ok {
+		_spec.SetField(booking.FieldCancelledBy, field.TypeString, value)
+		_node.CancelledBy = value
+	}
+	if value, ok := _c.mutation.CancellationReason(); ok {
+		_spec.SetField(booking.FieldCancellationReason, field.TypeString, value)
+		_node.CancellationReason = value
+	}
+	if value, ok := _c.mutation.RefundAmount(); ok {
+		_spec.SetField(booking.FieldRefundAmount, field.TypeInt64, value)
+		_node.RefundAmount = value
+	}
+	if value, ok := _c.mutation.PenaltyAmount(); ok {
+		_spec.SetField(booking.FieldPenaltyAmount, field.TypeInt64, value)
+		_node.PenaltyAmount = value
+	}
 	if value, ok := _c.mutation.UpdatedAt(); ok {
 		_spec.SetField(booking.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -370,6 +696,22 @@ func (_c *BookingCreate) createSpec() (*Booking, *sqlgraph.CreateSpec) {
 		_node.PassengerID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   booking.PaymentTable,
+			Columns: []string{booking.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/payment"
 	"github.com/slowtyper/poolie/backend/ent/predicate"
 	"github.com/slowtyper/poolie/backend/ent/ride"
 	"github.com/slowtyper/poolie/backend/ent/user"
@@ -93,6 +94,89 @@ func (_u *BookingUpdate) AddPassengerCount(v int) *BookingUpdate {
 	return _u
 }
 
+// SetSubtotalAmount sets the "subtotal_amount" field.
+func (_u *BookingUpdate) SetSubtotalAmount(v int64) *BookingUpdate {
+	_u.mutation.ResetSubtotalAmount()
+	_u.mutation.SetSubtotalAmount(v)
+	return _u
+}
+
+// SetNillableSubtotalAmount sets the "subtotal_amount" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableSubtotalAmount(v *int64) *BookingUpdate {
+	if v != nil {
+		_u.SetSubtotalAmount(*v)
+	}
+	return _u
+}
+
+// AddSubtotalAmount adds value to the "subtotal_amount" field.
+func (_u *BookingUpdate) AddSubtotalAmount(v int64) *BookingUpdate {
+	_u.mutation.AddSubtotalAmount(v)
+	return _u
+}
+
+// SetPromoCode sets the "promo_code" field.
+func (_u *BookingUpdate) SetPromoCode(v string) *BookingUpdate {
+	_u.mutation.SetPromoCode(v)
+	return _u
+}
+
+// SetNillablePromoCode sets the "promo_code" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillablePromoCode(v *string) *BookingUpdate {
+	if v != nil {
+		_u.SetPromoCode(*v)
+	}
+	return _u
+}
+
+// ClearPromoCode clears the value of the "promo_code" field.
+func (_u *BookingUpdate) ClearPromoCode() *BookingUpdate {
+	_u.mutation.ClearPromoCode()
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *BookingUpdate) SetDiscountAmount(v int64) *BookingUpdate {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableDiscountAmount(v *int64) *BookingUpdate {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *BookingUpdate) AddDiscountAmount(v int64) *BookingUpdate {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
+// SetCreditAmount sets the "credit_amount" field.
+func (_u *BookingUpdate) SetCreditAmount(v int64) *BookingUpdate {
+	_u.mutation.ResetCreditAmount()
+	_u.mutation.SetCreditAmount(v)
+	return _u
+}
+
+// SetNillableCreditAmount sets the "credit_amount" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableCreditAmount(v *int64) *BookingUpdate {
+	if v != nil {
+		_u.SetCreditAmount(*v)
+	}
+	return _u
+}
+
+// AddCreditAmount adds value to the "credit_amount" field.
+func (_u *BookingUpdate) AddCreditAmount(v int64) *BookingUpdate {
+	_u.mutation.AddCreditAmount(v)
+	return _u
+}
+
 // SetTotalPriceAmount sets the "total_price_amount" field.
 func (_u *BookingUpdate) SetTotalPriceAmount(v int64) *BookingUpdate {
 	_u.mutation.ResetTotalPriceAmount()
@@ -128,6 +212,93 @@ func (_u *BookingUpdate) SetNillableTotalPriceCurrency(v *string) *BookingUpdate
 	return _u
 }
 
+// SetDisplayCurrency sets the "display_currency" field.
+func (_u *BookingUpdate) SetDisplayCurrency(v string) *BookingUpdate {
+	_u.mutation.SetDisplayCurrency(v)
+	return _u
+}
+
+// SetNillableDisplayCurrency sets the "display_currency" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableDisplayCurrency(v *string) *BookingUpdate {
+	if v != nil {
+		_u.SetDisplayCurrency(*v)
+	}
+	return _u
+}
+
+// ClearDisplayCurrency clears the value of the "display_currency" field.
+func (_u *BookingUpdate) ClearDisplayCurrency() *BookingUpdate {
+	_u.mutation.ClearDisplayCurrency()
+	return _u
+}
+
+// SetDisplayTotalAmount sets the "display_total_amount" field.
+func (_u *BookingUpdate) SetDisplayTotalAmount(v int64) *BookingUpdate {
+	_u.mutation.ResetDisplayTotalAmount()
+	_u.mutation.SetDisplayTotalAmount(v)
+	return _u
+}
+
+// SetNillableDisplayTotalAmount sets the "display_total_amount" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableDisplayTotalAmount(v *int64) *BookingUpdate {
+	if v != nil {
+		_u.SetDisplayTotalAmount(*v)
+	}
+	return _u
+}
+
+// AddDisplayTotalAmount adds value to the "display_total_amount" field.
+func (_u *BookingUpdate) AddDisplayTotalAmount(v int64) *BookingUpdate {
+	_u.mutation.AddDisplayTotalAmount(v)
+	return _u
+}
+
+// ClearDisplayTotalAmount clears the value of the "display_total_amount" field.
+func (_u *BookingUpdate) ClearDisplayTotalAmount() *BookingUpdate {
+	_u.mutation.ClearDisplayTotalAmount()
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *BookingUpdate) SetExchangeRate(v string) *BookingUpdate {
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableExchangeRate(v *string) *BookingUpdate {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
+// ClearExchangeRate clears the value of the "exchange_rate" field.
+func (_u *BookingUpdate) ClearExchangeRate() *BookingUpdate {
+	_u.mutation.ClearExchangeRate()
+	return _u
+}
+
+// SetExchangeRateAsOf sets the "exchange_rate_as_of" field.
+func (_u *BookingUpdate) SetExchangeRateAsOf(v time.Time) *BookingUpdate {
+	_u.mutation.SetExchangeRateAsOf(v)
+	return _u
+}
+
+// SetNillableExchangeRateAsOf sets the "exchange_rate_as_of" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableExchangeRateAsOf(v *time.Time) *BookingUpdate {
+	if v != nil {
+		_u.SetExchangeRateAsOf(*v)
+	}
+	return _u
+}
+
+// ClearExchangeRateAsOf clears the value of the "exchange_rate_as_of" field.
+func (_u *BookingUpdate) ClearExchangeRateAsOf() *BookingUpdate {
+	_u.mutation.ClearExchangeRateAsOf()
+	return _u
+}
+
 // SetMessage sets the "message" field.
 func (_u *BookingUpdate) SetMessage(v string) *BookingUpdate {
 	_u.mutation.SetMessage(v)
@@ -188,6 +359,108 @@ func (_u *BookingUpdate) ClearRespondedAt() *BookingUpdate {
 	return _u
 }
 
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *BookingUpdate) SetCancelledAt(v time.Time) *BookingUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableCancelledAt(v *time.Time) *BookingUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *BookingUpdate) ClearCancelledAt() *BookingUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCancelledBy sets the "cancelled_by" field.
+func (_u *BookingUpdate) SetCancelledBy(v string) *BookingUpdate {
+	_u.mutation.SetCancelledBy(v)
+	return _u
+}
+
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableCancelledBy(v *string) *BookingUpdate {
+	if v != nil {
+		_u.SetCancelledBy(*v)
+	}
+	return _u
+}
+
+// ClearCancelledBy clears the value of the "cancelled_by" field.
+func (_u *BookingUpdate) ClearCancelledBy() *BookingUpdate {
+	_u.mutation.ClearCancelledBy()
+	return _u
+}
+
+// SetCancellationReason sets the "cancellation_reason" field.
+func (_u *BookingUpdate) SetCancellationReason(v string) *BookingUpdate {
+	_u.mutation.SetCancellationReason(v)
+	return _u
+}
+
+// SetNillableCancellationReason sets the "cancellation_reason" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableCancellationReason(v *string) *BookingUpdate {
+	if v != nil {
+		_u.SetCancellationReason(*v)
+	}
+	return _u
+}
+
+// ClearCancellationReason clears the value of the "cancellation_reason" field.
+func (_u *BookingUpdate) ClearCancellationReason() *BookingUpdate {
+	_u.mutation.ClearCancellationReason()
+	return _u
+}
+
+// SetRefundAmount sets the "refund_amount" field.
+func (_u *BookingUpdate) SetRefundAmount(v int64) *BookingUpdate {
+	_u.mutation.ResetRefundAmount()
+	_u.mutation.SetRefundAmount(v)
+	return _u
+}
+
+// SetNillableRefundAmount sets the "refund_amount" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillableRefundAmount(v *int64) *BookingUpdate {
+	if v != nil {
+		_u.SetRefundAmount(*v)
+	}
+	return _u
+}
+
+// AddRefundAmount adds value to the "refund_amount" field.
+func (_u *BookingUpdate) AddRefundAmount(v int64) *BookingUpdate {
+	_u.mutation.AddRefundAmount(v)
+	return _u
+}
+
+// SetPenaltyAmount sets the "penalty_amount" field.
+func (_u *BookingUpdate) SetPenaltyAmount(v int64) *BookingUpdate {
+	_u.mutation.ResetPenaltyAmount()
+	_u.mutation.SetPenaltyAmount(v)
+	return _u
+}
+
+// SetNillablePenaltyAmount sets the "penalty_amount" field if the given value is not nil.
+func (_u *BookingUpdate) SetNillablePenaltyAmount(v *int64) *BookingUpdate {
+	if v != nil {
+		_u.SetPenaltyAmount(*v)
+	}
+	return _u
+}
+
+// AddPenaltyAmount adds value to the "penalty_amount" field.
+func (_u *BookingUpdate) AddPenaltyAmount(v int64) *BookingUpdate {
+	_u.mutation.AddPenaltyAmount(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *BookingUpdate) SetUpdatedAt(v time.Time) *BookingUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -204,6 +477,25 @@ func (_u *BookingUpdate) SetPassenger(v *User) *BookingUpdate {
 	return _u.SetPassengerID(v.ID)
 }
 
+// SetPaymentID sets the "payment" edge to the Payment entity by ID.
+func (_u *BookingUpdate) SetPaymentID(id string) *BookingUpdate {
+	_u.mutation.SetPaymentID(id)
+	return _u
+}
+
+// SetNillablePaymentID sets the "payment" edge to the Payment entity by ID if the given value is not nil.
+func (_u *BookingUpdate) SetNillablePaymentID(id *string) *BookingUpdate {
+	if id != nil {
+		_u = _u.SetPaymentID(*id)
+	}
+	return _u
+}
+
+// SetPayment sets the "payment" edge to the Payment entity.
+func (_u *BookingUpdate) SetPayment(v *Payment) *BookingUpdate {
+	return _u.SetPaymentID(v.ID)
+}
+
 // Mutation returns the BookingMutation object of the builder.
 func (_u *BookingUpdate) Mutation() *BookingMutation {
 	return _u.mutation
@@ -221,6 +513,12 @@ func (_u *BookingUpdate) ClearPassenger() *BookingUpdate {
 	return _u
 }
 
+// ClearPayment clears the "payment" edge to the Payment entity.
+func (_u *BookingUpdate) ClearPayment() *BookingUpdate {
+	_u.mutation.ClearPayment()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *BookingUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -274,11 +572,36 @@ func (_u *BookingUpdate) check() error {
 			return &ValidationError{Name: "passenger_count", err: fmt.Errorf(`ent: validator failed for field "Booking.passenger_count": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SubtotalAmount(); ok {
+		if err := booking.SubtotalAmountValidator(v); err != nil {
+			return &ValidationError{Name: "subtotal_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.subtotal_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountAmount(); ok {
+		if err := booking.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.discount_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditAmount(); ok {
+		if err := booking.CreditAmountValidator(v); err != nil {
+			return &ValidationError{Name: "credit_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.credit_amount": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.TotalPriceAmount(); ok {
 		if err := booking.TotalPriceAmountValidator(v); err != nil {
 			return &ValidationError{Name: "total_price_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.total_price_amount": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.RefundAmount(); ok {
+		if err := booking.RefundAmountValidator(v); err != nil {
+			return &ValidationError{Name: "refund_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.refund_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PenaltyAmount(); ok {
+		if err := booking.PenaltyAmountValidator(v); err != nil {
+			return &ValidationError{Name: "penalty_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.penalty_amount": %w`, err)}
+		}
+	}
 	if _u.mutation.RideCleared() && len(_u.mutation.RideIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Booking.ride"`)
 	}
@@ -309,6 +632,30 @@ func (_u *BookingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedPassengerCount(); ok {
 		_spec.AddField(booking.FieldPassengerCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.SubtotalAmount(); ok {
+		_spec.SetField(booking.FieldSubtotalAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubtotalAmount(); ok {
+		_spec.AddField(booking.FieldSubtotalAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PromoCode(); ok {
+		_spec.SetField(booking.FieldPromoCode, field.TypeString, value)
+	}
+	if _u.mutation.PromoCodeCleared() {
+		_spec.ClearField(booking.FieldPromoCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(booking.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(booking.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreditAmount(); ok {
+		_spec.SetField(booking.FieldCreditAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreditAmount(); ok {
+		_spec.AddField(booking.FieldCreditAmount, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.TotalPriceAmount(); ok {
 		_spec.SetField(booking.FieldTotalPriceAmount, field.TypeInt64, value)
 	}
@@ -318,6 +665,33 @@ func (_u *BookingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.TotalPriceCurrency(); ok {
 		_spec.SetField(booking.FieldTotalPriceCurrency, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DisplayCurrency(); ok {
+		_spec.SetField(booking.FieldDisplayCurrency, field.TypeString, value)
+	}
+	if _u.mutation.DisplayCurrencyCleared() {
+		_spec.ClearField(booking.FieldDisplayCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.DisplayTotalAmount(); ok {
+		_spec.SetField(booking.FieldDisplayTotalAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDisplayTotalAmount(); ok {
+		_spec.AddField(booking.FieldDisplayTotalAmount, field.TypeInt64, value)
+	}
+	if _u.mutation.DisplayTotalAmountCleared() {
+		_spec.ClearField(booking.FieldDisplayTotalAmount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(booking.FieldExchangeRate, field.TypeString, value)
+	}
+	if _u.mutation.ExchangeRateCleared() {
+		_spec.ClearField(booking.FieldExchangeRate, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExchangeRateAsOf(); ok {
+		_spec.SetField(booking.FieldExchangeRateAsOf, field.TypeTime, value)
+	}
+	if _u.mutation.ExchangeRateAsOfCleared() {
+		_spec.ClearField(booking.FieldExchangeRateAsOf, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Message(); ok {
 		_spec.SetField(booking.FieldMessage, field.TypeString, value)
 	}
@@ -336,6 +710,36 @@ func (_u *BookingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.RespondedAtCleared() {
 		_spec.ClearField(booking.FieldRespondedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(booking.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(booking.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledBy(); ok {
+		_spec.SetField(booking.FieldCancelledBy, field.TypeString, value)
+	}
+	if _u.mutation.CancelledByCleared() {
+		_spec.ClearField(booking.FieldCancelledBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.CancellationReason(); ok {
+		_spec.SetField(booking.FieldCancellationReason, field.TypeString, value)
+	}
+	if _u.mutation.CancellationReasonCleared() {
+		_spec.ClearField(booking.FieldCancellationReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.RefundAmount(); ok {
+		_spec.SetField(booking.FieldRefundAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRefundAmount(); ok {
+		_spec.AddField(booking.FieldRefundAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PenaltyAmount(); ok {
+		_spec.SetField(booking.FieldPenaltyAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPenaltyAmount(); ok {
+		_spec.AddField(booking.FieldPenaltyAmount, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(booking.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -397,6 +801,35 @@ func (_u *BookingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PaymentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   booking.PaymentTable,
+			Columns: []string{booking.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   booking.PaymentTable,
+			Columns: []string{booking.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{booking.Label}
@@ -480,6 +913,89 @@ func (_u *BookingUpdateOne) AddPassengerCount(v int) *BookingUpdateOne {
 	return _u
 }
 
+// SetSubtotalAmount sets the "subtotal_amount" field.
+func (_u *BookingUpdateOne) SetSubtotalAmount(v int64) *BookingUpdateOne {
+	_u.mutation.ResetSubtotalAmount()
+	_u.mutation.SetSubtotalAmount(v)
+	return _u
+}
+
+// SetNillableSubtotalAmount sets the "subtotal_amount" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableSubtotalAmount(v *int64) *BookingUpdateOne {
+	if v != nil {
+		_u.SetSubtotalAmount(*v)
+	}
+	return _u
+}
+
+// AddSubtotalAmount adds value to the "subtotal_amount" field.
+func (_u *BookingUpdateOne) AddSubtotalAmount(v int64) *BookingUpdateOne {
+	_u.mutation.AddSubtotalAmount(v)
+	return _u
+}
+
+// SetPromoCode sets the "promo_code" field.
+func (_u *BookingUpdateOne) SetPromoCode(v string) *BookingUpdateOne {
+	_u.mutation.SetPromoCode(v)
+	return _u
+}
+
+// SetNillablePromoCode sets the "promo_code" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillablePromoCode(v *string) *BookingUpdateOne {
+	if v != nil {
+		_u.SetPromoCode(*v)
+	}
+	return _u
+}
+
+// ClearPromoCode clears the value of the "promo_code" field.
+func (_u *BookingUpdateOne) ClearPromoCode() *BookingUpdateOne {
+	_u.mutation.ClearPromoCode()
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *BookingUpdateOne) SetDiscountAmount(v int64) *BookingUpdateOne {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableDiscountAmount(v *int64) *BookingUpdateOne {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *BookingUpdateOne) AddDiscountAmount(v int64) *BookingUpdateOne {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
+// SetCreditAmount sets the "credit_amount" field.
+func (_u *BookingUpdateOne) SetCreditAmount(v int64) *BookingUpdateOne {
+	_u.mutation.ResetCreditAmount()
+	_u.mutation.SetCreditAmount(v)
+	return _u
+}
+
+// SetNillableCreditAmount sets the "credit_amount" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableCreditAmount(v *int64) *BookingUpdateOne {
+	if v != nil {
+		_u.SetCreditAmount(*v)
+	}
+	return _u
+}
+
+// AddCreditAmount adds value to the "credit_amount" field.
+func (_u *BookingUpdateOne) AddCreditAmount(v int64) *BookingUpdateOne {
+	_u.mutation.AddCreditAmount(v)
+	return _u
+}
+
 // SetTotalPriceAmount sets the "total_price_amount" field.
 func (_u *BookingUpdateOne) SetTotalPriceAmount(v int64) *BookingUpdateOne {
 	_u.mutation.ResetTotalPriceAmount()
@@ -515,6 +1031,93 @@ func (_u *BookingUpdateOne) SetNillableTotalPriceCurrency(v *string) *BookingUpd
 	return _u
 }
 
+// SetDisplayCurrency sets the "display_currency" field.
+func (_u *BookingUpdateOne) SetDisplayCurrency(v string) *BookingUpdateOne {
+	_u.mutation.SetDisplayCurrency(v)
+	return _u
+}
+
+// SetNillableDisplayCurrency sets the "display_currency" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableDisplayCurrency(v *string) *BookingUpdateOne {
+	if v != nil {
+		_u.SetDisplayCurrency(*v)
+	}
+	return _u
+}
+
+// ClearDisplayCurrency clears the value of the "display_currency" field.
+func (_u *BookingUpdateOne) ClearDisplayCurrency() *BookingUpdateOne {
+	_u.mutation.ClearDisplayCurrency()
+	return _u
+}
+
+// SetDisplayTotalAmount sets the "display_total_amount" field.
+func (_u *BookingUpdateOne) SetDisplayTotalAmount(v int64) *BookingUpdateOne {
+	_u.mutation.ResetDisplayTotalAmount()
+	_u.mutation.SetDisplayTotalAmount(v)
+	return _u
+}
+
+// SetNillableDisplayTotalAmount sets the "display_total_amount" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableDisplayTotalAmount(v *int64) *BookingUpdateOne {
+	if v != nil {
+		_u.SetDisplayTotalAmount(*v)
+	}
+	return _u
+}
+
+// AddDisplayTotalAmount adds value to the "display_total_amount" field.
+func (_u *BookingUpdateOne) AddDisplayTotalAmount(v int64) *BookingUpdateOne {
+	_u.mutation.AddDisplayTotalAmount(v)
+	return _u
+}
+
+// ClearDisplayTotalAmount clears the value of the "display_total_amount" field.
+func (_u *BookingUpdateOne) ClearDisplayTotalAmount() *BookingUpdateOne {
+	_u.mutation.ClearDisplayTotalAmount()
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *BookingUpdateOne) SetExchangeRate(v string) *BookingUpdateOne {
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableExchangeRate(v *string) *BookingUpdateOne {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
+// ClearExchangeRate clears the value of the "exchange_rate" field.
+func (_u *BookingUpdateOne) ClearExchangeRate() *BookingUpdateOne {
+	_u.mutation.ClearExchangeRate()
+	return _u
+}
+
+// SetExchangeRateAsOf sets the "exchange_rate_as_of" field.
+func (_u *BookingUpdateOne) SetExchangeRateAsOf(v time.Time) *BookingUpdateOne {
+	_u.mutation.SetExchangeRateAsOf(v)
+	return _u
+}
+
+// SetNillableExchangeRateAsOf sets the "exchange_rate_as_of" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableExchangeRateAsOf(v *time.Time) *BookingUpdateOne {
+	if v != nil {
+		_u.SetExchangeRateAsOf(*v)
+	}
+	return _u
+}
+
+// ClearExchangeRateAsOf clears the value of the "exchange_rate_as_of" field.
+func (_u *BookingUpdateOne) ClearExchangeRateAsOf() *BookingUpdateOne {
+	_u.mutation.ClearExchangeRateAsOf()
+	return _u
+}
+
 // SetMessage sets the "message" field.
 func (_u *BookingUpdateOne) SetMessage(v string) *BookingUpdateOne {
 	_u.mutation.SetMessage(v)
@@ -575,6 +1178,108 @@ func (_u *BookingUpdateOne) ClearRespondedAt() *BookingUpdateOne {
 	return _u
 }
 
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *BookingUpdateOne) SetCancelledAt(v time.Time) *BookingUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableCancelledAt(v *time.Time) *BookingUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *BookingUpdateOne) ClearCancelledAt() *BookingUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetCancelledBy sets the "cancelled_by" field.
+func (_u *BookingUpdateOne) SetCancelledBy(v string) *BookingUpdateOne {
+	_u.mutation.SetCancelledBy(v)
+	return _u
+}
+
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableCancelledBy(v *string) *BookingUpdateOne {
+	if v != nil {
+		_u.SetCancelledBy(*v)
+	}
+	return _u
+}
+
+// ClearCancelledBy clears the value of the "cancelled_by" field.
+func (_u *BookingUpdateOne) ClearCancelledBy() *BookingUpdateOne {
+	_u.mutation.ClearCancelledBy()
+	return _u
+}
+
+// SetCancellationReason sets the "cancellation_reason" field.
+func (_u *BookingUpdateOne) SetCancellationReason(v string) *BookingUpdateOne {
+	_u.mutation.SetCancellationReason(v)
+	return _u
+}
+
+// SetNillableCancellationReason sets the "cancellation_reason" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableCancellationReason(v *string) *BookingUpdateOne {
+	if v != nil {
+		_u.SetCancellationReason(*v)
+	}
+	return _u
+}
+
+// ClearCancellationReason clears the value of the "cancellation_reason" field.
+func (_u *BookingUpdateOne) ClearCancellationReason() *BookingUpdateOne {
+	_u.mutation.ClearCancellationReason()
+	return _u
+}
+
+// SetRefundAmount sets the "refund_amount" field.
+func (_u *BookingUpdateOne) SetRefundAmount(v int64) *BookingUpdateOne {
+	_u.mutation.ResetRefundAmount()
+	_u.mutation.SetRefundAmount(v)
+	return _u
+}
+
+// SetNillableRefundAmount sets the "refund_amount" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillableRefundAmount(v *int64) *BookingUpdateOne {
+	if v != nil {
+		_u.SetRefundAmount(*v)
+	}
+	return _u
+}
+
+// AddRefundAmount adds value to the "refund_amount" field.
+func (_u *BookingUpdateOne) AddRefundAmount(v int64) *BookingUpdateOne {
+	_u.mutation.AddRefundAmount(v)
+	return _u
+}
+
+// SetPenaltyAmount sets the "penalty_amount" field.
+func (_u *BookingUpdateOne) SetPenaltyAmount(v int64) *BookingUpdateOne {
+	_u.mutation.ResetPenaltyAmount()
+	_u.mutation.SetPenaltyAmount(v)
+	return _u
+}
+
+// SetNillablePenaltyAmount sets the "penalty_amount" field if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillablePenaltyAmount(v *int64) *BookingUpdateOne {
+	if v != nil {
+		_u.SetPenaltyAmount(*v)
+	}
+	return _u
+}
+
+// AddPenaltyAmount adds value to the "penalty_amount" field.
+func (_u *BookingUpdateOne) AddPenaltyAmount(v int64) *BookingUpdateOne {
+	_u.mutation.AddPenaltyAmount(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *BookingUpdateOne) SetUpdatedAt(v time.Time) *BookingUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -591,6 +1296,25 @@ func (_u *BookingUpdateOne) SetPassenger(v *User) *BookingUpdateOne {
 	return _u.SetPassengerID(v.ID)
 }
 
+// SetPaymentID sets the "payment" edge to the Payment entity by ID.
+func (_u *BookingUpdateOne) SetPaymentID(id string) *BookingUpdateOne {
+	_u.mutation.SetPaymentID(id)
+	return _u
+}
+
+// SetNillablePaymentID sets the "payment" edge to the Payment entity by ID if the given value is not nil.
+func (_u *BookingUpdateOne) SetNillablePaymentID(id *string) *BookingUpdateOne {
+	if id != nil {
+		_u = _u.SetPaymentID(*id)
+	}
+	return _u
+}
+
+// SetPayment sets the "payment" edge to the Payment entity.
+func (_u *BookingUpdateOne) SetPayment(v *Payment) *BookingUpdateOne {
+	return _u.SetPaymentID(v.ID)
+}
+
 // Mutation returns the BookingMutation object of the builder.
 func (_u *BookingUpdateOne) Mutation() *BookingMutation {
 	return _u.mutation
@@ -608,6 +1332,12 @@ func (_u *BookingUpdateOne) ClearPassenger() *BookingUpdateOne {
 	return _u
 }
 
+// ClearPayment clears the "payment" edge to the Payment entity.
+func (_u *BookingUpdateOne) ClearPayment() *BookingUpdateOne {
+	_u.mutation.ClearPayment()
+	return _u
+}
+
 // Where appends a list predicates to the BookingUpdate builder.
 func (_u *BookingUpdateOne) Where(ps ...predicate.Booking) *BookingUpdateOne {
 	_u.mutation.Where(ps...)
@@ -674,11 +1404,36 @@ func (_u *BookingUpdateOne) check() error {
 			return &ValidationError{Name: "passenger_count", err: fmt.Errorf(`ent: validator failed for field "Booking.passenger_count": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SubtotalAmount(); ok {
+		if err := booking.SubtotalAmountValidator(v); err != nil {
+			return &ValidationError{Name: "subtotal_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.subtotal_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountAmount(); ok {
+		if err := booking.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.discount_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CreditAmount(); ok {
+		if err := booking.CreditAmountValidator(v); err != nil {
+			return &ValidationError{Name: "credit_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.credit_amount": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.TotalPriceAmount(); ok {
 		if err := booking.TotalPriceAmountValidator(v); err != nil {
 			return &ValidationError{Name: "total_price_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.total_price_amount": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.RefundAmount(); ok {
+		if err := booking.RefundAmountValidator(v); err != nil {
+			return &ValidationError{Name: "refund_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.refund_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PenaltyAmount(); ok {
+		if err := booking.PenaltyAmountValidator(v); err != nil {
+			return &ValidationError{Name: "penalty_amount", err: fmt.Errorf(`ent: validator failed for field "Booking.penalty_amount": %w`, err)}
+		}
+	}
 	if _u.mutation.RideCleared() && len(_u.mutation.RideIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Booking.ride"`)
 	}
@@ -726,6 +1481,30 @@ func (_u *BookingUpdateOne) sqlSave(ctx context.Context) (_node *Booking, err er
 	if value, ok := _u.mutation.AddedPassengerCount(); ok {
 		_spec.AddField(booking.FieldPassengerCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.SubtotalAmount(); ok {
+		_spec.SetField(booking.FieldSubtotalAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSubtotalAmount(); ok {
+		_spec.AddField(booking.FieldSubtotalAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PromoCode(); ok {
+		_spec.SetField(booking.FieldPromoCode, field.TypeString, value)
+	}
+	if _u.mutation.PromoCodeCleared() {
+		_spec.ClearField(booking.FieldPromoCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(booking.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(booking.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreditAmount(); ok {
+		_spec.SetField(booking.FieldCreditAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreditAmount(); ok {
+		_spec.AddField(booking.FieldCreditAmount, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.TotalPriceAmount(); ok {
 		_spec.SetField(booking.FieldTotalPriceAmount, field.TypeInt64, value)
 	}
@@ -735,6 +1514,33 @@ func (_u *BookingUpdateOne) sqlSave(ctx context.Context) (_node *Booking, err er
 	if value, ok := _u.mutation.TotalPriceCurrency(); ok {
 		_spec.SetField(booking.FieldTotalPriceCurrency, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DisplayCurrency(); ok {
+		_spec.SetField(booking.FieldDisplayCurrency, field.TypeString, value)
+	}
+	if _u.mutation.DisplayCurrencyCleared() {
+		_spec.ClearField(booking.FieldDisplayCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.DisplayTotalAmount(); ok {
+		_spec.SetField(booking.FieldDisplayTotalAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDisplayTotalAmount(); ok {
+		_spec.AddField(booking.FieldDisplayTotalAmount, field.TypeInt64, value)
+	}
+	if _u.mutation.DisplayTotalAmountCleared() {
+		_spec.ClearField(booking.FieldDisplayTotalAmount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(booking.FieldExchangeRate, field.TypeString, value)
+	}
+	if _u.mutation.ExchangeRateCleared() {
+		_spec.ClearField(booking.FieldExchangeRate, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExchangeRateAsOf(); ok {
+		_spec.SetField(booking.FieldExchangeRateAsOf, field.TypeTime, value)
+	}
+	if _u.mutation.ExchangeRateAsOfCleared() {
+		_spec.ClearField(booking.FieldExchangeRateAsOf, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Message(); ok {
 		_spec.SetField(booking.FieldMessage, field.TypeString, value)
 	}
@@ -753,6 +1559,36 @@ func (_u *BookingUpdateOne) sqlSave(ctx context.Context) (_node *Booking, err er
 	if _u.mutation.RespondedAtCleared() {
 		_spec.ClearField(booking.FieldRespondedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(booking.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(booking.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelledBy(); ok {
+		_spec.SetField(booking.FieldCancelledBy, field.TypeString, value)
+	}
+	if _u.mutation.CancelledByCleared() {
+		_spec.ClearField(booking.FieldCancelledBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.CancellationReason(); ok {
+		_spec.SetField(booking.FieldCancellationReason, field.TypeString, value)
+	}
+	if _u.mutation.CancellationReasonCleared() {
+		_spec.ClearField(booking.FieldCancellationReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.RefundAmount(); ok {
+		_spec.SetField(booking.FieldRefundAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRefundAmount(); ok {
+		_spec.AddField(booking.FieldRefundAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PenaltyAmount(); ok {
+		_spec.SetField(booking.FieldPenaltyAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPenaltyAmount(); ok {
+		_spec.AddField(booking.FieldPenaltyAmount, field.TypeInt64, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(booking.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -814,6 +1650,35 @@ func (_u *BookingUpdateOne) sqlSave(ctx context.Context) (_node *Booking, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PaymentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   booking.PaymentTable,
+			Columns: []string{booking.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   booking.PaymentTable,
+			Columns: []string{booking.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Booking{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,540 @@
+// Code generated by ent, DO NOT EDIT.
+
+package credit
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/slowtyper/poolie/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Credit {
+	return predicate.Credit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Credit {
+	return predicate.Credit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Credit {
+	return predicate.Credit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Credit {
+	return predicate.Credit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Credit {
+	return predicate.Credit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Credit {
+	return predicate.Credit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Credit {
+	return predicate.Credit(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Credit {
+	return predicate.Credit(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Credit {
+	return predicate.Credit(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldUserID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldKind, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v int64) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldCurrency, v))
+}
+
+// SourceUserID applies equality check predicate on the "source_user_id" field. It's identical to SourceUserIDEQ.
+func SourceUserID(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldSourceUserID, v))
+}
+
+// BookingID applies equality check predicate on the "booking_id" field. It's identical to BookingIDEQ.
+func BookingID(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldBookingID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.Credit {
+	return predicate.Credit(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.Credit {
+	return predicate.Credit(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.Credit {
+	return predicate.Credit(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.Credit {
+	return predicate.Credit(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldContainsFold(FieldKind, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v int64) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v int64) predicate.Credit {
+	return predicate.Credit(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...int64) predicate.Credit {
+	return predicate.Credit(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...int64) predicate.Credit {
+	return predicate.Credit(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v int64) predicate.Credit {
+	return predicate.Credit(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v int64) predicate.Credit {
+	return predicate.Credit(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v int64) predicate.Credit {
+	return predicate.Credit(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v int64) predicate.Credit {
+	return predicate.Credit(sql.FieldLTE(FieldAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Credit {
+	return predicate.Credit(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Credit {
+	return predicate.Credit(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// SourceUserIDEQ applies the EQ predicate on the "source_user_id" field.
+func SourceUserIDEQ(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldSourceUserID, v))
+}
+
+// SourceUserIDNEQ applies the NEQ predicate on the "source_user_id" field.
+func SourceUserIDNEQ(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldNEQ(FieldSourceUserID, v))
+}
+
+// SourceUserIDIn applies the In predicate on the "source_user_id" field.
+func SourceUserIDIn(vs ...string) predicate.Credit {
+	return predicate.Credit(sql.FieldIn(FieldSourceUserID, vs...))
+}
+
+// SourceUserIDNotIn applies the NotIn predicate on the "source_user_id" field.
+func SourceUserIDNotIn(vs ...string) predicate.Credit {
+	return predicate.Credit(sql.FieldNotIn(FieldSourceUserID, vs...))
+}
+
+// SourceUserIDGT applies the GT predicate on the "source_user_id" field.
+func SourceUserIDGT(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldGT(FieldSourceUserID, v))
+}
+
+// SourceUserIDGTE applies the GTE predicate on the "source_user_id" field.
+func SourceUserIDGTE(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldGTE(FieldSourceUserID, v))
+}
+
+// SourceUserIDLT applies the LT predicate on the "source_user_id" field.
+func SourceUserIDLT(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldLT(FieldSourceUserID, v))
+}
+
+// SourceUserIDLTE applies the LTE predicate on the "source_user_id" field.
+func SourceUserIDLTE(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldLTE(FieldSourceUserID, v))
+}
+
+// SourceUserIDContains applies the Contains predicate on the "source_user_id" field.
+func SourceUserIDContains(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldContains(FieldSourceUserID, v))
+}
+
+// SourceUserIDHasPrefix applies the HasPrefix predicate on the "source_user_id" field.
+func SourceUserIDHasPrefix(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldHasPrefix(FieldSourceUserID, v))
+}
+
+// SourceUserIDHasSuffix applies the HasSuffix predicate on the "source_user_id" field.
+func SourceUserIDHasSuffix(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldHasSuffix(FieldSourceUserID, v))
+}
+
+// SourceUserIDIsNil applies the IsNil predicate on the "source_user_id" field.
+func SourceUserIDIsNil() predicate.Credit {
+	return predicate.Credit(sql.FieldIsNull(FieldSourceUserID))
+}
+
+// SourceUserIDNotNil applies the NotNil predicate on the "source_user_id" field.
+func SourceUserIDNotNil() predicate.Credit {
+	return predicate.Credit(sql.FieldNotNull(FieldSourceUserID))
+}
+
+// SourceUserIDEqualFold applies the EqualFold predicate on the "source_user_id" field.
+func SourceUserIDEqualFold(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEqualFold(FieldSourceUserID, v))
+}
+
+// SourceUserIDContainsFold applies the ContainsFold predicate on the "source_user_id" field.
+func SourceUserIDContainsFold(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldContainsFold(FieldSourceUserID, v))
+}
+
+// BookingIDEQ applies the EQ predicate on the "booking_id" field.
+func BookingIDEQ(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldBookingID, v))
+}
+
+// BookingIDNEQ applies the NEQ predicate on the "booking_id" field.
+func BookingIDNEQ(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldNEQ(FieldBookingID, v))
+}
+
+// BookingIDIn applies the In predicate on the "booking_id" field.
+func BookingIDIn(vs ...string) predicate.Credit {
+	return predicate.Credit(sql.FieldIn(FieldBookingID, vs...))
+}
+
+// BookingIDNotIn applies the NotIn predicate on the "booking_id" field.
+func BookingIDNotIn(vs ...string) predicate.Credit {
+	return predicate.Credit(sql.FieldNotIn(FieldBookingID, vs...))
+}
+
+// BookingIDGT applies the GT predicate on the "booking_id" field.
+func BookingIDGT(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldGT(FieldBookingID, v))
+}
+
+// BookingIDGTE applies the GTE predicate on the "booking_id" field.
+func BookingIDGTE(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldGTE(FieldBookingID, v))
+}
+
+// BookingIDLT applies the LT predicate on the "booking_id" field.
+func BookingIDLT(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldLT(FieldBookingID, v))
+}
+
+// BookingIDLTE applies the LTE predicate on the "booking_id" field.
+func BookingIDLTE(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldLTE(FieldBookingID, v))
+}
+
+// BookingIDContains applies the Contains predicate on the "booking_id" field.
+func BookingIDContains(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldContains(FieldBookingID, v))
+}
+
+// BookingIDHasPrefix applies the HasPrefix predicate on the "booking_id" field.
+func BookingIDHasPrefix(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldHasPrefix(FieldBookingID, v))
+}
+
+// BookingIDHasSuffix applies the HasSuffix predicate on the "booking_id" field.
+func BookingIDHasSuffix(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldHasSuffix(FieldBookingID, v))
+}
+
+// BookingIDIsNil applies the IsNil predicate on the "booking_id" field.
+func BookingIDIsNil() predicate.Credit {
+	return predicate.Credit(sql.FieldIsNull(FieldBookingID))
+}
+
+// BookingIDNotNil applies the NotNil predicate on the "booking_id" field.
+func BookingIDNotNil() predicate.Credit {
+	return predicate.Credit(sql.FieldNotNull(FieldBookingID))
+}
+
+// BookingIDEqualFold applies the EqualFold predicate on the "booking_id" field.
+func BookingIDEqualFold(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldEqualFold(FieldBookingID, v))
+}
+
+// BookingIDContainsFold applies the ContainsFold predicate on the "booking_id" field.
+func BookingIDContainsFold(v string) predicate.Credit {
+	return predicate.Credit(sql.FieldContainsFold(FieldBookingID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Credit {
+	return predicate.Credit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Credit {
+	return predicate.Credit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Credit {
+	return predicate.Credit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Credit {
+	return predicate.Credit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Credit {
+	return predicate.Credit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Credit {
+	return predicate.Credit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Credit {
+	return predicate.Credit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Credit {
+	return predicate.Credit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Credit) predicate.Credit {
+	return predicate.Credit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Credit) predicate.Credit {
+	return predicate.Credit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Credit) predicate.Credit {
+	return predicate.Credit(sql.NotPredicates(p))
+}
@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/slowtyper/poolie/backend/ent/credit"
+	"github.com/slowtyper/poolie/backend/ent/predicate"
+)
+
+// CreditUpdate is the builder for updating Credit entities.
+type CreditUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CreditMutation
+}
+
+// Where appends a list predicates to the CreditUpdate builder.
+func (_u *CreditUpdate) Where(ps ...predicate.Credit) *CreditUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *CreditUpdate) SetUserID(v string) *CreditUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *CreditUpdate) SetNillableUserID(v *string) *CreditUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *CreditUpdate) SetKind(v string) *CreditUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *CreditUpdate) SetNillableKind(v *string) *CreditUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *CreditUpdate) SetAmount(v int64) *CreditUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *CreditUpdate) SetNillableAmount(v *int64) *CreditUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *CreditUpdate) AddAmount(v int64) *CreditUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *CreditUpdate) SetCurrency(v string) *CreditUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *CreditUpdate) SetNillableCurrency(v *string) *CreditUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetSourceUserID sets the "source_user_id" field.
+func (_u *CreditUpdate) SetSourceUserID(v string) *CreditUpdate {
+	_u.mutation.SetSourceUserID(v)
+	return _u
+}
+
+// SetNillableSourceUserID sets the "source_user_id" field if the given value is not nil.
+func (_u *CreditUpdate) SetNillableSourceUserID(v *string) *CreditUpdate {
+	if v != nil {
+		_u.SetSourceUserID(*v)
+	}
+	return _u
+}
+
+// ClearSourceUserID clears the value of the "source_user_id" field.
+func (_u *CreditUpdate) ClearSourceUserID() *CreditUpdate {
+	_u.mutation.ClearSourceUserID()
+	return _u
+}
+
+// SetBookingID sets the "booking_id" field.
+func (_u *CreditUpdate) SetBookingID(v string) *CreditUpdate {
+	_u.mutation.SetBookingID(v)
+	return _u
+}
+
+// SetNillableBookingID sets the "booking_id" field if the given value is not nil.
+func (_u *CreditUpdate) SetNillableBookingID(v *string) *CreditUpdate {
+	if v != nil {
+		_u.SetBookingID(*v)
+	}
+	return _u
+}
+
+// ClearBookingID clears the value of the "booking_id" field.
+func (_u *CreditUpdate) ClearBookingID() *CreditUpdate {
+	_u.mutation.ClearBookingID()
+	return _u
+}
+
+// Mutation returns the CreditMutation object of the builder.
+func (_u *CreditUpdate) Mutation() *CreditMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CreditUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CreditUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CreditUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CreditUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CreditUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := credit.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Credit.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := credit.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Credit.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CreditUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(credit.Table, credit.Columns, sqlgraph.NewFieldSpec(credit.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(credit.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(credit.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(credit.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(credit.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(credit.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SourceUserID(); ok {
+		_spec.SetField(credit.FieldSourceUserID, field.TypeString, value)
+	}
+	if _u.mutation.SourceUserIDCleared() {
+		_spec.ClearField(credit.FieldSourceUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.BookingID(); ok {
+		_spec.SetField(credit.FieldBookingID, field.TypeString, value)
+	}
+	if _u.mutation.BookingIDCleared() {
+		_spec.ClearField(credit.FieldBookingID, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CreditUpdateOne is the builder for updating a single Credit entity.
+type CreditUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CreditMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *CreditUpdateOne) SetUserID(v string) *CreditUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *CreditUpdateOne) SetNillableUserID(v *string) *CreditUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *CreditUpdateOne) SetKind(v string) *CreditUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *CreditUpdateOne) SetNillableKind(v *string) *CreditUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *CreditUpdateOne) SetAmount(v int64) *CreditUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *CreditUpdateOne) SetNillableAmount(v *int64) *CreditUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *CreditUpdateOne) AddAmount(v int64) *CreditUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *CreditUpdateOne) SetCurrency(v string) *CreditUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *CreditUpdateOne) SetNillableCurrency(v *string) *CreditUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetSourceUserID sets the "source_user_id" field.
+func (_u *CreditUpdateOne) SetSourceUserID(v string) *CreditUpdateOne {
+	_u.mutation.SetSourceUserID(v)
+	return _u
+}
+
+// SetNillableSourceUserID sets the "source_user_id" field if the given value is not nil.
+func (_u *CreditUpdateOne) SetNillableSourceUserID(v *string) *CreditUpdateOne {
+	if v != nil {
+		_u.SetSourceUserID(*v)
+	}
+	return _u
+}
+
+// ClearSourceUserID clears the value of the "source_user_id" field.
+func (_u *CreditUpdateOne) ClearSourceUserID() *CreditUpdateOne {
+	_u.mutation.ClearSourceUserID()
+	return _u
+}
+
+// SetBookingID sets the "booking_id" field.
+func (_u *CreditUpdateOne) SetBookingID(v string) *CreditUpdateOne {
+	_u.mutation.SetBookingID(v)
+	return _u
+}
+
+// SetNillableBookingID sets the "booking_id" field if the given value is not nil.
+func (_u *CreditUpdateOne) SetNillableBookingID(v *string) *CreditUpdateOne {
+	if v != nil {
+		_u.SetBookingID(*v)
+	}
+	return _u
+}
+
+// ClearBookingID clears the value of the "booking_id" field.
+func (_u *CreditUpdateOne) ClearBookingID() *CreditUpdateOne {
+	_u.mutation.ClearBookingID()
+	return _u
+}
+
+// Mutation returns the CreditMutation object of the builder.
+func (_u *CreditUpdateOne) Mutation() *CreditMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CreditUpdate builder.
+func (_u *CreditUpdateOne) Where(ps ...predicate.Credit) *CreditUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CreditUpdateOne) Select(field string, fields ...string) *CreditUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Credit entity.
+func (_u *CreditUpdateOne) Save(ctx context.Context) (*Credit, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CreditUpdateOne) SaveX(ctx context.Context) *Credit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CreditUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CreditUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CreditUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := credit.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Credit.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := credit.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Credit.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CreditUpdateOne) sqlSave(ctx context.Context) (_node *Credit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(credit.Table, credit.Columns, sqlgraph.NewFieldSpec(credit.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Credit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, credit.FieldID)
+		for _, f := range fields {
+			if !credit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != credit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(credit.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(credit.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(credit.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(credit.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(credit.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SourceUserID(); ok {
+		_spec.SetField(credit.FieldSourceUserID, field.TypeString, value)
+	}
+	if _u.mutation.SourceUserIDCleared() {
+		_spec.ClearField(credit.FieldSourceUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.BookingID(); ok {
+		_spec.SetField(credit.FieldBookingID, field.TypeString, value)
+	}
+	if _u.mutation.BookingIDCleared() {
+		_spec.ClearField(credit.FieldBookingID, field.TypeString)
+	}
+	_node = &Credit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -13,6 +13,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/credit"
+	"github.com/slowtyper/poolie/backend/ent/idempotencykey"
+	"github.com/slowtyper/poolie/backend/ent/ledgerentry"
+	"github.com/slowtyper/poolie/backend/ent/payment"
+	"github.com/slowtyper/poolie/backend/ent/paymentevent"
+	"github.com/slowtyper/poolie/backend/ent/payout"
+	"github.com/slowtyper/poolie/backend/ent/promocode"
+	"github.com/slowtyper/poolie/backend/ent/promoredemption"
+	"github.com/slowtyper/poolie/backend/ent/ratetable"
 	"github.com/slowtyper/poolie/backend/ent/ride"
 	"github.com/slowtyper/poolie/backend/ent/user"
 	"github.com/slowtyper/poolie/backend/ent/vehicle"
@@ -76,10 +85,19 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			booking.Table: booking.ValidColumn,
-			ride.Table:    ride.ValidColumn,
-			user.Table:    user.ValidColumn,
-			vehicle.Table: vehicle.ValidColumn,
+			booking.Table:         booking.ValidColumn,
+			credit.Table:          credit.ValidColumn,
+			idempotencykey.Table:  idempotencykey.ValidColumn,
+			ledgerentry.Table:     ledgerentry.ValidColumn,
+			payment.Table:         payment.ValidColumn,
+			paymentevent.Table:    paymentevent.ValidColumn,
+			payout.Table:          payout.ValidColumn,
+			promocode.Table:       promocode.ValidColumn,
+			promoredemption.Table: promoredemption.ValidColumn,
+			ratetable.Table:       ratetable.ValidColumn,
+			ride.Table:            ride.ValidColumn,
+			user.Table:            user.ValidColumn,
+			vehicle.Table:         vehicle.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,44 @@
+//go:build ignore
+
+package main
+
+import (
+	"log"
+	"os"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+	"github.com/99designs/gqlgen/api"
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+func main() {
+	// The GraphQL schema of the ent types is written next to the
+	// hand-written one in internal/graph, which gqlgen then compiles
+	ex, err := entgql.NewExtension(
+		entgql.WithSchemaGenerator(),
+		entgql.WithSchemaPath("../internal/graph/ent.graphql"),
+		entgql.WithConfigPath("../gqlgen.yml"),
+		entgql.WithWhereInputs(true),
+	)
+	if err != nil {
+		log.Fatalf("failed to create entgql extension: %v", err)
+	}
+
+	if err := entc.Generate("./schema", &gen.Config{}, entc.Extensions(ex)); err != nil {
+		log.Fatalf("failed to run ent codegen: %v", err)
+	}
+
+	// gqlgen resolves the paths in its config from the working directory
+	if err := os.Chdir(".."); err != nil {
+		log.Fatalf("failed to change directory: %v", err)
+	}
+	cfg, err := config.LoadConfig("gqlgen.yml")
+	if err != nil {
+		log.Fatalf("failed to load gqlgen config: %v", err)
+	}
+	if err := api.Generate(cfg); err != nil {
+		log.Fatalf("failed to run gqlgen: %v", err)
+	}
+}
@@ -0,0 +1,894 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect/sql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/ride"
+	"github.com/slowtyper/poolie/backend/ent/user"
+	"github.com/slowtyper/poolie/backend/ent/vehicle"
+)
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *BookingQuery) CollectFields(ctx context.Context, satisfies ...string) (*BookingQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *BookingQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(booking.Columns))
+		selectedFields = []string{booking.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "ride":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&RideClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, rideImplementors)...); err != nil {
+				return err
+			}
+			_q.withRide = query
+			if _, ok := fieldSeen[booking.FieldRideID]; !ok {
+				selectedFields = append(selectedFields, booking.FieldRideID)
+				fieldSeen[booking.FieldRideID] = struct{}{}
+			}
+
+		case "passenger":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			_q.withPassenger = query
+			if _, ok := fieldSeen[booking.FieldPassengerID]; !ok {
+				selectedFields = append(selectedFields, booking.FieldPassengerID)
+				fieldSeen[booking.FieldPassengerID] = struct{}{}
+			}
+		case "rideID":
+			if _, ok := fieldSeen[booking.FieldRideID]; !ok {
+				selectedFields = append(selectedFields, booking.FieldRideID)
+				fieldSeen[booking.FieldRideID] = struct{}{}
+			}
+		case "passengerID":
+			if _, ok := fieldSeen[booking.FieldPassengerID]; !ok {
+				selectedFields = append(selectedFields, booking.FieldPassengerID)
+				fieldSeen[booking.FieldPassengerID] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[booking.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, booking.FieldStatus)
+				fieldSeen[booking.FieldStatus] = struct{}{}
+			}
+		case "passengerCount":
+			if _, ok := fieldSeen[booking.FieldPassengerCount]; !ok {
+				selectedFields = append(selectedFields, booking.FieldPassengerCount)
+				fieldSeen[booking.FieldPassengerCount] = struct{}{}
+			}
+		case "subtotalAmount":
+			if _, ok := fieldSeen[booking.FieldSubtotalAmount]; !ok {
+				selectedFields = append(selectedFields, booking.FieldSubtotalAmount)
+				fieldSeen[booking.FieldSubtotalAmount] = struct{}{}
+			}
+		case "promoCode":
+			if _, ok := fieldSeen[booking.FieldPromoCode]; !ok {
+				selectedFields = append(selectedFields, booking.FieldPromoCode)
+				fieldSeen[booking.FieldPromoCode] = struct{}{}
+			}
+		case "discountAmount":
+			if _, ok := fieldSeen[booking.FieldDiscountAmount]; !ok {
+				selectedFields = append(selectedFields, booking.FieldDiscountAmount)
+				fieldSeen[booking.FieldDiscountAmount] = struct{}{}
+			}
+		case "creditAmount":
+			if _, ok := fieldSeen[booking.FieldCreditAmount]; !ok {
+				selectedFields = append(selectedFields, booking.FieldCreditAmount)
+				fieldSeen[booking.FieldCreditAmount] = struct{}{}
+			}
+		case "totalPriceAmount":
+			if _, ok := fieldSeen[booking.FieldTotalPriceAmount]; !ok {
+				selectedFields = append(selectedFields, booking.FieldTotalPriceAmount)
+				fieldSeen[booking.FieldTotalPriceAmount] = struct{}{}
+			}
+		case "totalPriceCurrency":
+			if _, ok := fieldSeen[booking.FieldTotalPriceCurrency]; !ok {
+				selectedFields = append(selectedFields, booking.FieldTotalPriceCurrency)
+				fieldSeen[booking.FieldTotalPriceCurrency] = struct{}{}
+			}
+		case "displayCurrency":
+			if _, ok := fieldSeen[booking.FieldDisplayCurrency]; !ok {
+				selectedFields = append(selectedFields, booking.FieldDisplayCurrency)
+				fieldSeen[booking.FieldDisplayCurrency] = struct{}{}
+			}
+		case "displayTotalAmount":
+			if _, ok := fieldSeen[booking.FieldDisplayTotalAmount]; !ok {
+				selectedFields = append(selectedFields, booking.FieldDisplayTotalAmount)
+				fieldSeen[booking.FieldDisplayTotalAmount] = struct{}{}
+			}
+		case "exchangeRate":
+			if _, ok := fieldSeen[booking.FieldExchangeRate]; !ok {
+				selectedFields = append(selectedFields, booking.FieldExchangeRate)
+				fieldSeen[booking.FieldExchangeRate] = struct{}{}
+			}
+		case "exchangeRateAsOf":
+			if _, ok := fieldSeen[booking.FieldExchangeRateAsOf]; !ok {
+				selectedFields = append(selectedFields, booking.FieldExchangeRateAsOf)
+				fieldSeen[booking.FieldExchangeRateAsOf] = struct{}{}
+			}
+		case "message":
+			if _, ok := fieldSeen[booking.FieldMessage]; !ok {
+				selectedFields = append(selectedFields, booking.FieldMessage)
+				fieldSeen[booking.FieldMessage] = struct{}{}
+			}
+		case "driverResponseMessage":
+			if _, ok := fieldSeen[booking.FieldDriverResponseMessage]; !ok {
+				selectedFields = append(selectedFields, booking.FieldDriverResponseMessage)
+				fieldSeen[booking.FieldDriverResponseMessage] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[booking.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, booking.FieldCreatedAt)
+				fieldSeen[booking.FieldCreatedAt] = struct{}{}
+			}
+		case "respondedAt":
+			if _, ok := fieldSeen[booking.FieldRespondedAt]; !ok {
+				selectedFields = append(selectedFields, booking.FieldRespondedAt)
+				fieldSeen[booking.FieldRespondedAt] = struct{}{}
+			}
+		case "cancelledAt":
+			if _, ok := fieldSeen[booking.FieldCancelledAt]; !ok {
+				selectedFields = append(selectedFields, booking.FieldCancelledAt)
+				fieldSeen[booking.FieldCancelledAt] = struct{}{}
+			}
+		case "cancelledBy":
+			if _, ok := fieldSeen[booking.FieldCancelledBy]; !ok {
+				selectedFields = append(selectedFields, booking.FieldCancelledBy)
+				fieldSeen[booking.FieldCancelledBy] = struct{}{}
+			}
+		case "cancellationReason":
+			if _, ok := fieldSeen[booking.FieldCancellationReason]; !ok {
+				selectedFields = append(selectedFields, booking.FieldCancellationReason)
+				fieldSeen[booking.FieldCancellationReason] = struct{}{}
+			}
+		case "refundAmount":
+			if _, ok := fieldSeen[booking.FieldRefundAmount]; !ok {
+				selectedFields = append(selectedFields, booking.FieldRefundAmount)
+				fieldSeen[booking.FieldRefundAmount] = struct{}{}
+			}
+		case "penaltyAmount":
+			if _, ok := fieldSeen[booking.FieldPenaltyAmount]; !ok {
+				selectedFields = append(selectedFields, booking.FieldPenaltyAmount)
+				fieldSeen[booking.FieldPenaltyAmount] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[booking.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, booking.FieldUpdatedAt)
+				fieldSeen[booking.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type bookingPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []BookingPaginateOption
+}
+
+func newBookingPaginateArgs(rv map[string]any) *bookingPaginateArgs {
+	args := &bookingPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &BookingOrder{Field: &BookingOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithBookingOrder(order))
+			}
+		case *BookingOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithBookingOrder(v))
+			}
+		}
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *RideQuery) CollectFields(ctx context.Context, satisfies ...string) (*RideQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *RideQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(ride.Columns))
+		selectedFields = []string{ride.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "driverID":
+			if _, ok := fieldSeen[ride.FieldDriverID]; !ok {
+				selectedFields = append(selectedFields, ride.FieldDriverID)
+				fieldSeen[ride.FieldDriverID] = struct{}{}
+			}
+		case "vehicleID":
+			if _, ok := fieldSeen[ride.FieldVehicleID]; !ok {
+				selectedFields = append(selectedFields, ride.FieldVehicleID)
+				fieldSeen[ride.FieldVehicleID] = struct{}{}
+			}
+		case "type":
+			if _, ok := fieldSeen[ride.FieldType]; !ok {
+				selectedFields = append(selectedFields, ride.FieldType)
+				fieldSeen[ride.FieldType] = struct{}{}
+			}
+		case "rideType":
+			if _, ok := fieldSeen[ride.FieldRideType]; !ok {
+				selectedFields = append(selectedFields, ride.FieldRideType)
+				fieldSeen[ride.FieldRideType] = struct{}{}
+			}
+		case "recurrence":
+			if _, ok := fieldSeen[ride.FieldRecurrence]; !ok {
+				selectedFields = append(selectedFields, ride.FieldRecurrence)
+				fieldSeen[ride.FieldRecurrence] = struct{}{}
+			}
+		case "departureTime":
+			if _, ok := fieldSeen[ride.FieldDepartureTime]; !ok {
+				selectedFields = append(selectedFields, ride.FieldDepartureTime)
+				fieldSeen[ride.FieldDepartureTime] = struct{}{}
+			}
+		case "arrivalTime":
+			if _, ok := fieldSeen[ride.FieldArrivalTime]; !ok {
+				selectedFields = append(selectedFields, ride.FieldArrivalTime)
+				fieldSeen[ride.FieldArrivalTime] = struct{}{}
+			}
+		case "durationMinutes":
+			if _, ok := fieldSeen[ride.FieldDurationMinutes]; !ok {
+				selectedFields = append(selectedFields, ride.FieldDurationMinutes)
+				fieldSeen[ride.FieldDurationMinutes] = struct{}{}
+			}
+		case "originCity":
+			if _, ok := fieldSeen[ride.FieldOriginCity]; !ok {
+				selectedFields = append(selectedFields, ride.FieldOriginCity)
+				fieldSeen[ride.FieldOriginCity] = struct{}{}
+			}
+		case "originAddress":
+			if _, ok := fieldSeen[ride.FieldOriginAddress]; !ok {
+				selectedFields = append(selectedFields, ride.FieldOriginAddress)
+				fieldSeen[ride.FieldOriginAddress] = struct{}{}
+			}
+		case "originLocationPoint":
+			if _, ok := fieldSeen[ride.FieldOriginLocationPoint]; !ok {
+				selectedFields = append(selectedFields, ride.FieldOriginLocationPoint)
+				fieldSeen[ride.FieldOriginLocationPoint] = struct{}{}
+			}
+		case "destinationCity":
+			if _, ok := fieldSeen[ride.FieldDestinationCity]; !ok {
+				selectedFields = append(selectedFields, ride.FieldDestinationCity)
+				fieldSeen[ride.FieldDestinationCity] = struct{}{}
+			}
+		case "destinationAddress":
+			if _, ok := fieldSeen[ride.FieldDestinationAddress]; !ok {
+				selectedFields = append(selectedFields, ride.FieldDestinationAddress)
+				fieldSeen[ride.FieldDestinationAddress] = struct{}{}
+			}
+		case "destinationLocationPoint":
+			if _, ok := fieldSeen[ride.FieldDestinationLocationPoint]; !ok {
+				selectedFields = append(selectedFields, ride.FieldDestinationLocationPoint)
+				fieldSeen[ride.FieldDestinationLocationPoint] = struct{}{}
+			}
+		case "priceAmount":
+			if _, ok := fieldSeen[ride.FieldPriceAmount]; !ok {
+				selectedFields = append(selectedFields, ride.FieldPriceAmount)
+				fieldSeen[ride.FieldPriceAmount] = struct{}{}
+			}
+		case "priceCurrency":
+			if _, ok := fieldSeen[ride.FieldPriceCurrency]; !ok {
+				selectedFields = append(selectedFields, ride.FieldPriceCurrency)
+				fieldSeen[ride.FieldPriceCurrency] = struct{}{}
+			}
+		case "availableSeats":
+			if _, ok := fieldSeen[ride.FieldAvailableSeats]; !ok {
+				selectedFields = append(selectedFields, ride.FieldAvailableSeats)
+				fieldSeen[ride.FieldAvailableSeats] = struct{}{}
+			}
+		case "totalSeats":
+			if _, ok := fieldSeen[ride.FieldTotalSeats]; !ok {
+				selectedFields = append(selectedFields, ride.FieldTotalSeats)
+				fieldSeen[ride.FieldTotalSeats] = struct{}{}
+			}
+		case "amenities":
+			if _, ok := fieldSeen[ride.FieldAmenities]; !ok {
+				selectedFields = append(selectedFields, ride.FieldAmenities)
+				fieldSeen[ride.FieldAmenities] = struct{}{}
+			}
+		case "stops":
+			if _, ok := fieldSeen[ride.FieldStops]; !ok {
+				selectedFields = append(selectedFields, ride.FieldStops)
+				fieldSeen[ride.FieldStops] = struct{}{}
+			}
+		case "instantConfirmation":
+			if _, ok := fieldSeen[ride.FieldInstantConfirmation]; !ok {
+				selectedFields = append(selectedFields, ride.FieldInstantConfirmation)
+				fieldSeen[ride.FieldInstantConfirmation] = struct{}{}
+			}
+		case "cancellationPolicy":
+			if _, ok := fieldSeen[ride.FieldCancellationPolicy]; !ok {
+				selectedFields = append(selectedFields, ride.FieldCancellationPolicy)
+				fieldSeen[ride.FieldCancellationPolicy] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[ride.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, ride.FieldDescription)
+				fieldSeen[ride.FieldDescription] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[ride.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, ride.FieldStatus)
+				fieldSeen[ride.FieldStatus] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[ride.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, ride.FieldCreatedAt)
+				fieldSeen[ride.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[ride.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, ride.FieldUpdatedAt)
+				fieldSeen[ride.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type ridePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []RidePaginateOption
+}
+
+func newRidePaginateArgs(rv map[string]any) *ridePaginateArgs {
+	args := &ridePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &RideOrder{Field: &RideOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithRideOrder(order))
+			}
+		case *RideOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithRideOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*RideWhereInput); ok {
+		args.opts = append(args.opts, WithRideFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *UserQuery) CollectFields(ctx context.Context, satisfies ...string) (*UserQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *UserQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(user.Columns))
+		selectedFields = []string{user.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "rides":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&RideClient{config: _q.config}).Query()
+			)
+			args := newRidePaginateArgs(fieldArgs(ctx, new(RideWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newRidePager(args.opts, args.last != nil)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(query); err != nil {
+				return err
+			}
+			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
+			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
+				if hasPagination || ignoredEdges {
+					query := query.Clone()
+					_q.loadTotal = append(_q.loadTotal, func(ctx context.Context, nodes []*User) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID string `sql:"driver_id"`
+							Count  int    `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							s.Where(sql.InValues(s.C(user.RidesColumn), ids...))
+						})
+						if err := query.GroupBy(user.RidesColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[string]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							if nodes[i].Edges.totalCount[0] == nil {
+								nodes[i].Edges.totalCount[0] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[0][alias] = n
+						}
+						return nil
+					})
+				} else {
+					_q.loadTotal = append(_q.loadTotal, func(_ context.Context, nodes []*User) error {
+						for i := range nodes {
+							n := len(nodes[i].Edges.Rides)
+							if nodes[i].Edges.totalCount[0] == nil {
+								nodes[i].Edges.totalCount[0] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[0][alias] = n
+						}
+						return nil
+					})
+				}
+			}
+			if ignoredEdges || (args.first != nil && *args.first == 0) || (args.last != nil && *args.last == 0) {
+				continue
+			}
+			if query, err = pager.applyCursors(query, args.after, args.before); err != nil {
+				return err
+			}
+			path = append(path, edgesField, nodeField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, rideImplementors)...); err != nil {
+					return err
+				}
+			}
+			if limit := paginateLimit(args.first, args.last); limit > 0 {
+				if oneNode {
+					pager.applyOrder(query.Limit(limit))
+				} else {
+					modify := entgql.LimitPerRow(user.RidesColumn, limit, pager.orderExpr(query))
+					query.modifiers = append(query.modifiers, modify)
+				}
+			} else {
+				query = pager.applyOrder(query)
+			}
+			_q.WithNamedRides(alias, func(wq *RideQuery) {
+				*wq = *query
+			})
+
+		case "vehicles":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&VehicleClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, vehicleImplementors)...); err != nil {
+				return err
+			}
+			_q.WithNamedVehicles(alias, func(wq *VehicleQuery) {
+				*wq = *query
+			})
+		case "name":
+			if _, ok := fieldSeen[user.FieldName]; !ok {
+				selectedFields = append(selectedFields, user.FieldName)
+				fieldSeen[user.FieldName] = struct{}{}
+			}
+		case "age":
+			if _, ok := fieldSeen[user.FieldAge]; !ok {
+				selectedFields = append(selectedFields, user.FieldAge)
+				fieldSeen[user.FieldAge] = struct{}{}
+			}
+		case "experienceLevel":
+			if _, ok := fieldSeen[user.FieldExperienceLevel]; !ok {
+				selectedFields = append(selectedFields, user.FieldExperienceLevel)
+				fieldSeen[user.FieldExperienceLevel] = struct{}{}
+			}
+		case "rating":
+			if _, ok := fieldSeen[user.FieldRating]; !ok {
+				selectedFields = append(selectedFields, user.FieldRating)
+				fieldSeen[user.FieldRating] = struct{}{}
+			}
+		case "ratingCount":
+			if _, ok := fieldSeen[user.FieldRatingCount]; !ok {
+				selectedFields = append(selectedFields, user.FieldRatingCount)
+				fieldSeen[user.FieldRatingCount] = struct{}{}
+			}
+		case "drivingRating":
+			if _, ok := fieldSeen[user.FieldDrivingRating]; !ok {
+				selectedFields = append(selectedFields, user.FieldDrivingRating)
+				fieldSeen[user.FieldDrivingRating] = struct{}{}
+			}
+		case "profilePictureURL":
+			if _, ok := fieldSeen[user.FieldProfilePictureURL]; !ok {
+				selectedFields = append(selectedFields, user.FieldProfilePictureURL)
+				fieldSeen[user.FieldProfilePictureURL] = struct{}{}
+			}
+		case "isVerified":
+			if _, ok := fieldSeen[user.FieldIsVerified]; !ok {
+				selectedFields = append(selectedFields, user.FieldIsVerified)
+				fieldSeen[user.FieldIsVerified] = struct{}{}
+			}
+		case "verifiedID":
+			if _, ok := fieldSeen[user.FieldVerifiedID]; !ok {
+				selectedFields = append(selectedFields, user.FieldVerifiedID)
+				fieldSeen[user.FieldVerifiedID] = struct{}{}
+			}
+		case "confirmedEmail":
+			if _, ok := fieldSeen[user.FieldConfirmedEmail]; !ok {
+				selectedFields = append(selectedFields, user.FieldConfirmedEmail)
+				fieldSeen[user.FieldConfirmedEmail] = struct{}{}
+			}
+		case "confirmedPhone":
+			if _, ok := fieldSeen[user.FieldConfirmedPhone]; !ok {
+				selectedFields = append(selectedFields, user.FieldConfirmedPhone)
+				fieldSeen[user.FieldConfirmedPhone] = struct{}{}
+			}
+		case "bio":
+			if _, ok := fieldSeen[user.FieldBio]; !ok {
+				selectedFields = append(selectedFields, user.FieldBio)
+				fieldSeen[user.FieldBio] = struct{}{}
+			}
+		case "preferences":
+			if _, ok := fieldSeen[user.FieldPreferences]; !ok {
+				selectedFields = append(selectedFields, user.FieldPreferences)
+				fieldSeen[user.FieldPreferences] = struct{}{}
+			}
+		case "membershipType":
+			if _, ok := fieldSeen[user.FieldMembershipType]; !ok {
+				selectedFields = append(selectedFields, user.FieldMembershipType)
+				fieldSeen[user.FieldMembershipType] = struct{}{}
+			}
+		case "publishedRides":
+			if _, ok := fieldSeen[user.FieldPublishedRides]; !ok {
+				selectedFields = append(selectedFields, user.FieldPublishedRides)
+				fieldSeen[user.FieldPublishedRides] = struct{}{}
+			}
+		case "completedRides":
+			if _, ok := fieldSeen[user.FieldCompletedRides]; !ok {
+				selectedFields = append(selectedFields, user.FieldCompletedRides)
+				fieldSeen[user.FieldCompletedRides] = struct{}{}
+			}
+		case "neverCancels":
+			if _, ok := fieldSeen[user.FieldNeverCancels]; !ok {
+				selectedFields = append(selectedFields, user.FieldNeverCancels)
+				fieldSeen[user.FieldNeverCancels] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[user.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, user.FieldCreatedAt)
+				fieldSeen[user.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[user.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, user.FieldUpdatedAt)
+				fieldSeen[user.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type userPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []UserPaginateOption
+}
+
+func newUserPaginateArgs(rv map[string]any) *userPaginateArgs {
+	args := &userPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *VehicleQuery) CollectFields(ctx context.Context, satisfies ...string) (*VehicleQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *VehicleQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(vehicle.Columns))
+		selectedFields = []string{vehicle.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "owner":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			_q.withOwner = query
+			if _, ok := fieldSeen[vehicle.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, vehicle.FieldUserID)
+				fieldSeen[vehicle.FieldUserID] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[vehicle.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, vehicle.FieldUserID)
+				fieldSeen[vehicle.FieldUserID] = struct{}{}
+			}
+		case "make":
+			if _, ok := fieldSeen[vehicle.FieldMake]; !ok {
+				selectedFields = append(selectedFields, vehicle.FieldMake)
+				fieldSeen[vehicle.FieldMake] = struct{}{}
+			}
+		case "model":
+			if _, ok := fieldSeen[vehicle.FieldModel]; !ok {
+				selectedFields = append(selectedFields, vehicle.FieldModel)
+				fieldSeen[vehicle.FieldModel] = struct{}{}
+			}
+		case "color":
+			if _, ok := fieldSeen[vehicle.FieldColor]; !ok {
+				selectedFields = append(selectedFields, vehicle.FieldColor)
+				fieldSeen[vehicle.FieldColor] = struct{}{}
+			}
+		case "year":
+			if _, ok := fieldSeen[vehicle.FieldYear]; !ok {
+				selectedFields = append(selectedFields, vehicle.FieldYear)
+				fieldSeen[vehicle.FieldYear] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[vehicle.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, vehicle.FieldCreatedAt)
+				fieldSeen[vehicle.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[vehicle.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, vehicle.FieldUpdatedAt)
+				fieldSeen[vehicle.FieldUpdatedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type vehiclePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []VehiclePaginateOption
+}
+
+func newVehiclePaginateArgs(rv map[string]any) *vehiclePaginateArgs {
+	args := &vehiclePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	return args
+}
+
+const (
+	afterField     = "after"
+	firstField     = "first"
+	beforeField    = "before"
+	lastField      = "last"
+	orderByField   = "orderBy"
+	directionField = "direction"
+	fieldField     = "field"
+	whereField     = "where"
+)
+
+func fieldArgs(ctx context.Context, whereInput any, path ...string) map[string]any {
+	field := collectedField(ctx, path...)
+	if field == nil || field.Arguments == nil {
+		return nil
+	}
+	oc := graphql.GetOperationContext(ctx)
+	args := field.ArgumentMap(oc.Variables)
+	return unmarshalArgs(ctx, whereInput, args)
+}
+
+// unmarshalArgs allows extracting the field arguments from their raw representation.
+func unmarshalArgs(ctx context.Context, whereInput any, args map[string]any) map[string]any {
+	for _, k := range []string{firstField, lastField} {
+		v, ok := args[k]
+		if !ok || v == nil {
+			continue
+		}
+		i, err := graphql.UnmarshalInt(v)
+		if err == nil {
+			args[k] = &i
+		}
+	}
+	for _, k := range []string{beforeField, afterField} {
+		v, ok := args[k]
+		if !ok {
+			continue
+		}
+		c := &Cursor{}
+		if c.UnmarshalGQL(v) == nil {
+			args[k] = c
+		}
+	}
+	if v, ok := args[whereField]; ok && whereInput != nil {
+		if err := graphql.UnmarshalInputFromContext(ctx, v, whereInput); err == nil {
+			args[whereField] = whereInput
+		}
+	}
+
+	return args
+}
+
+// mayAddCondition appends another type condition to the satisfies list
+// if it does not exist in the list.
+func mayAddCondition(satisfies []string, typeCond []string) []string {
+Cond:
+	for _, c := range typeCond {
+		for _, s := range satisfies {
+			if c == s {
+				continue Cond
+			}
+		}
+		satisfies = append(satisfies, c)
+	}
+	return satisfies
+}
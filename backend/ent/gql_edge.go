@@ -0,0 +1,66 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func (_m *Booking) Ride(ctx context.Context) (*Ride, error) {
+	result, err := _m.Edges.RideOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryRide().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *Booking) Passenger(ctx context.Context) (*User, error) {
+	result, err := _m.Edges.PassengerOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryPassenger().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *User) Rides(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *RideOrder, where *RideWhereInput,
+) (*RideConnection, error) {
+	opts := []RidePaginateOption{
+		WithRideOrder(orderBy),
+		WithRideFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[0][alias]
+	if nodes, err := _m.NamedRides(alias); err == nil || hasTotalCount {
+		pager, err := newRidePager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &RideConnection{Edges: []*RideEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryRides().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *User) Vehicles(ctx context.Context) (result []*Vehicle, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = _m.NamedVehicles(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = _m.Edges.VehiclesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = _m.QueryVehicles().All(ctx)
+	}
+	return result, err
+}
+
+func (_m *Vehicle) Owner(ctx context.Context) (*User, error) {
+	result, err := _m.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryOwner().Only(ctx)
+	}
+	return result, err
+}
@@ -0,0 +1,1230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/ent/predicate"
+	"github.com/slowtyper/poolie/backend/ent/ride"
+)
+
+// RideWhereInput represents a where input for filtering Ride queries.
+type RideWhereInput struct {
+	Predicates []predicate.Ride  `json:"-"`
+	Not        *RideWhereInput   `json:"not,omitempty"`
+	Or         []*RideWhereInput `json:"or,omitempty"`
+	And        []*RideWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "driver_id" field predicates.
+	DriverID             *string  `json:"driverID,omitempty"`
+	DriverIDNEQ          *string  `json:"driverIDNEQ,omitempty"`
+	DriverIDIn           []string `json:"driverIDIn,omitempty"`
+	DriverIDNotIn        []string `json:"driverIDNotIn,omitempty"`
+	DriverIDGT           *string  `json:"driverIDGT,omitempty"`
+	DriverIDGTE          *string  `json:"driverIDGTE,omitempty"`
+	DriverIDLT           *string  `json:"driverIDLT,omitempty"`
+	DriverIDLTE          *string  `json:"driverIDLTE,omitempty"`
+	DriverIDContains     *string  `json:"driverIDContains,omitempty"`
+	DriverIDHasPrefix    *string  `json:"driverIDHasPrefix,omitempty"`
+	DriverIDHasSuffix    *string  `json:"driverIDHasSuffix,omitempty"`
+	DriverIDEqualFold    *string  `json:"driverIDEqualFold,omitempty"`
+	DriverIDContainsFold *string  `json:"driverIDContainsFold,omitempty"`
+
+	// "vehicle_id" field predicates.
+	VehicleID             *string  `json:"vehicleID,omitempty"`
+	VehicleIDNEQ          *string  `json:"vehicleIDNEQ,omitempty"`
+	VehicleIDIn           []string `json:"vehicleIDIn,omitempty"`
+	VehicleIDNotIn        []string `json:"vehicleIDNotIn,omitempty"`
+	VehicleIDGT           *string  `json:"vehicleIDGT,omitempty"`
+	VehicleIDGTE          *string  `json:"vehicleIDGTE,omitempty"`
+	VehicleIDLT           *string  `json:"vehicleIDLT,omitempty"`
+	VehicleIDLTE          *string  `json:"vehicleIDLTE,omitempty"`
+	VehicleIDContains     *string  `json:"vehicleIDContains,omitempty"`
+	VehicleIDHasPrefix    *string  `json:"vehicleIDHasPrefix,omitempty"`
+	VehicleIDHasSuffix    *string  `json:"vehicleIDHasSuffix,omitempty"`
+	VehicleIDIsNil        bool     `json:"vehicleIDIsNil,omitempty"`
+	VehicleIDNotNil       bool     `json:"vehicleIDNotNil,omitempty"`
+	VehicleIDEqualFold    *string  `json:"vehicleIDEqualFold,omitempty"`
+	VehicleIDContainsFold *string  `json:"vehicleIDContainsFold,omitempty"`
+
+	// "type" field predicates.
+	Type             *string  `json:"type,omitempty"`
+	TypeNEQ          *string  `json:"typeNEQ,omitempty"`
+	TypeIn           []string `json:"typeIn,omitempty"`
+	TypeNotIn        []string `json:"typeNotIn,omitempty"`
+	TypeGT           *string  `json:"typeGT,omitempty"`
+	TypeGTE          *string  `json:"typeGTE,omitempty"`
+	TypeLT           *string  `json:"typeLT,omitempty"`
+	TypeLTE          *string  `json:"typeLTE,omitempty"`
+	TypeContains     *string  `json:"typeContains,omitempty"`
+	TypeHasPrefix    *string  `json:"typeHasPrefix,omitempty"`
+	TypeHasSuffix    *string  `json:"typeHasSuffix,omitempty"`
+	TypeEqualFold    *string  `json:"typeEqualFold,omitempty"`
+	TypeContainsFold *string  `json:"typeContainsFold,omitempty"`
+
+	// "ride_type" field predicates.
+	RideType             *string  `json:"rideType,omitempty"`
+	RideTypeNEQ          *string  `json:"rideTypeNEQ,omitempty"`
+	RideTypeIn           []string `json:"rideTypeIn,omitempty"`
+	RideTypeNotIn        []string `json:"rideTypeNotIn,omitempty"`
+	RideTypeGT           *string  `json:"rideTypeGT,omitempty"`
+	RideTypeGTE          *string  `json:"rideTypeGTE,omitempty"`
+	RideTypeLT           *string  `json:"rideTypeLT,omitempty"`
+	RideTypeLTE          *string  `json:"rideTypeLTE,omitempty"`
+	RideTypeContains     *string  `json:"rideTypeContains,omitempty"`
+	RideTypeHasPrefix    *string  `json:"rideTypeHasPrefix,omitempty"`
+	RideTypeHasSuffix    *string  `json:"rideTypeHasSuffix,omitempty"`
+	RideTypeEqualFold    *string  `json:"rideTypeEqualFold,omitempty"`
+	RideTypeContainsFold *string  `json:"rideTypeContainsFold,omitempty"`
+
+	// "departure_time" field predicates.
+	DepartureTime      *time.Time  `json:"departureTime,omitempty"`
+	DepartureTimeNEQ   *time.Time  `json:"departureTimeNEQ,omitempty"`
+	DepartureTimeIn    []time.Time `json:"departureTimeIn,omitempty"`
+	DepartureTimeNotIn []time.Time `json:"departureTimeNotIn,omitempty"`
+	DepartureTimeGT    *time.Time  `json:"departureTimeGT,omitempty"`
+	DepartureTimeGTE   *time.Time  `json:"departureTimeGTE,omitempty"`
+	DepartureTimeLT    *time.Time  `json:"departureTimeLT,omitempty"`
+	DepartureTimeLTE   *time.Time  `json:"departureTimeLTE,omitempty"`
+
+	// "arrival_time" field predicates.
+	ArrivalTime       *time.Time  `json:"arrivalTime,omitempty"`
+	ArrivalTimeNEQ    *time.Time  `json:"arrivalTimeNEQ,omitempty"`
+	ArrivalTimeIn     []time.Time `json:"arrivalTimeIn,omitempty"`
+	ArrivalTimeNotIn  []time.Time `json:"arrivalTimeNotIn,omitempty"`
+	ArrivalTimeGT     *time.Time  `json:"arrivalTimeGT,omitempty"`
+	ArrivalTimeGTE    *time.Time  `json:"arrivalTimeGTE,omitempty"`
+	ArrivalTimeLT     *time.Time  `json:"arrivalTimeLT,omitempty"`
+	ArrivalTimeLTE    *time.Time  `json:"arrivalTimeLTE,omitempty"`
+	ArrivalTimeIsNil  bool        `json:"arrivalTimeIsNil,omitempty"`
+	ArrivalTimeNotNil bool        `json:"arrivalTimeNotNil,omitempty"`
+
+	// "duration_minutes" field predicates.
+	DurationMinutes       *int  `json:"durationMinutes,omitempty"`
+	DurationMinutesNEQ    *int  `json:"durationMinutesNEQ,omitempty"`
+	DurationMinutesIn     []int `json:"durationMinutesIn,omitempty"`
+	DurationMinutesNotIn  []int `json:"durationMinutesNotIn,omitempty"`
+	DurationMinutesGT     *int  `json:"durationMinutesGT,omitempty"`
+	DurationMinutesGTE    *int  `json:"durationMinutesGTE,omitempty"`
+	DurationMinutesLT     *int  `json:"durationMinutesLT,omitempty"`
+	DurationMinutesLTE    *int  `json:"durationMinutesLTE,omitempty"`
+	DurationMinutesIsNil  bool  `json:"durationMinutesIsNil,omitempty"`
+	DurationMinutesNotNil bool  `json:"durationMinutesNotNil,omitempty"`
+
+	// "origin_city" field predicates.
+	OriginCity             *string  `json:"originCity,omitempty"`
+	OriginCityNEQ          *string  `json:"originCityNEQ,omitempty"`
+	OriginCityIn           []string `json:"originCityIn,omitempty"`
+	OriginCityNotIn        []string `json:"originCityNotIn,omitempty"`
+	OriginCityGT           *string  `json:"originCityGT,omitempty"`
+	OriginCityGTE          *string  `json:"originCityGTE,omitempty"`
+	OriginCityLT           *string  `json:"originCityLT,omitempty"`
+	OriginCityLTE          *string  `json:"originCityLTE,omitempty"`
+	OriginCityContains     *string  `json:"originCityContains,omitempty"`
+	OriginCityHasPrefix    *string  `json:"originCityHasPrefix,omitempty"`
+	OriginCityHasSuffix    *string  `json:"originCityHasSuffix,omitempty"`
+	OriginCityEqualFold    *string  `json:"originCityEqualFold,omitempty"`
+	OriginCityContainsFold *string  `json:"originCityContainsFold,omitempty"`
+
+	// "origin_address" field predicates.
+	OriginAddress             *string  `json:"originAddress,omitempty"`
+	OriginAddressNEQ          *string  `json:"originAddressNEQ,omitempty"`
+	OriginAddressIn           []string `json:"originAddressIn,omitempty"`
+	OriginAddressNotIn        []string `json:"originAddressNotIn,omitempty"`
+	OriginAddressGT           *string  `json:"originAddressGT,omitempty"`
+	OriginAddressGTE          *string  `json:"originAddressGTE,omitempty"`
+	OriginAddressLT           *string  `json:"originAddressLT,omitempty"`
+	OriginAddressLTE          *string  `json:"originAddressLTE,omitempty"`
+	OriginAddressContains     *string  `json:"originAddressContains,omitempty"`
+	OriginAddressHasPrefix    *string  `json:"originAddressHasPrefix,omitempty"`
+	OriginAddressHasSuffix    *string  `json:"originAddressHasSuffix,omitempty"`
+	OriginAddressEqualFold    *string  `json:"originAddressEqualFold,omitempty"`
+	OriginAddressContainsFold *string  `json:"originAddressContainsFold,omitempty"`
+
+	// "origin_location_point" field predicates.
+	OriginLocationPoint             *string  `json:"originLocationPoint,omitempty"`
+	OriginLocationPointNEQ          *string  `json:"originLocationPointNEQ,omitempty"`
+	OriginLocationPointIn           []string `json:"originLocationPointIn,omitempty"`
+	OriginLocationPointNotIn        []string `json:"originLocationPointNotIn,omitempty"`
+	OriginLocationPointGT           *string  `json:"originLocationPointGT,omitempty"`
+	OriginLocationPointGTE          *string  `json:"originLocationPointGTE,omitempty"`
+	OriginLocationPointLT           *string  `json:"originLocationPointLT,omitempty"`
+	OriginLocationPointLTE          *string  `json:"originLocationPointLTE,omitempty"`
+	OriginLocationPointContains     *string  `json:"originLocationPointContains,omitempty"`
+	OriginLocationPointHasPrefix    *string  `json:"originLocationPointHasPrefix,omitempty"`
+	OriginLocationPointHasSuffix    *string  `json:"originLocationPointHasSuffix,omitempty"`
+	OriginLocationPointIsNil        bool     `json:"originLocationPointIsNil,omitempty"`
+	OriginLocationPointNotNil       bool     `json:"originLocationPointNotNil,omitempty"`
+	OriginLocationPointEqualFold    *string  `json:"originLocationPointEqualFold,omitempty"`
+	OriginLocationPointContainsFold *string  `json:"originLocationPointContainsFold,omitempty"`
+
+	// "destination_city" field predicates.
+	DestinationCity             *string  `json:"destinationCity,omitempty"`
+	DestinationCityNEQ          *string  `json:"destinationCityNEQ,omitempty"`
+	DestinationCityIn           []string `json:"destinationCityIn,omitempty"`
+	DestinationCityNotIn        []string `json:"destinationCityNotIn,omitempty"`
+	DestinationCityGT           *string  `json:"destinationCityGT,omitempty"`
+	DestinationCityGTE          *string  `json:"destinationCityGTE,omitempty"`
+	DestinationCityLT           *string  `json:"destinationCityLT,omitempty"`
+	DestinationCityLTE          *string  `json:"destinationCityLTE,omitempty"`
+	DestinationCityContains     *string  `json:"destinationCityContains,omitempty"`
+	DestinationCityHasPrefix    *string  `json:"destinationCityHasPrefix,omitempty"`
+	DestinationCityHasSuffix    *string  `json:"destinationCityHasSuffix,omitempty"`
+	DestinationCityEqualFold    *string  `json:"destinationCityEqualFold,omitempty"`
+	DestinationCityContainsFold *string  `json:"destinationCityContainsFold,omitempty"`
+
+	// "destination_address" field predicates.
+	DestinationAddress             *string  `json:"destinationAddress,omitempty"`
+	DestinationAddressNEQ          *string  `json:"destinationAddressNEQ,omitempty"`
+	DestinationAddressIn           []string `json:"destinationAddressIn,omitempty"`
+	DestinationAddressNotIn        []string `json:"destinationAddressNotIn,omitempty"`
+	DestinationAddressGT           *string  `json:"destinationAddressGT,omitempty"`
+	DestinationAddressGTE          *string  `json:"destinationAddressGTE,omitempty"`
+	DestinationAddressLT           *string  `json:"destinationAddressLT,omitempty"`
+	DestinationAddressLTE          *string  `json:"destinationAddressLTE,omitempty"`
+	DestinationAddressContains     *string  `json:"destinationAddressContains,omitempty"`
+	DestinationAddressHasPrefix    *string  `json:"destinationAddressHasPrefix,omitempty"`
+	DestinationAddressHasSuffix    *string  `json:"destinationAddressHasSuffix,omitempty"`
+	DestinationAddressEqualFold    *string  `json:"destinationAddressEqualFold,omitempty"`
+	DestinationAddressContainsFold *string  `json:"destinationAddressContainsFold,omitempty"`
+
+	// "destination_location_point" field predicates.
+	DestinationLocationPoint             *string  `json:"destinationLocationPoint,omitempty"`
+	DestinationLocationPointNEQ          *string  `json:"destinationLocationPointNEQ,omitempty"`
+	DestinationLocationPointIn           []string `json:"destinationLocationPointIn,omitempty"`
+	DestinationLocationPointNotIn        []string `json:"destinationLocationPointNotIn,omitempty"`
+	DestinationLocationPointGT           *string  `json:"destinationLocationPointGT,omitempty"`
+	DestinationLocationPointGTE          *string  `json:"destinationLocationPointGTE,omitempty"`
+	DestinationLocationPointLT           *string  `json:"destinationLocationPointLT,omitempty"`
+	DestinationLocationPointLTE          *string  `json:"destinationLocationPointLTE,omitempty"`
+	DestinationLocationPointContains     *string  `json:"destinationLocationPointContains,omitempty"`
+	DestinationLocationPointHasPrefix    *string  `json:"destinationLocationPointHasPrefix,omitempty"`
+	DestinationLocationPointHasSuffix    *string  `json:"destinationLocationPointHasSuffix,omitempty"`
+	DestinationLocationPointIsNil        bool     `json:"destinationLocationPointIsNil,omitempty"`
+	DestinationLocationPointNotNil       bool     `json:"destinationLocationPointNotNil,omitempty"`
+	DestinationLocationPointEqualFold    *string  `json:"destinationLocationPointEqualFold,omitempty"`
+	DestinationLocationPointContainsFold *string  `json:"destinationLocationPointContainsFold,omitempty"`
+
+	// "price_amount" field predicates.
+	PriceAmount      *int64  `json:"priceAmount,omitempty"`
+	PriceAmountNEQ   *int64  `json:"priceAmountNEQ,omitempty"`
+	PriceAmountIn    []int64 `json:"priceAmountIn,omitempty"`
+	PriceAmountNotIn []int64 `json:"priceAmountNotIn,omitempty"`
+	PriceAmountGT    *int64  `json:"priceAmountGT,omitempty"`
+	PriceAmountGTE   *int64  `json:"priceAmountGTE,omitempty"`
+	PriceAmountLT    *int64  `json:"priceAmountLT,omitempty"`
+	PriceAmountLTE   *int64  `json:"priceAmountLTE,omitempty"`
+
+	// "price_currency" field predicates.
+	PriceCurrency             *string  `json:"priceCurrency,omitempty"`
+	PriceCurrencyNEQ          *string  `json:"priceCurrencyNEQ,omitempty"`
+	PriceCurrencyIn           []string `json:"priceCurrencyIn,omitempty"`
+	PriceCurrencyNotIn        []string `json:"priceCurrencyNotIn,omitempty"`
+	PriceCurrencyGT           *string  `json:"priceCurrencyGT,omitempty"`
+	PriceCurrencyGTE          *string  `json:"priceCurrencyGTE,omitempty"`
+	PriceCurrencyLT           *string  `json:"priceCurrencyLT,omitempty"`
+	PriceCurrencyLTE          *string  `json:"priceCurrencyLTE,omitempty"`
+	PriceCurrencyContains     *string  `json:"priceCurrencyContains,omitempty"`
+	PriceCurrencyHasPrefix    *string  `json:"priceCurrencyHasPrefix,omitempty"`
+	PriceCurrencyHasSuffix    *string  `json:"priceCurrencyHasSuffix,omitempty"`
+	PriceCurrencyEqualFold    *string  `json:"priceCurrencyEqualFold,omitempty"`
+	PriceCurrencyContainsFold *string  `json:"priceCurrencyContainsFold,omitempty"`
+
+	// "available_seats" field predicates.
+	AvailableSeats      *int  `json:"availableSeats,omitempty"`
+	AvailableSeatsNEQ   *int  `json:"availableSeatsNEQ,omitempty"`
+	AvailableSeatsIn    []int `json:"availableSeatsIn,omitempty"`
+	AvailableSeatsNotIn []int `json:"availableSeatsNotIn,omitempty"`
+	AvailableSeatsGT    *int  `json:"availableSeatsGT,omitempty"`
+	AvailableSeatsGTE   *int  `json:"availableSeatsGTE,omitempty"`
+	AvailableSeatsLT    *int  `json:"availableSeatsLT,omitempty"`
+	AvailableSeatsLTE   *int  `json:"availableSeatsLTE,omitempty"`
+
+	// "total_seats" field predicates.
+	TotalSeats      *int  `json:"totalSeats,omitempty"`
+	TotalSeatsNEQ   *int  `json:"totalSeatsNEQ,omitempty"`
+	TotalSeatsIn    []int `json:"totalSeatsIn,omitempty"`
+	TotalSeatsNotIn []int `json:"totalSeatsNotIn,omitempty"`
+	TotalSeatsGT    *int  `json:"totalSeatsGT,omitempty"`
+	TotalSeatsGTE   *int  `json:"totalSeatsGTE,omitempty"`
+	TotalSeatsLT    *int  `json:"totalSeatsLT,omitempty"`
+	TotalSeatsLTE   *int  `json:"totalSeatsLTE,omitempty"`
+
+	// "instant_confirmation" field predicates.
+	InstantConfirmation    *bool `json:"instantConfirmation,omitempty"`
+	InstantConfirmationNEQ *bool `json:"instantConfirmationNEQ,omitempty"`
+
+	// "cancellation_policy" field predicates.
+	CancellationPolicy             *string  `json:"cancellationPolicy,omitempty"`
+	CancellationPolicyNEQ          *string  `json:"cancellationPolicyNEQ,omitempty"`
+	CancellationPolicyIn           []string `json:"cancellationPolicyIn,omitempty"`
+	CancellationPolicyNotIn        []string `json:"cancellationPolicyNotIn,omitempty"`
+	CancellationPolicyGT           *string  `json:"cancellationPolicyGT,omitempty"`
+	CancellationPolicyGTE          *string  `json:"cancellationPolicyGTE,omitempty"`
+	CancellationPolicyLT           *string  `json:"cancellationPolicyLT,omitempty"`
+	CancellationPolicyLTE          *string  `json:"cancellationPolicyLTE,omitempty"`
+	CancellationPolicyContains     *string  `json:"cancellationPolicyContains,omitempty"`
+	CancellationPolicyHasPrefix    *string  `json:"cancellationPolicyHasPrefix,omitempty"`
+	CancellationPolicyHasSuffix    *string  `json:"cancellationPolicyHasSuffix,omitempty"`
+	CancellationPolicyEqualFold    *string  `json:"cancellationPolicyEqualFold,omitempty"`
+	CancellationPolicyContainsFold *string  `json:"cancellationPolicyContainsFold,omitempty"`
+
+	// "description" field predicates.
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        bool     `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       bool     `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+
+	// "status" field predicates.
+	Status             *string  `json:"status,omitempty"`
+	StatusNEQ          *string  `json:"statusNEQ,omitempty"`
+	StatusIn           []string `json:"statusIn,omitempty"`
+	StatusNotIn        []string `json:"statusNotIn,omitempty"`
+	StatusGT           *string  `json:"statusGT,omitempty"`
+	StatusGTE          *string  `json:"statusGTE,omitempty"`
+	StatusLT           *string  `json:"statusLT,omitempty"`
+	StatusLTE          *string  `json:"statusLTE,omitempty"`
+	StatusContains     *string  `json:"statusContains,omitempty"`
+	StatusHasPrefix    *string  `json:"statusHasPrefix,omitempty"`
+	StatusHasSuffix    *string  `json:"statusHasSuffix,omitempty"`
+	StatusEqualFold    *string  `json:"statusEqualFold,omitempty"`
+	StatusContainsFold *string  `json:"statusContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *RideWhereInput) AddPredicates(predicates ...predicate.Ride) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the RideWhereInput filter on the RideQuery builder.
+func (i *RideWhereInput) Filter(q *RideQuery) (*RideQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyRideWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyRideWhereInput is returned in case the RideWhereInput is empty.
+var ErrEmptyRideWhereInput = errors.New("ent: empty predicate RideWhereInput")
+
+// P returns a predicate for filtering rides.
+// An error is returned if the input is empty or invalid.
+func (i *RideWhereInput) P() (predicate.Ride, error) {
+	var predicates []predicate.Ride
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, ride.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Ride, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, ride.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Ride, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, ride.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, ride.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, ride.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, ride.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, ride.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, ride.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, ride.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, ride.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, ride.IDLTE(*i.IDLTE))
+	}
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, ride.IDEqualFold(*i.IDEqualFold))
+	}
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, ride.IDContainsFold(*i.IDContainsFold))
+	}
+	if i.DriverID != nil {
+		predicates = append(predicates, ride.DriverIDEQ(*i.DriverID))
+	}
+	if i.DriverIDNEQ != nil {
+		predicates = append(predicates, ride.DriverIDNEQ(*i.DriverIDNEQ))
+	}
+	if len(i.DriverIDIn) > 0 {
+		predicates = append(predicates, ride.DriverIDIn(i.DriverIDIn...))
+	}
+	if len(i.DriverIDNotIn) > 0 {
+		predicates = append(predicates, ride.DriverIDNotIn(i.DriverIDNotIn...))
+	}
+	if i.DriverIDGT != nil {
+		predicates = append(predicates, ride.DriverIDGT(*i.DriverIDGT))
+	}
+	if i.DriverIDGTE != nil {
+		predicates = append(predicates, ride.DriverIDGTE(*i.DriverIDGTE))
+	}
+	if i.DriverIDLT != nil {
+		predicates = append(predicates, ride.DriverIDLT(*i.DriverIDLT))
+	}
+	if i.DriverIDLTE != nil {
+		predicates = append(predicates, ride.DriverIDLTE(*i.DriverIDLTE))
+	}
+	if i.DriverIDContains != nil {
+		predicates = append(predicates, ride.DriverIDContains(*i.DriverIDContains))
+	}
+	if i.DriverIDHasPrefix != nil {
+		predicates = append(predicates, ride.DriverIDHasPrefix(*i.DriverIDHasPrefix))
+	}
+	if i.DriverIDHasSuffix != nil {
+		predicates = append(predicates, ride.DriverIDHasSuffix(*i.DriverIDHasSuffix))
+	}
+	if i.DriverIDEqualFold != nil {
+		predicates = append(predicates, ride.DriverIDEqualFold(*i.DriverIDEqualFold))
+	}
+	if i.DriverIDContainsFold != nil {
+		predicates = append(predicates, ride.DriverIDContainsFold(*i.DriverIDContainsFold))
+	}
+	if i.VehicleID != nil {
+		predicates = append(predicates, ride.VehicleIDEQ(*i.VehicleID))
+	}
+	if i.VehicleIDNEQ != nil {
+		predicates = append(predicates, ride.VehicleIDNEQ(*i.VehicleIDNEQ))
+	}
+	if len(i.VehicleIDIn) > 0 {
+		predicates = append(predicates, ride.VehicleIDIn(i.VehicleIDIn...))
+	}
+	if len(i.VehicleIDNotIn) > 0 {
+		predicates = append(predicates, ride.VehicleIDNotIn(i.VehicleIDNotIn...))
+	}
+	if i.VehicleIDGT != nil {
+		predicates = append(predicates, ride.VehicleIDGT(*i.VehicleIDGT))
+	}
+	if i.VehicleIDGTE != nil {
+		predicates = append(predicates, ride.VehicleIDGTE(*i.VehicleIDGTE))
+	}
+	if i.VehicleIDLT != nil {
+		predicates = append(predicates, ride.VehicleIDLT(*i.VehicleIDLT))
+	}
+	if i.VehicleIDLTE != nil {
+		predicates = append(predicates, ride.VehicleIDLTE(*i.VehicleIDLTE))
+	}
+	if i.VehicleIDContains != nil {
+		predicates = append(predicates, ride.VehicleIDContains(*i.VehicleIDContains))
+	}
+	if i.VehicleIDHasPrefix != nil {
+		predicates = append(predicates, ride.VehicleIDHasPrefix(*i.VehicleIDHasPrefix))
+	}
+	if i.VehicleIDHasSuffix != nil {
+		predicates = append(predicates, ride.VehicleIDHasSuffix(*i.VehicleIDHasSuffix))
+	}
+	if i.VehicleIDIsNil {
+		predicates = append(predicates, ride.VehicleIDIsNil())
+	}
+	if i.VehicleIDNotNil {
+		predicates = append(predicates, ride.VehicleIDNotNil())
+	}
+	if i.VehicleIDEqualFold != nil {
+		predicates = append(predicates, ride.VehicleIDEqualFold(*i.VehicleIDEqualFold))
+	}
+	if i.VehicleIDContainsFold != nil {
+		predicates = append(predicates, ride.VehicleIDContainsFold(*i.VehicleIDContainsFold))
+	}
+	if i.Type != nil {
+		predicates = append(predicates, ride.TypeEQ(*i.Type))
+	}
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, ride.TypeNEQ(*i.TypeNEQ))
+	}
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, ride.TypeIn(i.TypeIn...))
+	}
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, ride.TypeNotIn(i.TypeNotIn...))
+	}
+	if i.TypeGT != nil {
+		predicates = append(predicates, ride.TypeGT(*i.TypeGT))
+	}
+	if i.TypeGTE != nil {
+		predicates = append(predicates, ride.TypeGTE(*i.TypeGTE))
+	}
+	if i.TypeLT != nil {
+		predicates = append(predicates, ride.TypeLT(*i.TypeLT))
+	}
+	if i.TypeLTE != nil {
+		predicates = append(predicates, ride.TypeLTE(*i.TypeLTE))
+	}
+	if i.TypeContains != nil {
+		predicates = append(predicates, ride.TypeContains(*i.TypeContains))
+	}
+	if i.TypeHasPrefix != nil {
+		predicates = append(predicates, ride.TypeHasPrefix(*i.TypeHasPrefix))
+	}
+	if i.TypeHasSuffix != nil {
+		predicates = append(predicates, ride.TypeHasSuffix(*i.TypeHasSuffix))
+	}
+	if i.TypeEqualFold != nil {
+		predicates = append(predicates, ride.TypeEqualFold(*i.TypeEqualFold))
+	}
+	if i.TypeContainsFold != nil {
+		predicates = append(predicates, ride.TypeContainsFold(*i.TypeContainsFold))
+	}
+	if i.RideType != nil {
+		predicates = append(predicates, ride.RideTypeEQ(*i.RideType))
+	}
+	if i.RideTypeNEQ != nil {
+		predicates = append(predicates, ride.RideTypeNEQ(*i.RideTypeNEQ))
+	}
+	if len(i.RideTypeIn) > 0 {
+		predicates = append(predicates, ride.RideTypeIn(i.RideTypeIn...))
+	}
+	if len(i.RideTypeNotIn) > 0 {
+		predicates = append(predicates, ride.RideTypeNotIn(i.RideTypeNotIn...))
+	}
+	if i.RideTypeGT != nil {
+		predicates = append(predicates, ride.RideTypeGT(*i.RideTypeGT))
+	}
+	if i.RideTypeGTE != nil {
+		predicates = append(predicates, ride.RideTypeGTE(*i.RideTypeGTE))
+	}
+	if i.RideTypeLT != nil {
+		predicates = append(predicates, ride.RideTypeLT(*i.RideTypeLT))
+	}
+	if i.RideTypeLTE != nil {
+		predicates = append(predicates, ride.RideTypeLTE(*i.RideTypeLTE))
+	}
+	if i.RideTypeContains != nil {
+		predicates = append(predicates, ride.RideTypeContains(*i.RideTypeContains))
+	}
+	if i.RideTypeHasPrefix != nil {
+		predicates = append(predicates, ride.RideTypeHasPrefix(*i.RideTypeHasPrefix))
+	}
+	if i.RideTypeHasSuffix != nil {
+		predicates = append(predicates, ride.RideTypeHasSuffix(*i.RideTypeHasSuffix))
+	}
+	if i.RideTypeEqualFold != nil {
+		predicates = append(predicates, ride.RideTypeEqualFold(*i.RideTypeEqualFold))
+	}
+	if i.RideTypeContainsFold != nil {
+		predicates = append(predicates, ride.RideTypeContainsFold(*i.RideTypeContainsFold))
+	}
+	if i.DepartureTime != nil {
+		predicates = append(predicates, ride.DepartureTimeEQ(*i.DepartureTime))
+	}
+	if i.DepartureTimeNEQ != nil {
+		predicates = append(predicates, ride.DepartureTimeNEQ(*i.DepartureTimeNEQ))
+	}
+	if len(i.DepartureTimeIn) > 0 {
+		predicates = append(predicates, ride.DepartureTimeIn(i.DepartureTimeIn...))
+	}
+	if len(i.DepartureTimeNotIn) > 0 {
+		predicates = append(predicates, ride.DepartureTimeNotIn(i.DepartureTimeNotIn...))
+	}
+	if i.DepartureTimeGT != nil {
+		predicates = append(predicates, ride.DepartureTimeGT(*i.DepartureTimeGT))
+	}
+	if i.DepartureTimeGTE != nil {
+		predicates = append(predicates, ride.DepartureTimeGTE(*i.DepartureTimeGTE))
+	}
+	if i.DepartureTimeLT != nil {
+		predicates = append(predicates, ride.DepartureTimeLT(*i.DepartureTimeLT))
+	}
+	if i.DepartureTimeLTE != nil {
+		predicates = append(predicates, ride.DepartureTimeLTE(*i.DepartureTimeLTE))
+	}
+	if i.ArrivalTime != nil {
+		predicates = append(predicates, ride.ArrivalTimeEQ(*i.ArrivalTime))
+	}
+	if i.ArrivalTimeNEQ != nil {
+		predicates = append(predicates, ride.ArrivalTimeNEQ(*i.ArrivalTimeNEQ))
+	}
+	if len(i.ArrivalTimeIn) > 0 {
+		predicates = append(predicates, ride.ArrivalTimeIn(i.ArrivalTimeIn...))
+	}
+	if len(i.ArrivalTimeNotIn) > 0 {
+		predicates = append(predicates, ride.ArrivalTimeNotIn(i.ArrivalTimeNotIn...))
+	}
+	if i.ArrivalTimeGT != nil {
+		predicates = append(predicates, ride.ArrivalTimeGT(*i.ArrivalTimeGT))
+	}
+	if i.ArrivalTimeGTE != nil {
+		predicates = append(predicates, ride.ArrivalTimeGTE(*i.ArrivalTimeGTE))
+	}
+	if i.ArrivalTimeLT != nil {
+		predicates = append(predicates, ride.ArrivalTimeLT(*i.ArrivalTimeLT))
+	}
+	if i.ArrivalTimeLTE != nil {
+		predicates = append(predicates, ride.ArrivalTimeLTE(*i.ArrivalTimeLTE))
+	}
+	if i.ArrivalTimeIsNil {
+		predicates = append(predicates, ride.ArrivalTimeIsNil())
+	}
+	if i.ArrivalTimeNotNil {
+		predicates = append(predicates, ride.ArrivalTimeNotNil())
+	}
+	if i.DurationMinutes != nil {
+		predicates = append(predicates, ride.DurationMinutesEQ(*i.DurationMinutes))
+	}
+	if i.DurationMinutesNEQ != nil {
+		predicates = append(predicates, ride.DurationMinutesNEQ(*i.DurationMinutesNEQ))
+	}
+	if len(i.DurationMinutesIn) > 0 {
+		predicates = append(predicates, ride.DurationMinutesIn(i.DurationMinutesIn...))
+	}
+	if len(i.DurationMinutesNotIn) > 0 {
+		predicates = append(predicates, ride.DurationMinutesNotIn(i.DurationMinutesNotIn...))
+	}
+	if i.DurationMinutesGT != nil {
+		predicates = append(predicates, ride.DurationMinutesGT(*i.DurationMinutesGT))
+	}
+	if i.DurationMinutesGTE != nil {
+		predicates = append(predicates, ride.DurationMinutesGTE(*i.DurationMinutesGTE))
+	}
+	if i.DurationMinutesLT != nil {
+		predicates = append(predicates, ride.DurationMinutesLT(*i.DurationMinutesLT))
+	}
+	if i.DurationMinutesLTE != nil {
+		predicates = append(predicates, ride.DurationMinutesLTE(*i.DurationMinutesLTE))
+	}
+	if i.DurationMinutesIsNil {
+		predicates = append(predicates, ride.DurationMinutesIsNil())
+	}
+	if i.DurationMinutesNotNil {
+		predicates = append(predicates, ride.DurationMinutesNotNil())
+	}
+	if i.OriginCity != nil {
+		predicates = append(predicates, ride.OriginCityEQ(*i.OriginCity))
+	}
+	if i.OriginCityNEQ != nil {
+		predicates = append(predicates, ride.OriginCityNEQ(*i.OriginCityNEQ))
+	}
+	if len(i.OriginCityIn) > 0 {
+		predicates = append(predicates, ride.OriginCityIn(i.OriginCityIn...))
+	}
+	if len(i.OriginCityNotIn) > 0 {
+		predicates = append(predicates, ride.OriginCityNotIn(i.OriginCityNotIn...))
+	}
+	if i.OriginCityGT != nil {
+		predicates = append(predicates, ride.OriginCityGT(*i.OriginCityGT))
+	}
+	if i.OriginCityGTE != nil {
+		predicates = append(predicates, ride.OriginCityGTE(*i.OriginCityGTE))
+	}
+	if i.OriginCityLT != nil {
+		predicates = append(predicates, ride.OriginCityLT(*i.OriginCityLT))
+	}
+	if i.OriginCityLTE != nil {
+		predicates = append(predicates, ride.OriginCityLTE(*i.OriginCityLTE))
+	}
+	if i.OriginCityContains != nil {
+		predicates = append(predicates, ride.OriginCityContains(*i.OriginCityContains))
+	}
+	if i.OriginCityHasPrefix != nil {
+		predicates = append(predicates, ride.OriginCityHasPrefix(*i.OriginCityHasPrefix))
+	}
+	if i.OriginCityHasSuffix != nil {
+		predicates = append(predicates, ride.OriginCityHasSuffix(*i.OriginCityHasSuffix))
+	}
+	if i.OriginCityEqualFold != nil {
+		predicates = append(predicates, ride.OriginCityEqualFold(*i.OriginCityEqualFold))
+	}
+	if i.OriginCityContainsFold != nil {
+		predicates = append(predicates, ride.OriginCityContainsFold(*i.OriginCityContainsFold))
+	}
+	if i.OriginAddress != nil {
+		predicates = append(predicates, ride.OriginAddressEQ(*i.OriginAddress))
+	}
+	if i.OriginAddressNEQ != nil {
+		predicates = append(predicates, ride.OriginAddressNEQ(*i.OriginAddressNEQ))
+	}
+	if len(i.OriginAddressIn) > 0 {
+		predicates = append(predicates, ride.OriginAddressIn(i.OriginAddressIn...))
+	}
+	if len(i.OriginAddressNotIn) > 0 {
+		predicates = append(predicates, ride.OriginAddressNotIn(i.OriginAddressNotIn...))
+	}
+	if i.OriginAddressGT != nil {
+		predicates = append(predicates, ride.OriginAddressGT(*i.OriginAddressGT))
+	}
+	if i.OriginAddressGTE != nil {
+		predicates = append(predicates, ride.OriginAddressGTE(*i.OriginAddressGTE))
+	}
+	if i.OriginAddressLT != nil {
+		predicates = append(predicates, ride.OriginAddressLT(*i.OriginAddressLT))
+	}
+	if i.OriginAddressLTE != nil {
+		predicates = append(predicates, ride.OriginAddressLTE(*i.OriginAddressLTE))
+	}
+	if i.OriginAddressContains != nil {
+		predicates = append(predicates, ride.OriginAddressContains(*i.OriginAddressContains))
+	}
+	if i.OriginAddressHasPrefix != nil {
+		predicates = append(predicates, ride.OriginAddressHasPrefix(*i.OriginAddressHasPrefix))
+	}
+	if i.OriginAddressHasSuffix != nil {
+		predicates = append(predicates, ride.OriginAddressHasSuffix(*i.OriginAddressHasSuffix))
+	}
+	if i.OriginAddressEqualFold != nil {
+		predicates = append(predicates, ride.OriginAddressEqualFold(*i.OriginAddressEqualFold))
+	}
+	if i.OriginAddressContainsFold != nil {
+		predicates = append(predicates, ride.OriginAddressContainsFold(*i.OriginAddressContainsFold))
+	}
+	if i.OriginLocationPoint != nil {
+		predicates = append(predicates, ride.OriginLocationPointEQ(*i.OriginLocationPoint))
+	}
+	if i.OriginLocationPointNEQ != nil {
+		predicates = append(predicates, ride.OriginLocationPointNEQ(*i.OriginLocationPointNEQ))
+	}
+	if len(i.OriginLocationPointIn) > 0 {
+		predicates = append(predicates, ride.OriginLocationPointIn(i.OriginLocationPointIn...))
+	}
+	if len(i.OriginLocationPointNotIn) > 0 {
+		predicates = append(predicates, ride.OriginLocationPointNotIn(i.OriginLocationPointNotIn...))
+	}
+	if i.OriginLocationPointGT != nil {
+		predicates = append(predicates, ride.OriginLocationPointGT(*i.OriginLocationPointGT))
+	}
+	if i.OriginLocationPointGTE != nil {
+		predicates = append(predicates, ride.OriginLocationPointGTE(*i.OriginLocationPointGTE))
+	}
+	if i.OriginLocationPointLT != nil {
+		predicates = append(predicates, ride.OriginLocationPointLT(*i.OriginLocationPointLT))
+	}
+	if i.OriginLocationPointLTE != nil {
+		predicates = append(predicates, ride.OriginLocationPointLTE(*i.OriginLocationPointLTE))
+	}
+	if i.OriginLocationPointContains != nil {
+		predicates = append(predicates, ride.OriginLocationPointContains(*i.OriginLocationPointContains))
+	}
+	if i.OriginLocationPointHasPrefix != nil {
+		predicates = append(predicates, ride.OriginLocationPointHasPrefix(*i.OriginLocationPointHasPrefix))
+	}
+	if i.OriginLocationPointHasSuffix != nil {
+		predicates = append(predicates, ride.OriginLocationPointHasSuffix(*i.OriginLocationPointHasSuffix))
+	}
+	if i.OriginLocationPointIsNil {
+		predicates = append(predicates, ride.OriginLocationPointIsNil())
+	}
+	if i.OriginLocationPointNotNil {
+		predicates = append(predicates, ride.OriginLocationPointNotNil())
+	}
+	if i.OriginLocationPointEqualFold != nil {
+		predicates = append(predicates, ride.OriginLocationPointEqualFold(*i.OriginLocationPointEqualFold))
+	}
+	if i.OriginLocationPointContainsFold != nil {
+		predicates = append(predicates, ride.OriginLocationPointContainsFold(*i.OriginLocationPointContainsFold))
+	}
+	if i.DestinationCity != nil {
+		predicates = append(predicates, ride.DestinationCityEQ(*i.DestinationCity))
+	}
+	if i.DestinationCityNEQ != nil {
+		predicates = append(predicates, ride.DestinationCityNEQ(*i.DestinationCityNEQ))
+	}
+	if len(i.DestinationCityIn) > 0 {
+		predicates = append(predicates, ride.DestinationCityIn(i.DestinationCityIn...))
+	}
+	if len(i.DestinationCityNotIn) > 0 {
+		predicates = append(predicates, ride.DestinationCityNotIn(i.DestinationCityNotIn...))
+	}
+	if i.DestinationCityGT != nil {
+		predicates = append(predicates, ride.DestinationCityGT(*i.DestinationCityGT))
+	}
+	if i.DestinationCityGTE != nil {
+		predicates = append(predicates, ride.DestinationCityGTE(*i.DestinationCityGTE))
+	}
+	if i.DestinationCityLT != nil {
+		predicates = append(predicates, ride.DestinationCityLT(*i.DestinationCityLT))
+	}
+	if i.DestinationCityLTE != nil {
+		predicates = append(predicates, ride.DestinationCityLTE(*i.DestinationCityLTE))
+	}
+	if i.DestinationCityContains != nil {
+		predicates = append(predicates, ride.DestinationCityContains(*i.DestinationCityContains))
+	}
+	if i.DestinationCityHasPrefix != nil {
+		predicates = append(predicates, ride.DestinationCityHasPrefix(*i.DestinationCityHasPrefix))
+	}
+	if i.DestinationCityHasSuffix != nil {
+		predicates = append(predicates, ride.DestinationCityHasSuffix(*i.DestinationCityHasSuffix))
+	}
+	if i.DestinationCityEqualFold != nil {
+		predicates = append(predicates, ride.DestinationCityEqualFold(*i.DestinationCityEqualFold))
+	}
+	if i.DestinationCityContainsFold != nil {
+		predicates = append(predicates, ride.DestinationCityContainsFold(*i.DestinationCityContainsFold))
+	}
+	if i.DestinationAddress != nil {
+		predicates = append(predicates, ride.DestinationAddressEQ(*i.DestinationAddress))
+	}
+	if i.DestinationAddressNEQ != nil {
+		predicates = append(predicates, ride.DestinationAddressNEQ(*i.DestinationAddressNEQ))
+	}
+	if len(i.DestinationAddressIn) > 0 {
+		predicates = append(predicates, ride.DestinationAddressIn(i.DestinationAddressIn...))
+	}
+	if len(i.DestinationAddressNotIn) > 0 {
+		predicates = append(predicates, ride.DestinationAddressNotIn(i.DestinationAddressNotIn...))
+	}
+	if i.DestinationAddressGT != nil {
+		predicates = append(predicates, ride.DestinationAddressGT(*i.DestinationAddressGT))
+	}
+	if i.DestinationAddressGTE != nil {
+		predicates = append(predicates, ride.DestinationAddressGTE(*i.DestinationAddressGTE))
+	}
+	if i.DestinationAddressLT != nil {
+		predicates = append(predicates, ride.DestinationAddressLT(*i.DestinationAddressLT))
+	}
+	if i.DestinationAddressLTE != nil {
+		predicates = append(predicates, ride.DestinationAddressLTE(*i.DestinationAddressLTE))
+	}
+	if i.DestinationAddressContains != nil {
+		predicates = append(predicates, ride.DestinationAddressContains(*i.DestinationAddressContains))
+	}
+	if i.DestinationAddressHasPrefix != nil {
+		predicates = append(predicates, ride.DestinationAddressHasPrefix(*i.DestinationAddressHasPrefix))
+	}
+	if i.DestinationAddressHasSuffix != nil {
+		predicates = append(predicates, ride.DestinationAddressHasSuffix(*i.DestinationAddressHasSuffix))
+	}
+	if i.DestinationAddressEqualFold != nil {
+		predicates = append(predicates, ride.DestinationAddressEqualFold(*i.DestinationAddressEqualFold))
+	}
+	if i.DestinationAddressContainsFold != nil {
+		predicates = append(predicates, ride.DestinationAddressContainsFold(*i.DestinationAddressContainsFold))
+	}
+	if i.DestinationLocationPoint != nil {
+		predicates = append(predicates, ride.DestinationLocationPointEQ(*i.DestinationLocationPoint))
+	}
+	if i.DestinationLocationPointNEQ != nil {
+		predicates = append(predicates, ride.DestinationLocationPointNEQ(*i.DestinationLocationPointNEQ))
+	}
+	if len(i.DestinationLocationPointIn) > 0 {
+		predicates = append(predicates, ride.DestinationLocationPointIn(i.DestinationLocationPointIn...))
+	}
+	if len(i.DestinationLocationPointNotIn) > 0 {
+		predicates = append(predicates, ride.DestinationLocationPointNotIn(i.DestinationLocationPointNotIn...))
+	}
+	if i.DestinationLocationPointGT != nil {
+		predicates = append(predicates, ride.DestinationLocationPointGT(*i.DestinationLocationPointGT))
+	}
+	if i.DestinationLocationPointGTE != nil {
+		predicates = append(predicates, ride.DestinationLocationPointGTE(*i.DestinationLocationPointGTE))
+	}
+	if i.DestinationLocationPointLT != nil {
+		predicates = append(predicates, ride.DestinationLocationPointLT(*i.DestinationLocationPointLT))
+	}
+	if i.DestinationLocationPointLTE != nil {
+		predicates = append(predicates, ride.DestinationLocationPointLTE(*i.DestinationLocationPointLTE))
+	}
+	if i.DestinationLocationPointContains != nil {
+		predicates = append(predicates, ride.DestinationLocationPointContains(*i.DestinationLocationPointContains))
+	}
+	if i.DestinationLocationPointHasPrefix != nil {
+		predicates = append(predicates, ride.DestinationLocationPointHasPrefix(*i.DestinationLocationPointHasPrefix))
+	}
+	if i.DestinationLocationPointHasSuffix != nil {
+		predicates = append(predicates, ride.DestinationLocationPointHasSuffix(*i.DestinationLocationPointHasSuffix))
+	}
+	if i.DestinationLocationPointIsNil {
+		predicates = append(predicates, ride.DestinationLocationPointIsNil())
+	}
+	if i.DestinationLocationPointNotNil {
+		predicates = append(predicates, ride.DestinationLocationPointNotNil())
+	}
+	if i.DestinationLocationPointEqualFold != nil {
+		predicates = append(predicates, ride.DestinationLocationPointEqualFold(*i.DestinationLocationPointEqualFold))
+	}
+	if i.DestinationLocationPointContainsFold != nil {
+		predicates = append(predicates, ride.DestinationLocationPointContainsFold(*i.DestinationLocationPointContainsFold))
+	}
+	if i.PriceAmount != nil {
+		predicates = append(predicates, ride.PriceAmountEQ(*i.PriceAmount))
+	}
+	if i.PriceAmountNEQ != nil {
+		predicates = append(predicates, ride.PriceAmountNEQ(*i.PriceAmountNEQ))
+	}
+	if len(i.PriceAmountIn) > 0 {
+		predicates = append(predicates, ride.PriceAmountIn(i.PriceAmountIn...))
+	}
+	if len(i.PriceAmountNotIn) > 0 {
+		predicates = append(predicates, ride.PriceAmountNotIn(i.PriceAmountNotIn...))
+	}
+	if i.PriceAmountGT != nil {
+		predicates = append(predicates, ride.PriceAmountGT(*i.PriceAmountGT))
+	}
+	if i.PriceAmountGTE != nil {
+		predicates = append(predicates, ride.PriceAmountGTE(*i.PriceAmountGTE))
+	}
+	if i.PriceAmountLT != nil {
+		predicates = append(predicates, ride.PriceAmountLT(*i.PriceAmountLT))
+	}
+	if i.PriceAmountLTE != nil {
+		predicates = append(predicates, ride.PriceAmountLTE(*i.PriceAmountLTE))
+	}
+	if i.PriceCurrency != nil {
+		predicates = append(predicates, ride.PriceCurrencyEQ(*i.PriceCurrency))
+	}
+	if i.PriceCurrencyNEQ != nil {
+		predicates = append(predicates, ride.PriceCurrencyNEQ(*i.PriceCurrencyNEQ))
+	}
+	if len(i.PriceCurrencyIn) > 0 {
+		predicates = append(predicates, ride.PriceCurrencyIn(i.PriceCurrencyIn...))
+	}
+	if len(i.PriceCurrencyNotIn) > 0 {
+		predicates = append(predicates, ride.PriceCurrencyNotIn(i.PriceCurrencyNotIn...))
+	}
+	if i.PriceCurrencyGT != nil {
+		predicates = append(predicates, ride.PriceCurrencyGT(*i.PriceCurrencyGT))
+	}
+	if i.PriceCurrencyGTE != nil {
+		predicates = append(predicates, ride.PriceCurrencyGTE(*i.PriceCurrencyGTE))
+	}
+	if i.PriceCurrencyLT != nil {
+		predicates = append(predicates, ride.PriceCurrencyLT(*i.PriceCurrencyLT))
+	}
+	if i.PriceCurrencyLTE != nil {
+		predicates = append(predicates, ride.PriceCurrencyLTE(*i.PriceCurrencyLTE))
+	}
+	if i.PriceCurrencyContains != nil {
+		predicates = append(predicates, ride.PriceCurrencyContains(*i.PriceCurrencyContains))
+	}
+	if i.PriceCurrencyHasPrefix != nil {
+		predicates = append(predicates, ride.PriceCurrencyHasPrefix(*i.PriceCurrencyHasPrefix))
+	}
+	if i.PriceCurrencyHasSuffix != nil {
+		predicates = append(predicates, ride.PriceCurrencyHasSuffix(*i.PriceCurrencyHasSuffix))
+	}
+	if i.PriceCurrencyEqualFold != nil {
+		predicates = append(predicates, ride.PriceCurrencyEqualFold(*i.PriceCurrencyEqualFold))
+	}
+	if i.PriceCurrencyContainsFold != nil {
+		predicates = append(predicates, ride.PriceCurrencyContainsFold(*i.PriceCurrencyContainsFold))
+	}
+	if i.AvailableSeats != nil {
+		predicates = append(predicates, ride.AvailableSeatsEQ(*i.AvailableSeats))
+	}
+	if i.AvailableSeatsNEQ != nil {
+		predicates = append(predicates, ride.AvailableSeatsNEQ(*i.AvailableSeatsNEQ))
+	}
+	if len(i.AvailableSeatsIn) > 0 {
+		predicates = append(predicates, ride.AvailableSeatsIn(i.AvailableSeatsIn...))
+	}
+	if len(i.AvailableSeatsNotIn) > 0 {
+		predicates = append(predicates, ride.AvailableSeatsNotIn(i.AvailableSeatsNotIn...))
+	}
+	if i.AvailableSeatsGT != nil {
+		predicates = append(predicates, ride.AvailableSeatsGT(*i.AvailableSeatsGT))
+	}
+	if i.AvailableSeatsGTE != nil {
+		predicates = append(predicates, ride.AvailableSeatsGTE(*i.AvailableSeatsGTE))
+	}
+	if i.AvailableSeatsLT != nil {
+		predicates = append(predicates, ride.AvailableSeatsLT(*i.AvailableSeatsLT))
+	}
+	if i.AvailableSeatsLTE != nil {
+		predicates = append(predicates, ride.AvailableSeatsLTE(*i.AvailableSeatsLTE))
+	}
+	if i.TotalSeats != nil {
+		predicates = append(predicates, ride.TotalSeatsEQ(*i.TotalSeats))
+	}
+	if i.TotalSeatsNEQ != nil {
+		predicates = append(predicates, ride.TotalSeatsNEQ(*i.TotalSeatsNEQ))
+	}
+	if len(i.TotalSeatsIn) > 0 {
+		predicates = append(predicates, ride.TotalSeatsIn(i.TotalSeatsIn...))
+	}
+	if len(i.TotalSeatsNotIn) > 0 {
+		predicates = append(predicates, ride.TotalSeatsNotIn(i.TotalSeatsNotIn...))
+	}
+	if i.TotalSeatsGT != nil {
+		predicates = append(predicates, ride.TotalSeatsGT(*i.TotalSeatsGT))
+	}
+	if i.TotalSeatsGTE != nil {
+		predicates = append(predicates, ride.TotalSeatsGTE(*i.TotalSeatsGTE))
+	}
+	if i.TotalSeatsLT != nil {
+		predicates = append(predicates, ride.TotalSeatsLT(*i.TotalSeatsLT))
+	}
+	if i.TotalSeatsLTE != nil {
+		predicates = append(predicates, ride.TotalSeatsLTE(*i.TotalSeatsLTE))
+	}
+	if i.InstantConfirmation != nil {
+		predicates = append(predicates, ride.InstantConfirmationEQ(*i.InstantConfirmation))
+	}
+	if i.InstantConfirmationNEQ != nil {
+		predicates = append(predicates, ride.InstantConfirmationNEQ(*i.InstantConfirmationNEQ))
+	}
+	if i.CancellationPolicy != nil {
+		predicates = append(predicates, ride.CancellationPolicyEQ(*i.CancellationPolicy))
+	}
+	if i.CancellationPolicyNEQ != nil {
+		predicates = append(predicates, ride.CancellationPolicyNEQ(*i.CancellationPolicyNEQ))
+	}
+	if len(i.CancellationPolicyIn) > 0 {
+		predicates = append(predicates, ride.CancellationPolicyIn(i.CancellationPolicyIn...))
+	}
+	if len(i.CancellationPolicyNotIn) > 0 {
+		predicates = append(predicates, ride.CancellationPolicyNotIn(i.CancellationPolicyNotIn...))
+	}
+	if i.CancellationPolicyGT != nil {
+		predicates = append(predicates, ride.CancellationPolicyGT(*i.CancellationPolicyGT))
+	}
+	if i.CancellationPolicyGTE != nil {
+		predicates = append(predicates, ride.CancellationPolicyGTE(*i.CancellationPolicyGTE))
+	}
+	if i.CancellationPolicyLT != nil {
+		predicates = append(predicates, ride.CancellationPolicyLT(*i.CancellationPolicyLT))
+	}
+	if i.CancellationPolicyLTE != nil {
+		predicates = append(predicates, ride.CancellationPolicyLTE(*i.CancellationPolicyLTE))
+	}
+	if i.CancellationPolicyContains != nil {
+		predicates = append(predicates, ride.CancellationPolicyContains(*i.CancellationPolicyContains))
+	}
+	if i.CancellationPolicyHasPrefix != nil {
+		predicates = append(predicates, ride.CancellationPolicyHasPrefix(*i.CancellationPolicyHasPrefix))
+	}
+	if i.CancellationPolicyHasSuffix != nil {
+		predicates = append(predicates, ride.CancellationPolicyHasSuffix(*i.CancellationPolicyHasSuffix))
+	}
+	if i.CancellationPolicyEqualFold != nil {
+		predicates = append(predicates, ride.CancellationPolicyEqualFold(*i.CancellationPolicyEqualFold))
+	}
+	if i.CancellationPolicyContainsFold != nil {
+		predicates = append(predicates, ride.CancellationPolicyContainsFold(*i.CancellationPolicyContainsFold))
+	}
+	if i.Description != nil {
+		predicates = append(predicates, ride.DescriptionEQ(*i.Description))
+	}
+	if i.DescriptionNEQ != nil {
+		predicates = append(predicates, ride.DescriptionNEQ(*i.DescriptionNEQ))
+	}
+	if len(i.DescriptionIn) > 0 {
+		predicates = append(predicates, ride.DescriptionIn(i.DescriptionIn...))
+	}
+	if len(i.DescriptionNotIn) > 0 {
+		predicates = append(predicates, ride.DescriptionNotIn(i.DescriptionNotIn...))
+	}
+	if i.DescriptionGT != nil {
+		predicates = append(predicates, ride.DescriptionGT(*i.DescriptionGT))
+	}
+	if i.DescriptionGTE != nil {
+		predicates = append(predicates, ride.DescriptionGTE(*i.DescriptionGTE))
+	}
+	if i.DescriptionLT != nil {
+		predicates = append(predicates, ride.DescriptionLT(*i.DescriptionLT))
+	}
+	if i.DescriptionLTE != nil {
+		predicates = append(predicates, ride.DescriptionLTE(*i.DescriptionLTE))
+	}
+	if i.DescriptionContains != nil {
+		predicates = append(predicates, ride.DescriptionContains(*i.DescriptionContains))
+	}
+	if i.DescriptionHasPrefix != nil {
+		predicates = append(predicates, ride.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+	}
+	if i.DescriptionHasSuffix != nil {
+		predicates = append(predicates, ride.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	}
+	if i.DescriptionIsNil {
+		predicates = append(predicates, ride.DescriptionIsNil())
+	}
+	if i.DescriptionNotNil {
+		predicates = append(predicates, ride.DescriptionNotNil())
+	}
+	if i.DescriptionEqualFold != nil {
+		predicates = append(predicates, ride.DescriptionEqualFold(*i.DescriptionEqualFold))
+	}
+	if i.DescriptionContainsFold != nil {
+		predicates = append(predicates, ride.DescriptionContainsFold(*i.DescriptionContainsFold))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, ride.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, ride.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, ride.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, ride.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.StatusGT != nil {
+		predicates = append(predicates, ride.StatusGT(*i.StatusGT))
+	}
+	if i.StatusGTE != nil {
+		predicates = append(predicates, ride.StatusGTE(*i.StatusGTE))
+	}
+	if i.StatusLT != nil {
+		predicates = append(predicates, ride.StatusLT(*i.StatusLT))
+	}
+	if i.StatusLTE != nil {
+		predicates = append(predicates, ride.StatusLTE(*i.StatusLTE))
+	}
+	if i.StatusContains != nil {
+		predicates = append(predicates, ride.StatusContains(*i.StatusContains))
+	}
+	if i.StatusHasPrefix != nil {
+		predicates = append(predicates, ride.StatusHasPrefix(*i.StatusHasPrefix))
+	}
+	if i.StatusHasSuffix != nil {
+		predicates = append(predicates, ride.StatusHasSuffix(*i.StatusHasSuffix))
+	}
+	if i.StatusEqualFold != nil {
+		predicates = append(predicates, ride.StatusEqualFold(*i.StatusEqualFold))
+	}
+	if i.StatusContainsFold != nil {
+		predicates = append(predicates, ride.StatusContainsFold(*i.StatusContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, ride.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, ride.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, ride.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, ride.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, ride.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, ride.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, ride.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, ride.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, ride.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, ride.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, ride.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, ride.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, ride.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, ride.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, ride.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, ride.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyRideWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return ride.And(predicates...), nil
+	}
+}
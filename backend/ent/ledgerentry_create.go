@@ -0,0 +1,371 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/slowtyper/poolie/backend/ent/ledgerentry"
+)
+
+// LedgerEntryCreate is the builder for creating a LedgerEntry entity.
+type LedgerEntryCreate struct {
+	config
+	mutation *LedgerEntryMutation
+	hooks    []Hook
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (_c *LedgerEntryCreate) SetTransactionID(v string) *LedgerEntryCreate {
+	_c.mutation.SetTransactionID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *LedgerEntryCreate) SetKind(v string) *LedgerEntryCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetAccount sets the "account" field.
+func (_c *LedgerEntryCreate) SetAccount(v string) *LedgerEntryCreate {
+	_c.mutation.SetAccount(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LedgerEntryCreate) SetUserID(v string) *LedgerEntryCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *LedgerEntryCreate) SetNillableUserID(v *string) *LedgerEntryCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetBookingID sets the "booking_id" field.
+func (_c *LedgerEntryCreate) SetBookingID(v string) *LedgerEntryCreate {
+	_c.mutation.SetBookingID(v)
+	return _c
+}
+
+// SetNillableBookingID sets the "booking_id" field if the given value is not nil.
+func (_c *LedgerEntryCreate) SetNillableBookingID(v *string) *LedgerEntryCreate {
+	if v != nil {
+		_c.SetBookingID(*v)
+	}
+	return _c
+}
+
+// SetPayoutID sets the "payout_id" field.
+func (_c *LedgerEntryCreate) SetPayoutID(v string) *LedgerEntryCreate {
+	_c.mutation.SetPayoutID(v)
+	return _c
+}
+
+// SetNillablePayoutID sets the "payout_id" field if the given value is not nil.
+func (_c *LedgerEntryCreate) SetNillablePayoutID(v *string) *LedgerEntryCreate {
+	if v != nil {
+		_c.SetPayoutID(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *LedgerEntryCreate) SetAmount(v int64) *LedgerEntryCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *LedgerEntryCreate) SetCurrency(v string) *LedgerEntryCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *LedgerEntryCreate) SetNillableCurrency(v *string) *LedgerEntryCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LedgerEntryCreate) SetCreatedAt(v time.Time) *LedgerEntryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LedgerEntryCreate) SetNillableCreatedAt(v *time.Time) *LedgerEntryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LedgerEntryCreate) SetID(v string) *LedgerEntryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LedgerEntryCreate) SetNillableID(v *string) *LedgerEntryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the LedgerEntryMutation object of the builder.
+func (_c *LedgerEntryCreate) Mutation() *LedgerEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the LedgerEntry in the database.
+func (_c *LedgerEntryCreate) Save(ctx context.Context) (*LedgerEntry, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LedgerEntryCreate) SaveX(ctx context.Context) *LedgerEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LedgerEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LedgerEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LedgerEntryCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := ledgerentry.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ledgerentry.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := ledgerentry.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LedgerEntryCreate) check() error {
+	if _, ok := _c.mutation.TransactionID(); !ok {
+		return &ValidationError{Name: "transaction_id", err: errors.New(`ent: missing required field "LedgerEntry.transaction_id"`)}
+	}
+	if v, ok := _c.mutation.TransactionID(); ok {
+		if err := ledgerentry.TransactionIDValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_id", err: fmt.Errorf(`ent: validator failed for field "LedgerEntry.transaction_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "LedgerEntry.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := ledgerentry.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "LedgerEntry.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Account(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required field "LedgerEntry.account"`)}
+	}
+	if v, ok := _c.mutation.Account(); ok {
+		if err := ledgerentry.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`ent: validator failed for field "LedgerEntry.account": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "LedgerEntry.amount"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "LedgerEntry.currency"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LedgerEntry.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *LedgerEntryCreate) sqlSave(ctx context.Context) (*LedgerEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LedgerEntry.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LedgerEntryCreate) createSpec() (*LedgerEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LedgerEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ledgerentry.Table, sqlgraph.NewFieldSpec(ledgerentry.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.TransactionID(); ok {
+		_spec.SetField(ledgerentry.FieldTransactionID, field.TypeString, value)
+		_node.TransactionID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(ledgerentry.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Account(); ok {
+		_spec.SetField(ledgerentry.FieldAccount, field.TypeString, value)
+		_node.Account = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(ledgerentry.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.BookingID(); ok {
+		_spec.SetField(ledgerentry.FieldBookingID, field.TypeString, value)
+		_node.BookingID = value
+	}
+	if value, ok := _c.mutation.PayoutID(); ok {
+		_spec.SetField(ledgerentry.FieldPayoutID, field.TypeString, value)
+		_node.PayoutID = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(ledgerentry.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(ledgerentry.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ledgerentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// LedgerEntryCreateBulk is the builder for creating many LedgerEntry entities in bulk.
+type LedgerEntryCreateBulk struct {
+	config
+	err      error
+	builders []*LedgerEntryCreate
+}
+
+// Save creates the LedgerEntry entities in the database.
+func (_c *LedgerEntryCreateBulk) Save(ctx context.Context) ([]*LedgerEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LedgerEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LedgerEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LedgerEntryCreateBulk) SaveX(ctx context.Context) []*LedgerEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LedgerEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LedgerEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -13,12 +13,25 @@ var (
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "status", Type: field.TypeString, Default: "pending"},
 		{Name: "passenger_count", Type: field.TypeInt},
+		{Name: "subtotal_amount", Type: field.TypeInt64, Default: 0},
+		{Name: "promo_code", Type: field.TypeString, Nullable: true},
+		{Name: "discount_amount", Type: field.TypeInt64, Default: 0},
+		{Name: "credit_amount", Type: field.TypeInt64, Default: 0},
 		{Name: "total_price_amount", Type: field.TypeInt64},
 		{Name: "total_price_currency", Type: field.TypeString, Default: "IDR"},
+		{Name: "display_currency", Type: field.TypeString, Nullable: true},
+		{Name: "display_total_amount", Type: field.TypeInt64, Nullable: true},
+		{Name: "exchange_rate", Type: field.TypeString, Nullable: true},
+		{Name: "exchange_rate_as_of", Type: field.TypeTime, Nullable: true},
 		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "driver_response_message", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancelled_by", Type: field.TypeString, Nullable: true},
+		{Name: "cancellation_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "refund_amount", Type: field.TypeInt64, Default: 0},
+		{Name: "penalty_amount", Type: field.TypeInt64, Default: 0},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "ride_id", Type: field.TypeString},
 		{Name: "passenger_id", Type: field.TypeString},
@@ -31,13 +44,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "bookings_rides_bookings",
-				Columns:    []*schema.Column{BookingsColumns[10]},
+				Columns:    []*schema.Column{BookingsColumns[23]},
 				RefColumns: []*schema.Column{RidesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "bookings_users_bookings",
-				Columns:    []*schema.Column{BookingsColumns[11]},
+				Columns:    []*schema.Column{BookingsColumns[24]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -46,12 +59,12 @@ var (
 			{
 				Name:    "booking_ride_id",
 				Unique:  false,
-				Columns: []*schema.Column{BookingsColumns[10]},
+				Columns: []*schema.Column{BookingsColumns[23]},
 			},
 			{
 				Name:    "booking_passenger_id",
 				Unique:  false,
-				Columns: []*schema.Column{BookingsColumns[11]},
+				Columns: []*schema.Column{BookingsColumns[24]},
 			},
 			{
 				Name:    "booking_status",
@@ -60,6 +73,283 @@ var (
 			},
 		},
 	}
+	// CreditsColumns holds the columns for the "credits" table.
+	CreditsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Default: "IDR"},
+		{Name: "source_user_id", Type: field.TypeString, Nullable: true},
+		{Name: "booking_id", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CreditsTable holds the schema information for the "credits" table.
+	CreditsTable = &schema.Table{
+		Name:       "credits",
+		Columns:    CreditsColumns,
+		PrimaryKey: []*schema.Column{CreditsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "credit_user_id_currency",
+				Unique:  false,
+				Columns: []*schema.Column{CreditsColumns[1], CreditsColumns[4]},
+			},
+			{
+				Name:    "credit_booking_id",
+				Unique:  false,
+				Columns: []*schema.Column{CreditsColumns[6]},
+			},
+			{
+				Name:    "credit_kind_source_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{CreditsColumns[2], CreditsColumns[5]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "request_hash", Type: field.TypeString},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "response_content_type", Type: field.TypeString, Nullable: true},
+		{Name: "response_body", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_user_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[1], IdempotencyKeysColumns[2]},
+			},
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[8]},
+			},
+		},
+	}
+	// LedgerEntriesColumns holds the columns for the "ledger_entries" table.
+	LedgerEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "transaction_id", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "account", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "booking_id", Type: field.TypeString, Nullable: true},
+		{Name: "payout_id", Type: field.TypeString, Nullable: true},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Default: "IDR"},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// LedgerEntriesTable holds the schema information for the "ledger_entries" table.
+	LedgerEntriesTable = &schema.Table{
+		Name:       "ledger_entries",
+		Columns:    LedgerEntriesColumns,
+		PrimaryKey: []*schema.Column{LedgerEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ledgerentry_account_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[3], LedgerEntriesColumns[4]},
+			},
+			{
+				Name:    "ledgerentry_transaction_id",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[1]},
+			},
+			{
+				Name:    "ledgerentry_booking_id",
+				Unique:  false,
+				Columns: []*schema.Column{LedgerEntriesColumns[5]},
+			},
+		},
+	}
+	// PaymentsColumns holds the columns for the "payments" table.
+	PaymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "provider_intent_id", Type: field.TypeString, Nullable: true},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Default: "IDR"},
+		{Name: "refunded_amount", Type: field.TypeInt64, Default: 0},
+		{Name: "status", Type: field.TypeString, Default: "requires_capture"},
+		{Name: "captured_at", Type: field.TypeTime, Nullable: true},
+		{Name: "voided_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "booking_id", Type: field.TypeString, Unique: true},
+	}
+	// PaymentsTable holds the schema information for the "payments" table.
+	PaymentsTable = &schema.Table{
+		Name:       "payments",
+		Columns:    PaymentsColumns,
+		PrimaryKey: []*schema.Column{PaymentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payments_bookings_payment",
+				Columns:    []*schema.Column{PaymentsColumns[11]},
+				RefColumns: []*schema.Column{BookingsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payment_booking_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaymentsColumns[11]},
+			},
+			{
+				Name:    "payment_provider_provider_intent_id",
+				Unique:  true,
+				Columns: []*schema.Column{PaymentsColumns[1], PaymentsColumns[2]},
+			},
+			{
+				Name:    "payment_status",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentsColumns[6]},
+			},
+		},
+	}
+	// PaymentEventsColumns holds the columns for the "payment_events" table.
+	PaymentEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString},
+		{Name: "provider_intent_id", Type: field.TypeString},
+		{Name: "processed_at", Type: field.TypeTime},
+	}
+	// PaymentEventsTable holds the schema information for the "payment_events" table.
+	PaymentEventsTable = &schema.Table{
+		Name:       "payment_events",
+		Columns:    PaymentEventsColumns,
+		PrimaryKey: []*schema.Column{PaymentEventsColumns[0]},
+	}
+	// PayoutsColumns holds the columns for the "payouts" table.
+	PayoutsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "driver_id", Type: field.TypeString},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Default: "IDR"},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "provider_reference", Type: field.TypeString, Nullable: true},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PayoutsTable holds the schema information for the "payouts" table.
+	PayoutsTable = &schema.Table{
+		Name:       "payouts",
+		Columns:    PayoutsColumns,
+		PrimaryKey: []*schema.Column{PayoutsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payout_driver_id",
+				Unique:  false,
+				Columns: []*schema.Column{PayoutsColumns[1]},
+			},
+			{
+				Name:    "payout_status",
+				Unique:  false,
+				Columns: []*schema.Column{PayoutsColumns[4]},
+			},
+		},
+	}
+	// PromoCodesColumns holds the columns for the "promo_codes" table.
+	PromoCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "discount_type", Type: field.TypeString, Default: "percentage"},
+		{Name: "discount_value", Type: field.TypeInt64},
+		{Name: "max_discount", Type: field.TypeInt64, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Default: "IDR"},
+		{Name: "min_spend", Type: field.TypeInt64, Default: 0},
+		{Name: "max_uses", Type: field.TypeInt, Nullable: true},
+		{Name: "max_uses_per_user", Type: field.TypeInt, Nullable: true},
+		{Name: "used_count", Type: field.TypeInt, Default: 0},
+		{Name: "valid_from", Type: field.TypeTime, Nullable: true},
+		{Name: "valid_until", Type: field.TypeTime, Nullable: true},
+		{Name: "origin_city", Type: field.TypeString, Nullable: true},
+		{Name: "destination_city", Type: field.TypeString, Nullable: true},
+		{Name: "ride_type", Type: field.TypeString, Nullable: true},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// PromoCodesTable holds the schema information for the "promo_codes" table.
+	PromoCodesTable = &schema.Table{
+		Name:       "promo_codes",
+		Columns:    PromoCodesColumns,
+		PrimaryKey: []*schema.Column{PromoCodesColumns[0]},
+	}
+	// PromoRedemptionsColumns holds the columns for the "promo_redemptions" table.
+	PromoRedemptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "booking_id", Type: field.TypeString},
+		{Name: "discount_amount", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Default: "IDR"},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "promo_code_id", Type: field.TypeString},
+	}
+	// PromoRedemptionsTable holds the schema information for the "promo_redemptions" table.
+	PromoRedemptionsTable = &schema.Table{
+		Name:       "promo_redemptions",
+		Columns:    PromoRedemptionsColumns,
+		PrimaryKey: []*schema.Column{PromoRedemptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "promo_redemptions_promo_codes_redemptions",
+				Columns:    []*schema.Column{PromoRedemptionsColumns[7]},
+				RefColumns: []*schema.Column{PromoCodesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "promoredemption_promo_code_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PromoRedemptionsColumns[7], PromoRedemptionsColumns[1]},
+			},
+			{
+				Name:    "promoredemption_booking_id",
+				Unique:  true,
+				Columns: []*schema.Column{PromoRedemptionsColumns[2]},
+			},
+		},
+	}
+	// RateTablesColumns holds the columns for the "rate_tables" table.
+	RateTablesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "base", Type: field.TypeString},
+		{Name: "rates", Type: field.TypeJSON},
+		{Name: "as_of", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// RateTablesTable holds the schema information for the "rate_tables" table.
+	RateTablesTable = &schema.Table{
+		Name:       "rate_tables",
+		Columns:    RateTablesColumns,
+		PrimaryKey: []*schema.Column{RateTablesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ratetable_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{RateTablesColumns[4]},
+			},
+		},
+	}
 	// RidesColumns holds the columns for the "rides" table.
 	RidesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -82,7 +372,7 @@ var (
 		{Name: "amenities", Type: field.TypeJSON, Nullable: true},
 		{Name: "stops", Type: field.TypeJSON, Nullable: true},
 		{Name: "instant_confirmation", Type: field.TypeBool, Default: true},
-		{Name: "cancellation_policy", Type: field.TypeString, Default: "never_cancels"},
+		{Name: "cancellation_policy", Type: field.TypeString, Default: "moderate"},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "status", Type: field.TypeString, Default: "active"},
 		{Name: "created_at", Type: field.TypeTime},
@@ -150,6 +440,8 @@ var (
 		{Name: "published_rides", Type: field.TypeInt, Default: 0},
 		{Name: "completed_rides", Type: field.TypeInt, Default: 0},
 		{Name: "never_cancels", Type: field.TypeBool, Default: true},
+		{Name: "referral_code", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "referred_by", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -188,6 +480,15 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		BookingsTable,
+		CreditsTable,
+		IdempotencyKeysTable,
+		LedgerEntriesTable,
+		PaymentsTable,
+		PaymentEventsTable,
+		PayoutsTable,
+		PromoCodesTable,
+		PromoRedemptionsTable,
+		RateTablesTable,
 		RidesTable,
 		UsersTable,
 		VehiclesTable,
@@ -197,6 +498,8 @@ var (
 func init() {
 	BookingsTable.ForeignKeys[0].RefTable = RidesTable
 	BookingsTable.ForeignKeys[1].RefTable = UsersTable
+	PaymentsTable.ForeignKeys[0].RefTable = BookingsTable
+	PromoRedemptionsTable.ForeignKeys[0].RefTable = PromoCodesTable
 	RidesTable.ForeignKeys[0].RefTable = UsersTable
 	RidesTable.ForeignKeys[1].RefTable = VehiclesTable
 	VehiclesTable.ForeignKeys[0].RefTable = UsersTable
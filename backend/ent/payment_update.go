@@ -0,0 +1,749 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/payment"
+	"github.com/slowtyper/poolie/backend/ent/predicate"
+)
+
+// PaymentUpdate is the builder for updating Payment entities.
+type PaymentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentMutation
+}
+
+// Where appends a list predicates to the PaymentUpdate builder.
+func (_u *PaymentUpdate) Where(ps ...predicate.Payment) *PaymentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBookingID sets the "booking_id" field.
+func (_u *PaymentUpdate) SetBookingID(v string) *PaymentUpdate {
+	_u.mutation.SetBookingID(v)
+	return _u
+}
+
+// SetNillableBookingID sets the "booking_id" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableBookingID(v *string) *PaymentUpdate {
+	if v != nil {
+		_u.SetBookingID(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *PaymentUpdate) SetProvider(v string) *PaymentUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableProvider(v *string) *PaymentUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetProviderIntentID sets the "provider_intent_id" field.
+func (_u *PaymentUpdate) SetProviderIntentID(v string) *PaymentUpdate {
+	_u.mutation.SetProviderIntentID(v)
+	return _u
+}
+
+// SetNillableProviderIntentID sets the "provider_intent_id" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableProviderIntentID(v *string) *PaymentUpdate {
+	if v != nil {
+		_u.SetProviderIntentID(*v)
+	}
+	return _u
+}
+
+// ClearProviderIntentID clears the value of the "provider_intent_id" field.
+func (_u *PaymentUpdate) ClearProviderIntentID() *PaymentUpdate {
+	_u.mutation.ClearProviderIntentID()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PaymentUpdate) SetAmount(v int64) *PaymentUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableAmount(v *int64) *PaymentUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *PaymentUpdate) AddAmount(v int64) *PaymentUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PaymentUpdate) SetCurrency(v string) *PaymentUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableCurrency(v *string) *PaymentUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetRefundedAmount sets the "refunded_amount" field.
+func (_u *PaymentUpdate) SetRefundedAmount(v int64) *PaymentUpdate {
+	_u.mutation.ResetRefundedAmount()
+	_u.mutation.SetRefundedAmount(v)
+	return _u
+}
+
+// SetNillableRefundedAmount sets the "refunded_amount" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableRefundedAmount(v *int64) *PaymentUpdate {
+	if v != nil {
+		_u.SetRefundedAmount(*v)
+	}
+	return _u
+}
+
+// AddRefundedAmount adds value to the "refunded_amount" field.
+func (_u *PaymentUpdate) AddRefundedAmount(v int64) *PaymentUpdate {
+	_u.mutation.AddRefundedAmount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PaymentUpdate) SetStatus(v string) *PaymentUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableStatus(v *string) *PaymentUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCapturedAt sets the "captured_at" field.
+func (_u *PaymentUpdate) SetCapturedAt(v time.Time) *PaymentUpdate {
+	_u.mutation.SetCapturedAt(v)
+	return _u
+}
+
+// SetNillableCapturedAt sets the "captured_at" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableCapturedAt(v *time.Time) *PaymentUpdate {
+	if v != nil {
+		_u.SetCapturedAt(*v)
+	}
+	return _u
+}
+
+// ClearCapturedAt clears the value of the "captured_at" field.
+func (_u *PaymentUpdate) ClearCapturedAt() *PaymentUpdate {
+	_u.mutation.ClearCapturedAt()
+	return _u
+}
+
+// SetVoidedAt sets the "voided_at" field.
+func (_u *PaymentUpdate) SetVoidedAt(v time.Time) *PaymentUpdate {
+	_u.mutation.SetVoidedAt(v)
+	return _u
+}
+
+// SetNillableVoidedAt sets the "voided_at" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableVoidedAt(v *time.Time) *PaymentUpdate {
+	if v != nil {
+		_u.SetVoidedAt(*v)
+	}
+	return _u
+}
+
+// ClearVoidedAt clears the value of the "voided_at" field.
+func (_u *PaymentUpdate) ClearVoidedAt() *PaymentUpdate {
+	_u.mutation.ClearVoidedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaymentUpdate) SetUpdatedAt(v time.Time) *PaymentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetBooking sets the "booking" edge to the Booking entity.
+func (_u *PaymentUpdate) SetBooking(v *Booking) *PaymentUpdate {
+	return _u.SetBookingID(v.ID)
+}
+
+// Mutation returns the PaymentMutation object of the builder.
+func (_u *PaymentUpdate) Mutation() *PaymentMutation {
+	return _u.mutation
+}
+
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (_u *PaymentUpdate) ClearBooking() *PaymentUpdate {
+	_u.mutation.ClearBooking()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaymentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := payment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentUpdate) check() error {
+	if v, ok := _u.mutation.BookingID(); ok {
+		if err := payment.BookingIDValidator(v); err != nil {
+			return &ValidationError{Name: "booking_id", err: fmt.Errorf(`ent: validator failed for field "Payment.booking_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := payment.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Payment.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Amount(); ok {
+		if err := payment.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Payment.amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefundedAmount(); ok {
+		if err := payment.RefundedAmountValidator(v); err != nil {
+			return &ValidationError{Name: "refunded_amount", err: fmt.Errorf(`ent: validator failed for field "Payment.refunded_amount": %w`, err)}
+		}
+	}
+	if _u.mutation.BookingCleared() && len(_u.mutation.BookingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Payment.booking"`)
+	}
+	return nil
+}
+
+func (_u *PaymentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payment.Table, payment.Columns, sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(payment.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProviderIntentID(); ok {
+		_spec.SetField(payment.FieldProviderIntentID, field.TypeString, value)
+	}
+	if _u.mutation.ProviderIntentIDCleared() {
+		_spec.ClearField(payment.FieldProviderIntentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(payment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(payment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(payment.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RefundedAmount(); ok {
+		_spec.SetField(payment.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRefundedAmount(); ok {
+		_spec.AddField(payment.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(payment.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CapturedAt(); ok {
+		_spec.SetField(payment.FieldCapturedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CapturedAtCleared() {
+		_spec.ClearField(payment.FieldCapturedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VoidedAt(); ok {
+		_spec.SetField(payment.FieldVoidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VoidedAtCleared() {
+		_spec.ClearField(payment.FieldVoidedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BookingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   payment.BookingTable,
+			Columns: []string{payment.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booking.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   payment.BookingTable,
+			Columns: []string{payment.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booking.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentUpdateOne is the builder for updating a single Payment entity.
+type PaymentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentMutation
+}
+
+// SetBookingID sets the "booking_id" field.
+func (_u *PaymentUpdateOne) SetBookingID(v string) *PaymentUpdateOne {
+	_u.mutation.SetBookingID(v)
+	return _u
+}
+
+// SetNillableBookingID sets the "booking_id" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableBookingID(v *string) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetBookingID(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *PaymentUpdateOne) SetProvider(v string) *PaymentUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableProvider(v *string) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetProviderIntentID sets the "provider_intent_id" field.
+func (_u *PaymentUpdateOne) SetProviderIntentID(v string) *PaymentUpdateOne {
+	_u.mutation.SetProviderIntentID(v)
+	return _u
+}
+
+// SetNillableProviderIntentID sets the "provider_intent_id" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableProviderIntentID(v *string) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetProviderIntentID(*v)
+	}
+	return _u
+}
+
+// ClearProviderIntentID clears the value of the "provider_intent_id" field.
+func (_u *PaymentUpdateOne) ClearProviderIntentID() *PaymentUpdateOne {
+	_u.mutation.ClearProviderIntentID()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PaymentUpdateOne) SetAmount(v int64) *PaymentUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableAmount(v *int64) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *PaymentUpdateOne) AddAmount(v int64) *PaymentUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PaymentUpdateOne) SetCurrency(v string) *PaymentUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableCurrency(v *string) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetRefundedAmount sets the "refunded_amount" field.
+func (_u *PaymentUpdateOne) SetRefundedAmount(v int64) *PaymentUpdateOne {
+	_u.mutation.ResetRefundedAmount()
+	_u.mutation.SetRefundedAmount(v)
+	return _u
+}
+
+// SetNillableRefundedAmount sets the "refunded_amount" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableRefundedAmount(v *int64) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetRefundedAmount(*v)
+	}
+	return _u
+}
+
+// AddRefundedAmount adds value to the "refunded_amount" field.
+func (_u *PaymentUpdateOne) AddRefundedAmount(v int64) *PaymentUpdateOne {
+	_u.mutation.AddRefundedAmount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PaymentUpdateOne) SetStatus(v string) *PaymentUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableStatus(v *string) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCapturedAt sets the "captured_at" field.
+func (_u *PaymentUpdateOne) SetCapturedAt(v time.Time) *PaymentUpdateOne {
+	_u.mutation.SetCapturedAt(v)
+	return _u
+}
+
+// SetNillableCapturedAt sets the "captured_at" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableCapturedAt(v *time.Time) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetCapturedAt(*v)
+	}
+	return _u
+}
+
+// ClearCapturedAt clears the value of the "captured_at" field.
+func (_u *PaymentUpdateOne) ClearCapturedAt() *PaymentUpdateOne {
+	_u.mutation.ClearCapturedAt()
+	return _u
+}
+
+// SetVoidedAt sets the "voided_at" field.
+func (_u *PaymentUpdateOne) SetVoidedAt(v time.Time) *PaymentUpdateOne {
+	_u.mutation.SetVoidedAt(v)
+	return _u
+}
+
+// SetNillableVoidedAt sets the "voided_at" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableVoidedAt(v *time.Time) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetVoidedAt(*v)
+	}
+	return _u
+}
+
+// ClearVoidedAt clears the value of the "voided_at" field.
+func (_u *PaymentUpdateOne) ClearVoidedAt() *PaymentUpdateOne {
+	_u.mutation.ClearVoidedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaymentUpdateOne) SetUpdatedAt(v time.Time) *PaymentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetBooking sets the "booking" edge to the Booking entity.
+func (_u *PaymentUpdateOne) SetBooking(v *Booking) *PaymentUpdateOne {
+	return _u.SetBookingID(v.ID)
+}
+
+// Mutation returns the PaymentMutation object of the builder.
+func (_u *PaymentUpdateOne) Mutation() *PaymentMutation {
+	return _u.mutation
+}
+
+// ClearBooking clears the "booking" edge to the Booking entity.
+func (_u *PaymentUpdateOne) ClearBooking() *PaymentUpdateOne {
+	_u.mutation.ClearBooking()
+	return _u
+}
+
+// Where appends a list predicates to the PaymentUpdate builder.
+func (_u *PaymentUpdateOne) Where(ps ...predicate.Payment) *PaymentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentUpdateOne) Select(field string, fields ...string) *PaymentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Payment entity.
+func (_u *PaymentUpdateOne) Save(ctx context.Context) (*Payment, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentUpdateOne) SaveX(ctx context.Context) *Payment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaymentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := payment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentUpdateOne) check() error {
+	if v, ok := _u.mutation.BookingID(); ok {
+		if err := payment.BookingIDValidator(v); err != nil {
+			return &ValidationError{Name: "booking_id", err: fmt.Errorf(`ent: validator failed for field "Payment.booking_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := payment.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Payment.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Amount(); ok {
+		if err := payment.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Payment.amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RefundedAmount(); ok {
+		if err := payment.RefundedAmountValidator(v); err != nil {
+			return &ValidationError{Name: "refunded_amount", err: fmt.Errorf(`ent: validator failed for field "Payment.refunded_amount": %w`, err)}
+		}
+	}
+	if _u.mutation.BookingCleared() && len(_u.mutation.BookingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Payment.booking"`)
+	}
+	return nil
+}
+
+func (_u *PaymentUpdateOne) sqlSave(ctx context.Context) (_node *Payment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payment.Table, payment.Columns, sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Payment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payment.FieldID)
+		for _, f := range fields {
+			if !payment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(payment.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProviderIntentID(); ok {
+		_spec.SetField(payment.FieldProviderIntentID, field.TypeString, value)
+	}
+	if _u.mutation.ProviderIntentIDCleared() {
+		_spec.ClearField(payment.FieldProviderIntentID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(payment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(payment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(payment.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RefundedAmount(); ok {
+		_spec.SetField(payment.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRefundedAmount(); ok {
+		_spec.AddField(payment.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(payment.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CapturedAt(); ok {
+		_spec.SetField(payment.FieldCapturedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CapturedAtCleared() {
+		_spec.ClearField(payment.FieldCapturedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VoidedAt(); ok {
+		_spec.SetField(payment.FieldVoidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VoidedAtCleared() {
+		_spec.ClearField(payment.FieldVoidedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BookingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   payment.BookingTable,
+			Columns: []string{payment.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booking.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   payment.BookingTable,
+			Columns: []string{payment.BookingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booking.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Payment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
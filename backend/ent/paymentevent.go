@@ -0,0 +1,137 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/slowtyper/poolie/backend/ent/paymentevent"
+)
+
+// PaymentEvent is the model entity for the PaymentEvent schema.
+type PaymentEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// ProviderIntentID holds the value of the "provider_intent_id" field.
+	ProviderIntentID string `json:"provider_intent_id,omitempty"`
+	// ProcessedAt holds the value of the "processed_at" field.
+	ProcessedAt  time.Time `json:"processed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentevent.FieldID, paymentevent.FieldProvider, paymentevent.FieldType, paymentevent.FieldProviderIntentID:
+			values[i] = new(sql.NullString)
+		case paymentevent.FieldProcessedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentEvent fields.
+func (_m *PaymentEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentevent.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case paymentevent.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case paymentevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case paymentevent.FieldProviderIntentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_intent_id", values[i])
+			} else if value.Valid {
+				_m.ProviderIntentID = value.String
+			}
+		case paymentevent.FieldProcessedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field processed_at", values[i])
+			} else if value.Valid {
+				_m.ProcessedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaymentEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PaymentEvent.
+// Note that you need to call PaymentEvent.Unwrap() before calling this method if this PaymentEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaymentEvent) Update() *PaymentEventUpdateOne {
+	return NewPaymentEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaymentEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaymentEvent) Unwrap() *PaymentEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaymentEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("provider_intent_id=")
+	builder.WriteString(_m.ProviderIntentID)
+	builder.WriteString(", ")
+	builder.WriteString("processed_at=")
+	builder.WriteString(_m.ProcessedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentEvents is a parsable slice of PaymentEvent.
+type PaymentEvents []*PaymentEvent
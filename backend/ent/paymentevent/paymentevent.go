@@ -0,0 +1,84 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the paymentevent type in the database.
+	Label = "payment_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldProviderIntentID holds the string denoting the provider_intent_id field in the database.
+	FieldProviderIntentID = "provider_intent_id"
+	// FieldProcessedAt holds the string denoting the processed_at field in the database.
+	FieldProcessedAt = "processed_at"
+	// Table holds the table name of the paymentevent in the database.
+	Table = "payment_events"
+)
+
+// Columns holds all SQL columns for paymentevent fields.
+var Columns = []string{
+	FieldID,
+	FieldProvider,
+	FieldType,
+	FieldProviderIntentID,
+	FieldProcessedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	TypeValidator func(string) error
+	// ProviderIntentIDValidator is a validator for the "provider_intent_id" field. It is called by the builders before save.
+	ProviderIntentIDValidator func(string) error
+	// DefaultProcessedAt holds the default value on creation for the "processed_at" field.
+	DefaultProcessedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PaymentEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByProviderIntentID orders the results by the provider_intent_id field.
+func ByProviderIntentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderIntentID, opts...).ToFunc()
+}
+
+// ByProcessedAt orders the results by the processed_at field.
+func ByProcessedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessedAt, opts...).ToFunc()
+}
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/slowtyper/poolie/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldContainsFold(FieldID, id))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldEQ(FieldProvider, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldEQ(FieldType, v))
+}
+
+// ProviderIntentID applies equality check predicate on the "provider_intent_id" field. It's identical to ProviderIntentIDEQ.
+func ProviderIntentID(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldEQ(FieldProviderIntentID, v))
+}
+
+// ProcessedAt applies equality check predicate on the "processed_at" field. It's identical to ProcessedAtEQ.
+func ProcessedAt(v time.Time) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldEQ(FieldProcessedAt, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldContainsFold(FieldType, v))
+}
+
+// ProviderIntentIDEQ applies the EQ predicate on the "provider_intent_id" field.
+func ProviderIntentIDEQ(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldEQ(FieldProviderIntentID, v))
+}
+
+// ProviderIntentIDNEQ applies the NEQ predicate on the "provider_intent_id" field.
+func ProviderIntentIDNEQ(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldNEQ(FieldProviderIntentID, v))
+}
+
+// ProviderIntentIDIn applies the In predicate on the "provider_intent_id" field.
+func ProviderIntentIDIn(vs ...string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldIn(FieldProviderIntentID, vs...))
+}
+
+// ProviderIntentIDNotIn applies the NotIn predicate on the "provider_intent_id" field.
+func ProviderIntentIDNotIn(vs ...string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldNotIn(FieldProviderIntentID, vs...))
+}
+
+// ProviderIntentIDGT applies the GT predicate on the "provider_intent_id" field.
+func ProviderIntentIDGT(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldGT(FieldProviderIntentID, v))
+}
+
+// ProviderIntentIDGTE applies the GTE predicate on the "provider_intent_id" field.
+func ProviderIntentIDGTE(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldGTE(FieldProviderIntentID, v))
+}
+
+// ProviderIntentIDLT applies the LT predicate on the "provider_intent_id" field.
+func ProviderIntentIDLT(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldLT(FieldProviderIntentID, v))
+}
+
+// ProviderIntentIDLTE applies the LTE predicate on the "provider_intent_id" field.
+func ProviderIntentIDLTE(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldLTE(FieldProviderIntentID, v))
+}
+
+// ProviderIntentIDContains applies the Contains predicate on the "provider_intent_id" field.
+func ProviderIntentIDContains(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldContains(FieldProviderIntentID, v))
+}
+
+// ProviderIntentIDHasPrefix applies the HasPrefix predicate on the "provider_intent_id" field.
+func ProviderIntentIDHasPrefix(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldHasPrefix(FieldProviderIntentID, v))
+}
+
+// ProviderIntentIDHasSuffix applies the HasSuffix predicate on the "provider_intent_id" field.
+func ProviderIntentIDHasSuffix(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldHasSuffix(FieldProviderIntentID, v))
+}
+
+// ProviderIntentIDEqualFold applies the EqualFold predicate on the "provider_intent_id" field.
+func ProviderIntentIDEqualFold(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldEqualFold(FieldProviderIntentID, v))
+}
+
+// ProviderIntentIDContainsFold applies the ContainsFold predicate on the "provider_intent_id" field.
+func ProviderIntentIDContainsFold(v string) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldContainsFold(FieldProviderIntentID, v))
+}
+
+// ProcessedAtEQ applies the EQ predicate on the "processed_at" field.
+func ProcessedAtEQ(v time.Time) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldEQ(FieldProcessedAt, v))
+}
+
+// ProcessedAtNEQ applies the NEQ predicate on the "processed_at" field.
+func ProcessedAtNEQ(v time.Time) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldNEQ(FieldProcessedAt, v))
+}
+
+// ProcessedAtIn applies the In predicate on the "processed_at" field.
+func ProcessedAtIn(vs ...time.Time) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldIn(FieldProcessedAt, vs...))
+}
+
+// ProcessedAtNotIn applies the NotIn predicate on the "processed_at" field.
+func ProcessedAtNotIn(vs ...time.Time) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldNotIn(FieldProcessedAt, vs...))
+}
+
+// ProcessedAtGT applies the GT predicate on the "processed_at" field.
+func ProcessedAtGT(v time.Time) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldGT(FieldProcessedAt, v))
+}
+
+// ProcessedAtGTE applies the GTE predicate on the "processed_at" field.
+func ProcessedAtGTE(v time.Time) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldGTE(FieldProcessedAt, v))
+}
+
+// ProcessedAtLT applies the LT predicate on the "processed_at" field.
+func ProcessedAtLT(v time.Time) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldLT(FieldProcessedAt, v))
+}
+
+// ProcessedAtLTE applies the LTE predicate on the "processed_at" field.
+func ProcessedAtLTE(v time.Time) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.FieldLTE(FieldProcessedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentEvent) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentEvent) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentEvent) predicate.PaymentEvent {
+	return predicate.PaymentEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/slowtyper/poolie/backend/ent/paymentevent"
+)
+
+// PaymentEventCreate is the builder for creating a PaymentEvent entity.
+type PaymentEventCreate struct {
+	config
+	mutation *PaymentEventMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (_c *PaymentEventCreate) SetProvider(v string) *PaymentEventCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *PaymentEventCreate) SetType(v string) *PaymentEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetProviderIntentID sets the "provider_intent_id" field.
+func (_c *PaymentEventCreate) SetProviderIntentID(v string) *PaymentEventCreate {
+	_c.mutation.SetProviderIntentID(v)
+	return _c
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_c *PaymentEventCreate) SetProcessedAt(v time.Time) *PaymentEventCreate {
+	_c.mutation.SetProcessedAt(v)
+	return _c
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_c *PaymentEventCreate) SetNillableProcessedAt(v *time.Time) *PaymentEventCreate {
+	if v != nil {
+		_c.SetProcessedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PaymentEventCreate) SetID(v string) *PaymentEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the PaymentEventMutation object of the builder.
+func (_c *PaymentEventCreate) Mutation() *PaymentEventMutation {
+	return _c.mutation
+}
+
+// Save creates the PaymentEvent in the database.
+func (_c *PaymentEventCreate) Save(ctx context.Context) (*PaymentEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaymentEventCreate) SaveX(ctx context.Context) *PaymentEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaymentEventCreate) defaults() {
+	if _, ok := _c.mutation.ProcessedAt(); !ok {
+		v := paymentevent.DefaultProcessedAt()
+		_c.mutation.SetProcessedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaymentEventCreate) check() error {
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "PaymentEvent.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := paymentevent.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "PaymentEvent.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "PaymentEvent.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := paymentevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "PaymentEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProviderIntentID(); !ok {
+		return &ValidationError{Name: "provider_intent_id", err: errors.New(`ent: missing required field "PaymentEvent.provider_intent_id"`)}
+	}
+	if v, ok := _c.mutation.ProviderIntentID(); ok {
+		if err := paymentevent.ProviderIntentIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_intent_id", err: fmt.Errorf(`ent: validator failed for field "PaymentEvent.provider_intent_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProcessedAt(); !ok {
+		return &ValidationError{Name: "processed_at", err: errors.New(`ent: missing required field "PaymentEvent.processed_at"`)}
+	}
+	return nil
+}
+
+func (_c *PaymentEventCreate) sqlSave(ctx context.Context) (*PaymentEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PaymentEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaymentEventCreate) createSpec() (*PaymentEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paymentevent.Table, sqlgraph.NewFieldSpec(paymentevent.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(paymentevent.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(paymentevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.ProviderIntentID(); ok {
+		_spec.SetField(paymentevent.FieldProviderIntentID, field.TypeString, value)
+		_node.ProviderIntentID = value
+	}
+	if value, ok := _c.mutation.ProcessedAt(); ok {
+		_spec.SetField(paymentevent.FieldProcessedAt, field.TypeTime, value)
+		_node.ProcessedAt = value
+	}
+	return _node, _spec
+}
+
+// PaymentEventCreateBulk is the builder for creating many PaymentEvent entities in bulk.
+type PaymentEventCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentEventCreate
+}
+
+// Save creates the PaymentEvent entities in the database.
+func (_c *PaymentEventCreateBulk) Save(ctx context.Context) ([]*PaymentEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaymentEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaymentEventCreateBulk) SaveX(ctx context.Context) []*PaymentEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
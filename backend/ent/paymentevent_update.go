@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/slowtyper/poolie/backend/ent/paymentevent"
+	"github.com/slowtyper/poolie/backend/ent/predicate"
+)
+
+// PaymentEventUpdate is the builder for updating PaymentEvent entities.
+type PaymentEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentEventMutation
+}
+
+// Where appends a list predicates to the PaymentEventUpdate builder.
+func (_u *PaymentEventUpdate) Where(ps ...predicate.PaymentEvent) *PaymentEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *PaymentEventUpdate) SetProvider(v string) *PaymentEventUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *PaymentEventUpdate) SetNillableProvider(v *string) *PaymentEventUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *PaymentEventUpdate) SetType(v string) *PaymentEventUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *PaymentEventUpdate) SetNillableType(v *string) *PaymentEventUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetProviderIntentID sets the "provider_intent_id" field.
+func (_u *PaymentEventUpdate) SetProviderIntentID(v string) *PaymentEventUpdate {
+	_u.mutation.SetProviderIntentID(v)
+	return _u
+}
+
+// SetNillableProviderIntentID sets the "provider_intent_id" field if the given value is not nil.
+func (_u *PaymentEventUpdate) SetNillableProviderIntentID(v *string) *PaymentEventUpdate {
+	if v != nil {
+		_u.SetProviderIntentID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PaymentEventMutation object of the builder.
+func (_u *PaymentEventUpdate) Mutation() *PaymentEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentEventUpdate) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := paymentevent.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "PaymentEvent.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := paymentevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "PaymentEvent.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProviderIntentID(); ok {
+		if err := paymentevent.ProviderIntentIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_intent_id", err: fmt.Errorf(`ent: validator failed for field "PaymentEvent.provider_intent_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PaymentEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentevent.Table, paymentevent.Columns, sqlgraph.NewFieldSpec(paymentevent.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(paymentevent.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(paymentevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProviderIntentID(); ok {
+		_spec.SetField(paymentevent.FieldProviderIntentID, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentEventUpdateOne is the builder for updating a single PaymentEvent entity.
+type PaymentEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentEventMutation
+}
+
+// SetProvider sets the "provider" field.
+func (_u *PaymentEventUpdateOne) SetProvider(v string) *PaymentEventUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *PaymentEventUpdateOne) SetNillableProvider(v *string) *PaymentEventUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *PaymentEventUpdateOne) SetType(v string) *PaymentEventUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *PaymentEventUpdateOne) SetNillableType(v *string) *PaymentEventUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetProviderIntentID sets the "provider_intent_id" field.
+func (_u *PaymentEventUpdateOne) SetProviderIntentID(v string) *PaymentEventUpdateOne {
+	_u.mutation.SetProviderIntentID(v)
+	return _u
+}
+
+// SetNillableProviderIntentID sets the "provider_intent_id" field if the given value is not nil.
+func (_u *PaymentEventUpdateOne) SetNillableProviderIntentID(v *string) *PaymentEventUpdateOne {
+	if v != nil {
+		_u.SetProviderIntentID(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PaymentEventMutation object of the builder.
+func (_u *PaymentEventUpdateOne) Mutation() *PaymentEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PaymentEventUpdate builder.
+func (_u *PaymentEventUpdateOne) Where(ps ...predicate.PaymentEvent) *PaymentEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentEventUpdateOne) Select(field string, fields ...string) *PaymentEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentEvent entity.
+func (_u *PaymentEventUpdateOne) Save(ctx context.Context) (*PaymentEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentEventUpdateOne) SaveX(ctx context.Context) *PaymentEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentEventUpdateOne) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := paymentevent.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "PaymentEvent.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := paymentevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "PaymentEvent.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProviderIntentID(); ok {
+		if err := paymentevent.ProviderIntentIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_intent_id", err: fmt.Errorf(`ent: validator failed for field "PaymentEvent.provider_intent_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PaymentEventUpdateOne) sqlSave(ctx context.Context) (_node *PaymentEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentevent.Table, paymentevent.Columns, sqlgraph.NewFieldSpec(paymentevent.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentevent.FieldID)
+		for _, f := range fields {
+			if !paymentevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(paymentevent.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(paymentevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProviderIntentID(); ok {
+		_spec.SetField(paymentevent.FieldProviderIntentID, field.TypeString, value)
+	}
+	_node = &PaymentEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
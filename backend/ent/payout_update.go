@@ -0,0 +1,552 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/slowtyper/poolie/backend/ent/payout"
+	"github.com/slowtyper/poolie/backend/ent/predicate"
+)
+
+// PayoutUpdate is the builder for updating Payout entities.
+type PayoutUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PayoutMutation
+}
+
+// Where appends a list predicates to the PayoutUpdate builder.
+func (_u *PayoutUpdate) Where(ps ...predicate.Payout) *PayoutUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDriverID sets the "driver_id" field.
+func (_u *PayoutUpdate) SetDriverID(v string) *PayoutUpdate {
+	_u.mutation.SetDriverID(v)
+	return _u
+}
+
+// SetNillableDriverID sets the "driver_id" field if the given value is not nil.
+func (_u *PayoutUpdate) SetNillableDriverID(v *string) *PayoutUpdate {
+	if v != nil {
+		_u.SetDriverID(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PayoutUpdate) SetAmount(v int64) *PayoutUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PayoutUpdate) SetNillableAmount(v *int64) *PayoutUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *PayoutUpdate) AddAmount(v int64) *PayoutUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PayoutUpdate) SetCurrency(v string) *PayoutUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PayoutUpdate) SetNillableCurrency(v *string) *PayoutUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PayoutUpdate) SetStatus(v string) *PayoutUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PayoutUpdate) SetNillableStatus(v *string) *PayoutUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *PayoutUpdate) SetProvider(v string) *PayoutUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *PayoutUpdate) SetNillableProvider(v *string) *PayoutUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetProviderReference sets the "provider_reference" field.
+func (_u *PayoutUpdate) SetProviderReference(v string) *PayoutUpdate {
+	_u.mutation.SetProviderReference(v)
+	return _u
+}
+
+// SetNillableProviderReference sets the "provider_reference" field if the given value is not nil.
+func (_u *PayoutUpdate) SetNillableProviderReference(v *string) *PayoutUpdate {
+	if v != nil {
+		_u.SetProviderReference(*v)
+	}
+	return _u
+}
+
+// ClearProviderReference clears the value of the "provider_reference" field.
+func (_u *PayoutUpdate) ClearProviderReference() *PayoutUpdate {
+	_u.mutation.ClearProviderReference()
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *PayoutUpdate) SetFailureReason(v string) *PayoutUpdate {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *PayoutUpdate) SetNillableFailureReason(v *string) *PayoutUpdate {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
+	return _u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (_u *PayoutUpdate) ClearFailureReason() *PayoutUpdate {
+	_u.mutation.ClearFailureReason()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PayoutUpdate) SetUpdatedAt(v time.Time) *PayoutUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PayoutMutation object of the builder.
+func (_u *PayoutUpdate) Mutation() *PayoutMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PayoutUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayoutUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PayoutUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayoutUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PayoutUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := payout.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PayoutUpdate) check() error {
+	if v, ok := _u.mutation.DriverID(); ok {
+		if err := payout.DriverIDValidator(v); err != nil {
+			return &ValidationError{Name: "driver_id", err: fmt.Errorf(`ent: validator failed for field "Payout.driver_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Amount(); ok {
+		if err := payout.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Payout.amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := payout.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Payout.provider": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PayoutUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payout.Table, payout.Columns, sqlgraph.NewFieldSpec(payout.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DriverID(); ok {
+		_spec.SetField(payout.FieldDriverID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(payout.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(payout.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(payout.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(payout.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(payout.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProviderReference(); ok {
+		_spec.SetField(payout.FieldProviderReference, field.TypeString, value)
+	}
+	if _u.mutation.ProviderReferenceCleared() {
+		_spec.ClearField(payout.FieldProviderReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(payout.FieldFailureReason, field.TypeString, value)
+	}
+	if _u.mutation.FailureReasonCleared() {
+		_spec.ClearField(payout.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payout.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PayoutUpdateOne is the builder for updating a single Payout entity.
+type PayoutUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PayoutMutation
+}
+
+// SetDriverID sets the "driver_id" field.
+func (_u *PayoutUpdateOne) SetDriverID(v string) *PayoutUpdateOne {
+	_u.mutation.SetDriverID(v)
+	return _u
+}
+
+// SetNillableDriverID sets the "driver_id" field if the given value is not nil.
+func (_u *PayoutUpdateOne) SetNillableDriverID(v *string) *PayoutUpdateOne {
+	if v != nil {
+		_u.SetDriverID(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PayoutUpdateOne) SetAmount(v int64) *PayoutUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PayoutUpdateOne) SetNillableAmount(v *int64) *PayoutUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *PayoutUpdateOne) AddAmount(v int64) *PayoutUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PayoutUpdateOne) SetCurrency(v string) *PayoutUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PayoutUpdateOne) SetNillableCurrency(v *string) *PayoutUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PayoutUpdateOne) SetStatus(v string) *PayoutUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PayoutUpdateOne) SetNillableStatus(v *string) *PayoutUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *PayoutUpdateOne) SetProvider(v string) *PayoutUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *PayoutUpdateOne) SetNillableProvider(v *string) *PayoutUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetProviderReference sets the "provider_reference" field.
+func (_u *PayoutUpdateOne) SetProviderReference(v string) *PayoutUpdateOne {
+	_u.mutation.SetProviderReference(v)
+	return _u
+}
+
+// SetNillableProviderReference sets the "provider_reference" field if the given value is not nil.
+func (_u *PayoutUpdateOne) SetNillableProviderReference(v *string) *PayoutUpdateOne {
+	if v != nil {
+		_u.SetProviderReference(*v)
+	}
+	return _u
+}
+
+// ClearProviderReference clears the value of the "provider_reference" field.
+func (_u *PayoutUpdateOne) ClearProviderReference() *PayoutUpdateOne {
+	_u.mutation.ClearProviderReference()
+	return _u
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (_u *PayoutUpdateOne) SetFailureReason(v string) *PayoutUpdateOne {
+	_u.mutation.SetFailureReason(v)
+	return _u
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (_u *PayoutUpdateOne) SetNillableFailureReason(v *string) *PayoutUpdateOne {
+	if v != nil {
+		_u.SetFailureReason(*v)
+	}
+	return _u
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (_u *PayoutUpdateOne) ClearFailureReason() *PayoutUpdateOne {
+	_u.mutation.ClearFailureReason()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PayoutUpdateOne) SetUpdatedAt(v time.Time) *PayoutUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PayoutMutation object of the builder.
+func (_u *PayoutUpdateOne) Mutation() *PayoutMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PayoutUpdate builder.
+func (_u *PayoutUpdateOne) Where(ps ...predicate.Payout) *PayoutUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PayoutUpdateOne) Select(field string, fields ...string) *PayoutUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Payout entity.
+func (_u *PayoutUpdateOne) Save(ctx context.Context) (*Payout, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PayoutUpdateOne) SaveX(ctx context.Context) *Payout {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PayoutUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PayoutUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PayoutUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := payout.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PayoutUpdateOne) check() error {
+	if v, ok := _u.mutation.DriverID(); ok {
+		if err := payout.DriverIDValidator(v); err != nil {
+			return &ValidationError{Name: "driver_id", err: fmt.Errorf(`ent: validator failed for field "Payout.driver_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Amount(); ok {
+		if err := payout.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Payout.amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := payout.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Payout.provider": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PayoutUpdateOne) sqlSave(ctx context.Context) (_node *Payout, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payout.Table, payout.Columns, sqlgraph.NewFieldSpec(payout.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Payout.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payout.FieldID)
+		for _, f := range fields {
+			if !payout.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payout.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DriverID(); ok {
+		_spec.SetField(payout.FieldDriverID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(payout.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(payout.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(payout.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(payout.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(payout.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProviderReference(); ok {
+		_spec.SetField(payout.FieldProviderReference, field.TypeString, value)
+	}
+	if _u.mutation.ProviderReferenceCleared() {
+		_spec.ClearField(payout.FieldProviderReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.FailureReason(); ok {
+		_spec.SetField(payout.FieldFailureReason, field.TypeString, value)
+	}
+	if _u.mutation.FailureReasonCleared() {
+		_spec.ClearField(payout.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(payout.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Payout{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
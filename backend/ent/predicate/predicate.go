@@ -9,6 +9,33 @@ import (
 // Booking is the predicate function for booking builders.
 type Booking func(*sql.Selector)
 
+// Credit is the predicate function for credit builders.
+type Credit func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// LedgerEntry is the predicate function for ledgerentry builders.
+type LedgerEntry func(*sql.Selector)
+
+// Payment is the predicate function for payment builders.
+type Payment func(*sql.Selector)
+
+// PaymentEvent is the predicate function for paymentevent builders.
+type PaymentEvent func(*sql.Selector)
+
+// Payout is the predicate function for payout builders.
+type Payout func(*sql.Selector)
+
+// PromoCode is the predicate function for promocode builders.
+type PromoCode func(*sql.Selector)
+
+// PromoRedemption is the predicate function for promoredemption builders.
+type PromoRedemption func(*sql.Selector)
+
+// RateTable is the predicate function for ratetable builders.
+type RateTable func(*sql.Selector)
+
 // Ride is the predicate function for ride builders.
 type Ride func(*sql.Selector)
 
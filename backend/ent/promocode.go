@@ -0,0 +1,362 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/slowtyper/poolie/backend/ent/promocode"
+)
+
+// PromoCode is the model entity for the PromoCode schema.
+type PromoCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// DiscountType holds the value of the "discount_type" field.
+	DiscountType string `json:"discount_type,omitempty"`
+	// DiscountValue holds the value of the "discount_value" field.
+	DiscountValue int64 `json:"discount_value,omitempty"`
+	// MaxDiscount holds the value of the "max_discount" field.
+	MaxDiscount *int64 `json:"max_discount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// MinSpend holds the value of the "min_spend" field.
+	MinSpend int64 `json:"min_spend,omitempty"`
+	// MaxUses holds the value of the "max_uses" field.
+	MaxUses *int `json:"max_uses,omitempty"`
+	// MaxUsesPerUser holds the value of the "max_uses_per_user" field.
+	MaxUsesPerUser *int `json:"max_uses_per_user,omitempty"`
+	// UsedCount holds the value of the "used_count" field.
+	UsedCount int `json:"used_count,omitempty"`
+	// ValidFrom holds the value of the "valid_from" field.
+	ValidFrom *time.Time `json:"valid_from,omitempty"`
+	// ValidUntil holds the value of the "valid_until" field.
+	ValidUntil *time.Time `json:"valid_until,omitempty"`
+	// OriginCity holds the value of the "origin_city" field.
+	OriginCity string `json:"origin_city,omitempty"`
+	// DestinationCity holds the value of the "destination_city" field.
+	DestinationCity string `json:"destination_city,omitempty"`
+	// RideType holds the value of the "ride_type" field.
+	RideType string `json:"ride_type,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PromoCodeQuery when eager-loading is set.
+	Edges        PromoCodeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PromoCodeEdges holds the relations/edges for other nodes in the graph.
+type PromoCodeEdges struct {
+	// Redemptions holds the value of the redemptions edge.
+	Redemptions []*PromoRedemption `json:"redemptions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+
+	namedRedemptions map[string][]*PromoRedemption
+}
+
+// RedemptionsOrErr returns the Redemptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PromoCodeEdges) RedemptionsOrErr() ([]*PromoRedemption, error) {
+	if e.loadedTypes[0] {
+		return e.Redemptions, nil
+	}
+	return nil, &NotLoadedError{edge: "redemptions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PromoCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case promocode.FieldActive:
+			values[i] = new(sql.NullBool)
+		case promocode.FieldDiscountValue, promocode.FieldMaxDiscount, promocode.FieldMinSpend, promocode.FieldMaxUses, promocode.FieldMaxUsesPerUser, promocode.FieldUsedCount:
+			values[i] = new(sql.NullInt64)
+		case promocode.FieldID, promocode.FieldCode, promocode.FieldDescription, promocode.FieldDiscountType, promocode.FieldCurrency, promocode.FieldOriginCity, promocode.FieldDestinationCity, promocode.FieldRideType:
+			values[i] = new(sql.NullString)
+		case promocode.FieldValidFrom, promocode.FieldValidUntil, promocode.FieldCreatedAt, promocode.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PromoCode fields.
+func (_m *PromoCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case promocode.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case promocode.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case promocode.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case promocode.FieldDiscountType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_type", values[i])
+			} else if value.Valid {
+				_m.DiscountType = value.String
+			}
+		case promocode.FieldDiscountValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_value", values[i])
+			} else if value.Valid {
+				_m.DiscountValue = value.Int64
+			}
+		case promocode.FieldMaxDiscount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_discount", values[i])
+			} else if value.Valid {
+				_m.MaxDiscount = new(int64)
+				*_m.MaxDiscount = value.Int64
+			}
+		case promocode.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case promocode.FieldMinSpend:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_spend", values[i])
+			} else if value.Valid {
+				_m.MinSpend = value.Int64
+			}
+		case promocode.FieldMaxUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses", values[i])
+			} else if value.Valid {
+				_m.MaxUses = new(int)
+				*_m.MaxUses = int(value.Int64)
+			}
+		case promocode.FieldMaxUsesPerUser:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses_per_user", values[i])
+			} else if value.Valid {
+				_m.MaxUsesPerUser = new(int)
+				*_m.MaxUsesPerUser = int(value.Int64)
+			}
+		case promocode.FieldUsedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field used_count", values[i])
+			} else if value.Valid {
+				_m.UsedCount = int(value.Int64)
+			}
+		case promocode.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = new(time.Time)
+				*_m.ValidFrom = value.Time
+			}
+		case promocode.FieldValidUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_until", values[i])
+			} else if value.Valid {
+				_m.ValidUntil = new(time.Time)
+				*_m.ValidUntil = value.Time
+			}
+		case promocode.FieldOriginCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin_city", values[i])
+			} else if value.Valid {
+				_m.OriginCity = value.String
+			}
+		case promocode.FieldDestinationCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field destination_city", values[i])
+			} else if value.Valid {
+				_m.DestinationCity = value.String
+			}
+		case promocode.FieldRideType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ride_type", values[i])
+			} else if value.Valid {
+				_m.RideType = value.String
+			}
+		case promocode.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				_m.Active = value.Bool
+			}
+		case promocode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case promocode.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PromoCode.
+// This includes values selected through modifiers, order, etc.
+func (_m *PromoCode) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRedemptions queries the "redemptions" edge of the PromoCode entity.
+func (_m *PromoCode) QueryRedemptions() *PromoRedemptionQuery {
+	return NewPromoCodeClient(_m.config).QueryRedemptions(_m)
+}
+
+// Update returns a builder for updating this PromoCode.
+// Note that you need to call PromoCode.Unwrap() before calling this method if this PromoCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PromoCode) Update() *PromoCodeUpdateOne {
+	return NewPromoCodeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PromoCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PromoCode) Unwrap() *PromoCode {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PromoCode is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PromoCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("PromoCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("discount_type=")
+	builder.WriteString(_m.DiscountType)
+	builder.WriteString(", ")
+	builder.WriteString("discount_value=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountValue))
+	builder.WriteString(", ")
+	if v := _m.MaxDiscount; v != nil {
+		builder.WriteString("max_discount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("min_spend=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinSpend))
+	builder.WriteString(", ")
+	if v := _m.MaxUses; v != nil {
+		builder.WriteString("max_uses=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxUsesPerUser; v != nil {
+		builder.WriteString("max_uses_per_user=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("used_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UsedCount))
+	builder.WriteString(", ")
+	if v := _m.ValidFrom; v != nil {
+		builder.WriteString("valid_from=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ValidUntil; v != nil {
+		builder.WriteString("valid_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("origin_city=")
+	builder.WriteString(_m.OriginCity)
+	builder.WriteString(", ")
+	builder.WriteString("destination_city=")
+	builder.WriteString(_m.DestinationCity)
+	builder.WriteString(", ")
+	builder.WriteString("ride_type=")
+	builder.WriteString(_m.RideType)
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Active))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedRedemptions returns the Redemptions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *PromoCode) NamedRedemptions(name string) ([]*PromoRedemption, error) {
+	if _m.Edges.namedRedemptions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedRedemptions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *PromoCode) appendNamedRedemptions(name string, edges ...*PromoRedemption) {
+	if _m.Edges.namedRedemptions == nil {
+		_m.Edges.namedRedemptions = make(map[string][]*PromoRedemption)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedRedemptions[name] = []*PromoRedemption{}
+	} else {
+		_m.Edges.namedRedemptions[name] = append(_m.Edges.namedRedemptions[name], edges...)
+	}
+}
+
+// PromoCodes is a parsable slice of PromoCode.
+type PromoCodes []*PromoCode
@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package promocode
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the promocode type in the database.
+	Label = "promo_code"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldDiscountType holds the string denoting the discount_type field in the database.
+	FieldDiscountType = "discount_type"
+	// FieldDiscountValue holds the string denoting the discount_value field in the database.
+	FieldDiscountValue = "discount_value"
+	// FieldMaxDiscount holds the string denoting the max_discount field in the database.
+	FieldMaxDiscount = "max_discount"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldMinSpend holds the string denoting the min_spend field in the database.
+	FieldMinSpend = "min_spend"
+	// FieldMaxUses holds the string denoting the max_uses field in the database.
+	FieldMaxUses = "max_uses"
+	// FieldMaxUsesPerUser holds the string denoting the max_uses_per_user field in the database.
+	FieldMaxUsesPerUser = "max_uses_per_user"
+	// FieldUsedCount holds the string denoting the used_count field in the database.
+	FieldUsedCount = "used_count"
+	// FieldValidFrom holds the string denoting the valid_from field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidUntil holds the string denoting the valid_until field in the database.
+	FieldValidUntil = "valid_until"
+	// FieldOriginCity holds the string denoting the origin_city field in the database.
+	FieldOriginCity = "origin_city"
+	// FieldDestinationCity holds the string denoting the destination_city field in the database.
+	FieldDestinationCity = "destination_city"
+	// FieldRideType holds the string denoting the ride_type field in the database.
+	FieldRideType = "ride_type"
+	// FieldActive holds the string denoting the active field in the database.
+	FieldActive = "active"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeRedemptions holds the string denoting the redemptions edge name in mutations.
+	EdgeRedemptions = "redemptions"
+	// Table holds the table name of the promocode in the database.
+	Table = "promo_codes"
+	// RedemptionsTable is the table that holds the redemptions relation/edge.
+	RedemptionsTable = "promo_redemptions"
+	// RedemptionsInverseTable is the table name for the PromoRedemption entity.
+	// It exists in this package in order to avoid circular dependency with the "promoredemption" package.
+	RedemptionsInverseTable = "promo_redemptions"
+	// RedemptionsColumn is the table column denoting the redemptions relation/edge.
+	RedemptionsColumn = "promo_code_id"
+)
+
+// Columns holds all SQL columns for promocode fields.
+var Columns = []string{
+	FieldID,
+	FieldCode,
+	FieldDescription,
+	FieldDiscountType,
+	FieldDiscountValue,
+	FieldMaxDiscount,
+	FieldCurrency,
+	FieldMinSpend,
+	FieldMaxUses,
+	FieldMaxUsesPerUser,
+	FieldUsedCount,
+	FieldValidFrom,
+	FieldValidUntil,
+	FieldOriginCity,
+	FieldDestinationCity,
+	FieldRideType,
+	FieldActive,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// DefaultDiscountType holds the default value on creation for the "discount_type" field.
+	DefaultDiscountType string
+	// DiscountValueValidator is a validator for the "discount_value" field. It is called by the builders before save.
+	DiscountValueValidator func(int64) error
+	// MaxDiscountValidator is a validator for the "max_discount" field. It is called by the builders before save.
+	MaxDiscountValidator func(int64) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultMinSpend holds the default value on creation for the "min_spend" field.
+	DefaultMinSpend int64
+	// MinSpendValidator is a validator for the "min_spend" field. It is called by the builders before save.
+	MinSpendValidator func(int64) error
+	// MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	MaxUsesValidator func(int) error
+	// MaxUsesPerUserValidator is a validator for the "max_uses_per_user" field. It is called by the builders before save.
+	MaxUsesPerUserValidator func(int) error
+	// DefaultUsedCount holds the default value on creation for the "used_count" field.
+	DefaultUsedCount int
+	// UsedCountValidator is a validator for the "used_count" field. It is called by the builders before save.
+	UsedCountValidator func(int) error
+	// DefaultActive holds the default value on creation for the "active" field.
+	DefaultActive bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the PromoCode queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByDiscountType orders the results by the discount_type field.
+func ByDiscountType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountType, opts...).ToFunc()
+}
+
+// ByDiscountValue orders the results by the discount_value field.
+func ByDiscountValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountValue, opts...).ToFunc()
+}
+
+// ByMaxDiscount orders the results by the max_discount field.
+func ByMaxDiscount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxDiscount, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByMinSpend orders the results by the min_spend field.
+func ByMinSpend(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinSpend, opts...).ToFunc()
+}
+
+// ByMaxUses orders the results by the max_uses field.
+func ByMaxUses(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxUses, opts...).ToFunc()
+}
+
+// ByMaxUsesPerUser orders the results by the max_uses_per_user field.
+func ByMaxUsesPerUser(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxUsesPerUser, opts...).ToFunc()
+}
+
+// ByUsedCount orders the results by the used_count field.
+func ByUsedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsedCount, opts...).ToFunc()
+}
+
+// ByValidFrom orders the results by the valid_from field.
+func ByValidFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidFrom, opts...).ToFunc()
+}
+
+// ByValidUntil orders the results by the valid_until field.
+func ByValidUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidUntil, opts...).ToFunc()
+}
+
+// ByOriginCity orders the results by the origin_city field.
+func ByOriginCity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginCity, opts...).ToFunc()
+}
+
+// ByDestinationCity orders the results by the destination_city field.
+func ByDestinationCity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDestinationCity, opts...).ToFunc()
+}
+
+// ByRideType orders the results by the ride_type field.
+func ByRideType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRideType, opts...).ToFunc()
+}
+
+// ByActive orders the results by the active field.
+func ByActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActive, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByRedemptionsCount orders the results by redemptions count.
+func ByRedemptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRedemptionsStep(), opts...)
+	}
+}
+
+// ByRedemptions orders the results by redemptions terms.
+func ByRedemptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRedemptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newRedemptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RedemptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RedemptionsTable, RedemptionsColumn),
+	)
+}
@@ -0,0 +1,1149 @@
+// Code generated by ent, DO NOT EDIT.
+
+package promocode
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/slowtyper/poolie/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContainsFold(FieldID, id))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldCode, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldDescription, v))
+}
+
+// DiscountType applies equality check predicate on the "discount_type" field. It's identical to DiscountTypeEQ.
+func DiscountType(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldDiscountType, v))
+}
+
+// DiscountValue applies equality check predicate on the "discount_value" field. It's identical to DiscountValueEQ.
+func DiscountValue(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldDiscountValue, v))
+}
+
+// MaxDiscount applies equality check predicate on the "max_discount" field. It's identical to MaxDiscountEQ.
+func MaxDiscount(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMaxDiscount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldCurrency, v))
+}
+
+// MinSpend applies equality check predicate on the "min_spend" field. It's identical to MinSpendEQ.
+func MinSpend(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMinSpend, v))
+}
+
+// MaxUses applies equality check predicate on the "max_uses" field. It's identical to MaxUsesEQ.
+func MaxUses(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMaxUses, v))
+}
+
+// MaxUsesPerUser applies equality check predicate on the "max_uses_per_user" field. It's identical to MaxUsesPerUserEQ.
+func MaxUsesPerUser(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMaxUsesPerUser, v))
+}
+
+// UsedCount applies equality check predicate on the "used_count" field. It's identical to UsedCountEQ.
+func UsedCount(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldUsedCount, v))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidUntil applies equality check predicate on the "valid_until" field. It's identical to ValidUntilEQ.
+func ValidUntil(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldValidUntil, v))
+}
+
+// OriginCity applies equality check predicate on the "origin_city" field. It's identical to OriginCityEQ.
+func OriginCity(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldOriginCity, v))
+}
+
+// DestinationCity applies equality check predicate on the "destination_city" field. It's identical to DestinationCityEQ.
+func DestinationCity(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldDestinationCity, v))
+}
+
+// RideType applies equality check predicate on the "ride_type" field. It's identical to RideTypeEQ.
+func RideType(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldRideType, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldActive, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContainsFold(FieldCode, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// DiscountTypeEQ applies the EQ predicate on the "discount_type" field.
+func DiscountTypeEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldDiscountType, v))
+}
+
+// DiscountTypeNEQ applies the NEQ predicate on the "discount_type" field.
+func DiscountTypeNEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldDiscountType, v))
+}
+
+// DiscountTypeIn applies the In predicate on the "discount_type" field.
+func DiscountTypeIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldDiscountType, vs...))
+}
+
+// DiscountTypeNotIn applies the NotIn predicate on the "discount_type" field.
+func DiscountTypeNotIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldDiscountType, vs...))
+}
+
+// DiscountTypeGT applies the GT predicate on the "discount_type" field.
+func DiscountTypeGT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldDiscountType, v))
+}
+
+// DiscountTypeGTE applies the GTE predicate on the "discount_type" field.
+func DiscountTypeGTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldDiscountType, v))
+}
+
+// DiscountTypeLT applies the LT predicate on the "discount_type" field.
+func DiscountTypeLT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldDiscountType, v))
+}
+
+// DiscountTypeLTE applies the LTE predicate on the "discount_type" field.
+func DiscountTypeLTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldDiscountType, v))
+}
+
+// DiscountTypeContains applies the Contains predicate on the "discount_type" field.
+func DiscountTypeContains(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContains(FieldDiscountType, v))
+}
+
+// DiscountTypeHasPrefix applies the HasPrefix predicate on the "discount_type" field.
+func DiscountTypeHasPrefix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasPrefix(FieldDiscountType, v))
+}
+
+// DiscountTypeHasSuffix applies the HasSuffix predicate on the "discount_type" field.
+func DiscountTypeHasSuffix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasSuffix(FieldDiscountType, v))
+}
+
+// DiscountTypeEqualFold applies the EqualFold predicate on the "discount_type" field.
+func DiscountTypeEqualFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEqualFold(FieldDiscountType, v))
+}
+
+// DiscountTypeContainsFold applies the ContainsFold predicate on the "discount_type" field.
+func DiscountTypeContainsFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContainsFold(FieldDiscountType, v))
+}
+
+// DiscountValueEQ applies the EQ predicate on the "discount_value" field.
+func DiscountValueEQ(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldDiscountValue, v))
+}
+
+// DiscountValueNEQ applies the NEQ predicate on the "discount_value" field.
+func DiscountValueNEQ(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldDiscountValue, v))
+}
+
+// DiscountValueIn applies the In predicate on the "discount_value" field.
+func DiscountValueIn(vs ...int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldDiscountValue, vs...))
+}
+
+// DiscountValueNotIn applies the NotIn predicate on the "discount_value" field.
+func DiscountValueNotIn(vs ...int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldDiscountValue, vs...))
+}
+
+// DiscountValueGT applies the GT predicate on the "discount_value" field.
+func DiscountValueGT(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldDiscountValue, v))
+}
+
+// DiscountValueGTE applies the GTE predicate on the "discount_value" field.
+func DiscountValueGTE(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldDiscountValue, v))
+}
+
+// DiscountValueLT applies the LT predicate on the "discount_value" field.
+func DiscountValueLT(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldDiscountValue, v))
+}
+
+// DiscountValueLTE applies the LTE predicate on the "discount_value" field.
+func DiscountValueLTE(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldDiscountValue, v))
+}
+
+// MaxDiscountEQ applies the EQ predicate on the "max_discount" field.
+func MaxDiscountEQ(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMaxDiscount, v))
+}
+
+// MaxDiscountNEQ applies the NEQ predicate on the "max_discount" field.
+func MaxDiscountNEQ(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldMaxDiscount, v))
+}
+
+// MaxDiscountIn applies the In predicate on the "max_discount" field.
+func MaxDiscountIn(vs ...int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldMaxDiscount, vs...))
+}
+
+// MaxDiscountNotIn applies the NotIn predicate on the "max_discount" field.
+func MaxDiscountNotIn(vs ...int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldMaxDiscount, vs...))
+}
+
+// MaxDiscountGT applies the GT predicate on the "max_discount" field.
+func MaxDiscountGT(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldMaxDiscount, v))
+}
+
+// MaxDiscountGTE applies the GTE predicate on the "max_discount" field.
+func MaxDiscountGTE(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldMaxDiscount, v))
+}
+
+// MaxDiscountLT applies the LT predicate on the "max_discount" field.
+func MaxDiscountLT(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldMaxDiscount, v))
+}
+
+// MaxDiscountLTE applies the LTE predicate on the "max_discount" field.
+func MaxDiscountLTE(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldMaxDiscount, v))
+}
+
+// MaxDiscountIsNil applies the IsNil predicate on the "max_discount" field.
+func MaxDiscountIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldMaxDiscount))
+}
+
+// MaxDiscountNotNil applies the NotNil predicate on the "max_discount" field.
+func MaxDiscountNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldMaxDiscount))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// MinSpendEQ applies the EQ predicate on the "min_spend" field.
+func MinSpendEQ(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMinSpend, v))
+}
+
+// MinSpendNEQ applies the NEQ predicate on the "min_spend" field.
+func MinSpendNEQ(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldMinSpend, v))
+}
+
+// MinSpendIn applies the In predicate on the "min_spend" field.
+func MinSpendIn(vs ...int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldMinSpend, vs...))
+}
+
+// MinSpendNotIn applies the NotIn predicate on the "min_spend" field.
+func MinSpendNotIn(vs ...int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldMinSpend, vs...))
+}
+
+// MinSpendGT applies the GT predicate on the "min_spend" field.
+func MinSpendGT(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldMinSpend, v))
+}
+
+// MinSpendGTE applies the GTE predicate on the "min_spend" field.
+func MinSpendGTE(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldMinSpend, v))
+}
+
+// MinSpendLT applies the LT predicate on the "min_spend" field.
+func MinSpendLT(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldMinSpend, v))
+}
+
+// MinSpendLTE applies the LTE predicate on the "min_spend" field.
+func MinSpendLTE(v int64) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldMinSpend, v))
+}
+
+// MaxUsesEQ applies the EQ predicate on the "max_uses" field.
+func MaxUsesEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMaxUses, v))
+}
+
+// MaxUsesNEQ applies the NEQ predicate on the "max_uses" field.
+func MaxUsesNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldMaxUses, v))
+}
+
+// MaxUsesIn applies the In predicate on the "max_uses" field.
+func MaxUsesIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldMaxUses, vs...))
+}
+
+// MaxUsesNotIn applies the NotIn predicate on the "max_uses" field.
+func MaxUsesNotIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldMaxUses, vs...))
+}
+
+// MaxUsesGT applies the GT predicate on the "max_uses" field.
+func MaxUsesGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldMaxUses, v))
+}
+
+// MaxUsesGTE applies the GTE predicate on the "max_uses" field.
+func MaxUsesGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldMaxUses, v))
+}
+
+// MaxUsesLT applies the LT predicate on the "max_uses" field.
+func MaxUsesLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldMaxUses, v))
+}
+
+// MaxUsesLTE applies the LTE predicate on the "max_uses" field.
+func MaxUsesLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldMaxUses, v))
+}
+
+// MaxUsesIsNil applies the IsNil predicate on the "max_uses" field.
+func MaxUsesIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldMaxUses))
+}
+
+// MaxUsesNotNil applies the NotNil predicate on the "max_uses" field.
+func MaxUsesNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldMaxUses))
+}
+
+// MaxUsesPerUserEQ applies the EQ predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldMaxUsesPerUser, v))
+}
+
+// MaxUsesPerUserNEQ applies the NEQ predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldMaxUsesPerUser, v))
+}
+
+// MaxUsesPerUserIn applies the In predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldMaxUsesPerUser, vs...))
+}
+
+// MaxUsesPerUserNotIn applies the NotIn predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserNotIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldMaxUsesPerUser, vs...))
+}
+
+// MaxUsesPerUserGT applies the GT predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldMaxUsesPerUser, v))
+}
+
+// MaxUsesPerUserGTE applies the GTE predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldMaxUsesPerUser, v))
+}
+
+// MaxUsesPerUserLT applies the LT predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldMaxUsesPerUser, v))
+}
+
+// MaxUsesPerUserLTE applies the LTE predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldMaxUsesPerUser, v))
+}
+
+// MaxUsesPerUserIsNil applies the IsNil predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldMaxUsesPerUser))
+}
+
+// MaxUsesPerUserNotNil applies the NotNil predicate on the "max_uses_per_user" field.
+func MaxUsesPerUserNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldMaxUsesPerUser))
+}
+
+// UsedCountEQ applies the EQ predicate on the "used_count" field.
+func UsedCountEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldUsedCount, v))
+}
+
+// UsedCountNEQ applies the NEQ predicate on the "used_count" field.
+func UsedCountNEQ(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldUsedCount, v))
+}
+
+// UsedCountIn applies the In predicate on the "used_count" field.
+func UsedCountIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldUsedCount, vs...))
+}
+
+// UsedCountNotIn applies the NotIn predicate on the "used_count" field.
+func UsedCountNotIn(vs ...int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldUsedCount, vs...))
+}
+
+// UsedCountGT applies the GT predicate on the "used_count" field.
+func UsedCountGT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldUsedCount, v))
+}
+
+// UsedCountGTE applies the GTE predicate on the "used_count" field.
+func UsedCountGTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldUsedCount, v))
+}
+
+// UsedCountLT applies the LT predicate on the "used_count" field.
+func UsedCountLT(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldUsedCount, v))
+}
+
+// UsedCountLTE applies the LTE predicate on the "used_count" field.
+func UsedCountLTE(v int) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldUsedCount, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidFromIsNil applies the IsNil predicate on the "valid_from" field.
+func ValidFromIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldValidFrom))
+}
+
+// ValidFromNotNil applies the NotNil predicate on the "valid_from" field.
+func ValidFromNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldValidFrom))
+}
+
+// ValidUntilEQ applies the EQ predicate on the "valid_until" field.
+func ValidUntilEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldValidUntil, v))
+}
+
+// ValidUntilNEQ applies the NEQ predicate on the "valid_until" field.
+func ValidUntilNEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldValidUntil, v))
+}
+
+// ValidUntilIn applies the In predicate on the "valid_until" field.
+func ValidUntilIn(vs ...time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldValidUntil, vs...))
+}
+
+// ValidUntilNotIn applies the NotIn predicate on the "valid_until" field.
+func ValidUntilNotIn(vs ...time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldValidUntil, vs...))
+}
+
+// ValidUntilGT applies the GT predicate on the "valid_until" field.
+func ValidUntilGT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldValidUntil, v))
+}
+
+// ValidUntilGTE applies the GTE predicate on the "valid_until" field.
+func ValidUntilGTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldValidUntil, v))
+}
+
+// ValidUntilLT applies the LT predicate on the "valid_until" field.
+func ValidUntilLT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldValidUntil, v))
+}
+
+// ValidUntilLTE applies the LTE predicate on the "valid_until" field.
+func ValidUntilLTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldValidUntil, v))
+}
+
+// ValidUntilIsNil applies the IsNil predicate on the "valid_until" field.
+func ValidUntilIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldValidUntil))
+}
+
+// ValidUntilNotNil applies the NotNil predicate on the "valid_until" field.
+func ValidUntilNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldValidUntil))
+}
+
+// OriginCityEQ applies the EQ predicate on the "origin_city" field.
+func OriginCityEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldOriginCity, v))
+}
+
+// OriginCityNEQ applies the NEQ predicate on the "origin_city" field.
+func OriginCityNEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldOriginCity, v))
+}
+
+// OriginCityIn applies the In predicate on the "origin_city" field.
+func OriginCityIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldOriginCity, vs...))
+}
+
+// OriginCityNotIn applies the NotIn predicate on the "origin_city" field.
+func OriginCityNotIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldOriginCity, vs...))
+}
+
+// OriginCityGT applies the GT predicate on the "origin_city" field.
+func OriginCityGT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldOriginCity, v))
+}
+
+// OriginCityGTE applies the GTE predicate on the "origin_city" field.
+func OriginCityGTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldOriginCity, v))
+}
+
+// OriginCityLT applies the LT predicate on the "origin_city" field.
+func OriginCityLT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldOriginCity, v))
+}
+
+// OriginCityLTE applies the LTE predicate on the "origin_city" field.
+func OriginCityLTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldOriginCity, v))
+}
+
+// OriginCityContains applies the Contains predicate on the "origin_city" field.
+func OriginCityContains(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContains(FieldOriginCity, v))
+}
+
+// OriginCityHasPrefix applies the HasPrefix predicate on the "origin_city" field.
+func OriginCityHasPrefix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasPrefix(FieldOriginCity, v))
+}
+
+// OriginCityHasSuffix applies the HasSuffix predicate on the "origin_city" field.
+func OriginCityHasSuffix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasSuffix(FieldOriginCity, v))
+}
+
+// OriginCityIsNil applies the IsNil predicate on the "origin_city" field.
+func OriginCityIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldOriginCity))
+}
+
+// OriginCityNotNil applies the NotNil predicate on the "origin_city" field.
+func OriginCityNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldOriginCity))
+}
+
+// OriginCityEqualFold applies the EqualFold predicate on the "origin_city" field.
+func OriginCityEqualFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEqualFold(FieldOriginCity, v))
+}
+
+// OriginCityContainsFold applies the ContainsFold predicate on the "origin_city" field.
+func OriginCityContainsFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContainsFold(FieldOriginCity, v))
+}
+
+// DestinationCityEQ applies the EQ predicate on the "destination_city" field.
+func DestinationCityEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldDestinationCity, v))
+}
+
+// DestinationCityNEQ applies the NEQ predicate on the "destination_city" field.
+func DestinationCityNEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldDestinationCity, v))
+}
+
+// DestinationCityIn applies the In predicate on the "destination_city" field.
+func DestinationCityIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldDestinationCity, vs...))
+}
+
+// DestinationCityNotIn applies the NotIn predicate on the "destination_city" field.
+func DestinationCityNotIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldDestinationCity, vs...))
+}
+
+// DestinationCityGT applies the GT predicate on the "destination_city" field.
+func DestinationCityGT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldDestinationCity, v))
+}
+
+// DestinationCityGTE applies the GTE predicate on the "destination_city" field.
+func DestinationCityGTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldDestinationCity, v))
+}
+
+// DestinationCityLT applies the LT predicate on the "destination_city" field.
+func DestinationCityLT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldDestinationCity, v))
+}
+
+// DestinationCityLTE applies the LTE predicate on the "destination_city" field.
+func DestinationCityLTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldDestinationCity, v))
+}
+
+// DestinationCityContains applies the Contains predicate on the "destination_city" field.
+func DestinationCityContains(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContains(FieldDestinationCity, v))
+}
+
+// DestinationCityHasPrefix applies the HasPrefix predicate on the "destination_city" field.
+func DestinationCityHasPrefix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasPrefix(FieldDestinationCity, v))
+}
+
+// DestinationCityHasSuffix applies the HasSuffix predicate on the "destination_city" field.
+func DestinationCityHasSuffix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasSuffix(FieldDestinationCity, v))
+}
+
+// DestinationCityIsNil applies the IsNil predicate on the "destination_city" field.
+func DestinationCityIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldDestinationCity))
+}
+
+// DestinationCityNotNil applies the NotNil predicate on the "destination_city" field.
+func DestinationCityNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldDestinationCity))
+}
+
+// DestinationCityEqualFold applies the EqualFold predicate on the "destination_city" field.
+func DestinationCityEqualFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEqualFold(FieldDestinationCity, v))
+}
+
+// DestinationCityContainsFold applies the ContainsFold predicate on the "destination_city" field.
+func DestinationCityContainsFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContainsFold(FieldDestinationCity, v))
+}
+
+// RideTypeEQ applies the EQ predicate on the "ride_type" field.
+func RideTypeEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldRideType, v))
+}
+
+// RideTypeNEQ applies the NEQ predicate on the "ride_type" field.
+func RideTypeNEQ(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldRideType, v))
+}
+
+// RideTypeIn applies the In predicate on the "ride_type" field.
+func RideTypeIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldRideType, vs...))
+}
+
+// RideTypeNotIn applies the NotIn predicate on the "ride_type" field.
+func RideTypeNotIn(vs ...string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldRideType, vs...))
+}
+
+// RideTypeGT applies the GT predicate on the "ride_type" field.
+func RideTypeGT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldRideType, v))
+}
+
+// RideTypeGTE applies the GTE predicate on the "ride_type" field.
+func RideTypeGTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldRideType, v))
+}
+
+// RideTypeLT applies the LT predicate on the "ride_type" field.
+func RideTypeLT(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldRideType, v))
+}
+
+// RideTypeLTE applies the LTE predicate on the "ride_type" field.
+func RideTypeLTE(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldRideType, v))
+}
+
+// RideTypeContains applies the Contains predicate on the "ride_type" field.
+func RideTypeContains(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContains(FieldRideType, v))
+}
+
+// RideTypeHasPrefix applies the HasPrefix predicate on the "ride_type" field.
+func RideTypeHasPrefix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasPrefix(FieldRideType, v))
+}
+
+// RideTypeHasSuffix applies the HasSuffix predicate on the "ride_type" field.
+func RideTypeHasSuffix(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldHasSuffix(FieldRideType, v))
+}
+
+// RideTypeIsNil applies the IsNil predicate on the "ride_type" field.
+func RideTypeIsNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIsNull(FieldRideType))
+}
+
+// RideTypeNotNil applies the NotNil predicate on the "ride_type" field.
+func RideTypeNotNil() predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotNull(FieldRideType))
+}
+
+// RideTypeEqualFold applies the EqualFold predicate on the "ride_type" field.
+func RideTypeEqualFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEqualFold(FieldRideType, v))
+}
+
+// RideTypeContainsFold applies the ContainsFold predicate on the "ride_type" field.
+func RideTypeContainsFold(v string) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldContainsFold(FieldRideType, v))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PromoCode {
+	return predicate.PromoCode(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasRedemptions applies the HasEdge predicate on the "redemptions" edge.
+func HasRedemptions() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RedemptionsTable, RedemptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRedemptionsWith applies the HasEdge predicate on the "redemptions" edge with a given conditions (other predicates).
+func HasRedemptionsWith(preds ...predicate.PromoRedemption) predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		step := newRedemptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PromoCode) predicate.PromoCode {
+	return predicate.PromoCode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PromoCode) predicate.PromoCode {
+	return predicate.PromoCode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PromoCode) predicate.PromoCode {
+	return predicate.PromoCode(sql.NotPredicates(p))
+}
@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/slowtyper/poolie/backend/ent/promocode"
+	"github.com/slowtyper/poolie/backend/ent/promoredemption"
+)
+
+// PromoCodeCreate is the builder for creating a PromoCode entity.
+type PromoCodeCreate struct {
+	config
+	mutation *PromoCodeMutation
+	hooks    []Hook
+}
+
+// SetCode sets the "code" field.
+func (_c *PromoCodeCreate) SetCode(v string) *PromoCodeCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *PromoCodeCreate) SetDescription(v string) *PromoCodeCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableDescription(v *string) *PromoCodeCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (_c *PromoCodeCreate) SetDiscountType(v string) *PromoCodeCreate {
+	_c.mutation.SetDiscountType(v)
+	return _c
+}
+
+// SetNillableDiscountType sets the "discount_type" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableDiscountType(v *string) *PromoCodeCreate {
+	if v != nil {
+		_c.SetDiscountType(*v)
+	}
+	return _c
+}
+
+// SetDiscountValue sets the "discount_value" field.
+func (_c *PromoCodeCreate) SetDiscountValue(v int64) *PromoCodeCreate {
+	_c.mutation.SetDiscountValue(v)
+	return _c
+}
+
+// SetMaxDiscount sets the "max_discount" field.
+func (_c *PromoCodeCreate) SetMaxDiscount(v int64) *PromoCodeCreate {
+	_c.mutation.SetMaxDiscount(v)
+	return _c
+}
+
+// SetNillableMaxDiscount sets the "max_discount" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableMaxDiscount(v *int64) *PromoCodeCreate {
+	if v != nil {
+		_c.SetMaxDiscount(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *PromoCodeCreate) SetCurrency(v string) *PromoCodeCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableCurrency(v *string) *PromoCodeCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetMinSpend sets the "min_spend" field.
+func (_c *PromoCodeCreate) SetMinSpend(v int64) *PromoCodeCreate {
+	_c.mutation.SetMinSpend(v)
+	return _c
+}
+
+// SetNillableMinSpend sets the "min_spend" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableMinSpend(v *int64) *PromoCodeCreate {
+	if v != nil {
+		_c.SetMinSpend(*v)
+	}
+	return _c
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_c *PromoCodeCreate) SetMaxUses(v int) *PromoCodeCreate {
+	_c.mutation.SetMaxUses(v)
+	return _c
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableMaxUses(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetMaxUses(*v)
+	}
+	return _c
+}
+
+// SetMaxUsesPerUser sets the "max_uses_per_user" field.
+func (_c *PromoCodeCreate) SetMaxUsesPerUser(v int) *PromoCodeCreate {
+	_c.mutation.SetMaxUsesPerUser(v)
+	return _c
+}
+
+// SetNillableMaxUsesPerUser sets the "max_uses_per_user" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableMaxUsesPerUser(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetMaxUsesPerUser(*v)
+	}
+	return _c
+}
+
+// SetUsedCount sets the "used_count" field.
+func (_c *PromoCodeCreate) SetUsedCount(v int) *PromoCodeCreate {
+	_c.mutation.SetUsedCount(v)
+	return _c
+}
+
+// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableUsedCount(v *int) *PromoCodeCreate {
+	if v != nil {
+		_c.SetUsedCount(*v)
+	}
+	return _c
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *PromoCodeCreate) SetValidFrom(v time.Time) *PromoCodeCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableValidFrom(v *time.Time) *PromoCodeCreate {
+	if v != nil {
+		_c.SetValidFrom(*v)
+	}
+	return _c
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (_c *PromoCodeCreate) SetValidUntil(v time.Time) *PromoCodeCreate {
+	_c.mutation.SetValidUntil(v)
+	return _c
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableValidUntil(v *time.Time) *PromoCodeCreate {
+	if v != nil {
+		_c.SetValidUntil(*v)
+	}
+	return _c
+}
+
+// SetOriginCity sets the "origin_city" field.
+func (_c *PromoCodeCreate) SetOriginCity(v string) *PromoCodeCreate {
+	_c.mutation.SetOriginCity(v)
+	return _c
+}
+
+// SetNillableOriginCity sets the "origin_city" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableOriginCity(v *string) *PromoCodeCreate {
+	if v != nil {
+		_c.SetOriginCity(*v)
+	}
+	return _c
+}
+
+// SetDestinationCity sets the "destination_city" field.
+func (_c *PromoCodeCreate) SetDestinationCity(v string) *PromoCodeCreate {
+	_c.mutation.SetDestinationCity(v)
+	return _c
+}
+
+// SetNillableDestinationCity sets the "destination_city" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableDestinationCity(v *string) *PromoCodeCreate {
+	if v != nil {
+		_c.SetDestinationCity(*v)
+	}
+	return _c
+}
+
+// SetRideType sets the "ride_type" field.
+func (_c *PromoCodeCreate) SetRideType(v string) *PromoCodeCreate {
+	_c.mutation.SetRideType(v)
+	return _c
+}
+
+// SetNillableRideType sets the "ride_type" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableRideType(v *string) *PromoCodeCreate {
+	if v != nil {
+		_c.SetRideType(*v)
+	}
+	return _c
+}
+
+// SetActive sets the "active" field.
+func (_c *PromoCodeCreate) SetActive(v bool) *PromoCodeCreate {
+	_c.mutation.SetActive(v)
+	return _c
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableActive(v *bool) *PromoCodeCreate {
+	if v != nil {
+		_c.SetActive(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PromoCodeCreate) SetCreatedAt(v time.Time) *PromoCodeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableCreatedAt(v *time.Time) *PromoCodeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PromoCodeCreate) SetUpdatedAt(v time.Time) *PromoCodeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableUpdatedAt(v *time.Time) *PromoCodeCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PromoCodeCreate) SetID(v string) *PromoCodeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PromoCodeCreate) SetNillableID(v *string) *PromoCodeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the PromoRedemption entity by IDs.
+func (_c *PromoCodeCreate) AddRedemptionIDs(ids ...string) *PromoCodeCreate {
+	_c.mutation.AddRedemptionIDs(ids...)
+	return _c
+}
+
+// AddRedemptions adds the "redemptions" edges to the PromoRedemption entity.
+func (_c *PromoCodeCreate) AddRedemptions(v ...*PromoRedemption) *PromoCodeCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRedemptionIDs(ids...)
+}
+
+// Mutation returns the PromoCodeMutation object of the builder.
+func (_c *PromoCodeCreate) Mutation() *PromoCodeMutation {
+	return _c.mutation
+}
+
+// Save creates the PromoCode in the database.
+func (_c *PromoCodeCreate) Save(ctx context.Context) (*PromoCode, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PromoCodeCreate) SaveX(ctx context.Context) *PromoCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PromoCodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PromoCodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PromoCodeCreate) defaults() {
+	if _, ok := _c.mutation.DiscountType(); !ok {
+		v := promocode.DefaultDiscountType
+		_c.mutation.SetDiscountType(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := promocode.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.MinSpend(); !ok {
+		v := promocode.DefaultMinSpend
+		_c.mutation.SetMinSpend(v)
+	}
+	if _, ok := _c.mutation.UsedCount(); !ok {
+		v := promocode.DefaultUsedCount
+		_c.mutation.SetUsedCount(v)
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		v := promocode.DefaultActive
+		_c.mutation.SetActive(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := promocode.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := promocode.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := promocode.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PromoCodeCreate) check() error {
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "PromoCode.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := promocode.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "PromoCode.code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DiscountType(); !ok {
+		return &ValidationError{Name: "discount_type", err: errors.New(`ent: missing required field "PromoCode.discount_type"`)}
+	}
+	if _, ok := _c.mutation.DiscountValue(); !ok {
+		return &ValidationError{Name: "discount_value", err: errors.New(`ent: missing required field "PromoCode.discount_value"`)}
+	}
+	if v, ok := _c.mutation.DiscountValue(); ok {
+		if err := promocode.DiscountValueValidator(v); err != nil {
+			return &ValidationError{Name: "discount_value", err: fmt.Errorf(`ent: validator failed for field "PromoCode.discount_value": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxDiscount(); ok {
+		if err := promocode.MaxDiscountValidator(v); err != nil {
+			return &ValidationError{Name: "max_discount", err: fmt.Errorf(`ent: validator failed for field "PromoCode.max_discount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "PromoCode.currency"`)}
+	}
+	if _, ok := _c.mutation.MinSpend(); !ok {
+		return &ValidationError{Name: "min_spend", err: errors.New(`ent: missing required field "PromoCode.min_spend"`)}
+	}
+	if v, ok := _c.mutation.MinSpend(); ok {
+		if err := promocode.MinSpendValidator(v); err != nil {
+			return &ValidationError{Name: "min_spend", err: fmt.Errorf(`ent: validator failed for field "PromoCode.min_spend": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxUses(); ok {
+		if err := promocode.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "PromoCode.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxUsesPerUser(); ok {
+		if err := promocode.MaxUsesPerUserValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses_per_user", err: fmt.Errorf(`ent: validator failed for field "PromoCode.max_uses_per_user": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UsedCount(); !ok {
+		return &ValidationError{Name: "used_count", err: errors.New(`ent: missing required field "PromoCode.used_count"`)}
+	}
+	if v, ok := _c.mutation.UsedCount(); ok {
+		if err := promocode.UsedCountValidator(v); err != nil {
+			return &ValidationError{Name: "used_count", err: fmt.Errorf(`ent: validator failed for field "PromoCode.used_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "PromoCode.active"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PromoCode.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PromoCode.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *PromoCodeCreate) sqlSave(ctx context.Context) (*PromoCode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PromoCode.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PromoCodeCreate) createSpec() (*PromoCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PromoCode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(promocode.Table, sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(promocode.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(promocode.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.DiscountType(); ok {
+		_spec.SetField(promocode.FieldDiscountType, field.TypeString, value)
+		_node.DiscountType = value
+	}
+	if value, ok := _c.mutation.DiscountValue(); ok {
+		_spec.SetField(promocode.FieldDiscountValue, field.TypeInt64, value)
+		_node.DiscountValue = value
+	}
+	if value, ok := _c.mutation.MaxDiscount(); ok {
+		_spec.SetField(promocode.FieldMaxDiscount, field.TypeInt64, value)
+		_node.MaxDiscount = &value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(promocode.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.MinSpend(); ok {
+		_spec.SetField(promocode.FieldMinSpend, field.TypeInt64, value)
+		_node.MinSpend = value
+	}
+	if value, ok := _c.mutation.MaxUses(); ok {
+		_spec.SetField(promocode.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = &value
+	}
+	if value, ok := _c.mutation.MaxUsesPerUser(); ok {
+		_spec.SetField(promocode.FieldMaxUsesPerUser, field.TypeInt, value)
+		_node.MaxUsesPerUser = &value
+	}
+	if value, ok := _c.mutation.UsedCount(); ok {
+		_spec.SetField(promocode.FieldUsedCount, field.TypeInt, value)
+		_node.UsedCount = value
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(promocode.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = &value
+	}
+	if value, ok := _c.mutation.ValidUntil(); ok {
+		_spec.SetField(promocode.FieldValidUntil, field.TypeTime, value)
+		_node.ValidUntil = &value
+	}
+	if value, ok := _c.mutation.OriginCity(); ok {
+		_spec.SetField(promocode.FieldOriginCity, field.TypeString, value)
+		_node.OriginCity = value
+	}
+	if value, ok := _c.mutation.DestinationCity(); ok {
+		_spec.SetField(promocode.FieldDestinationCity, field.TypeString, value)
+		_node.DestinationCity = value
+	}
+	if value, ok := _c.mutation.RideType(); ok {
+		_spec.SetField(promocode.FieldRideType, field.TypeString, value)
+		_node.RideType = value
+	}
+	if value, ok := _c.mutation.Active(); ok {
+		_spec.SetField(promocode.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(promocode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(promocode.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PromoCodeCreateBulk is the builder for creating many PromoCode entities in bulk.
+type PromoCodeCreateBulk struct {
+	config
+	err      error
+	builders []*PromoCodeCreate
+}
+
+// Save creates the PromoCode entities in the database.
+func (_c *PromoCodeCreateBulk) Save(ctx context.Context) ([]*PromoCode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PromoCode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PromoCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PromoCodeCreateBulk) SaveX(ctx context.Context) []*PromoCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PromoCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PromoCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/slowtyper/poolie/backend/ent/predicate"
+	"github.com/slowtyper/poolie/backend/ent/promocode"
+	"github.com/slowtyper/poolie/backend/ent/promoredemption"
+)
+
+// PromoCodeUpdate is the builder for updating PromoCode entities.
+type PromoCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PromoCodeMutation
+}
+
+// Where appends a list predicates to the PromoCodeUpdate builder.
+func (_u *PromoCodeUpdate) Where(ps ...predicate.PromoCode) *PromoCodeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *PromoCodeUpdate) SetCode(v string) *PromoCodeUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableCode(v *string) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PromoCodeUpdate) SetDescription(v string) *PromoCodeUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableDescription(v *string) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *PromoCodeUpdate) ClearDescription() *PromoCodeUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (_u *PromoCodeUpdate) SetDiscountType(v string) *PromoCodeUpdate {
+	_u.mutation.SetDiscountType(v)
+	return _u
+}
+
+// SetNillableDiscountType sets the "discount_type" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableDiscountType(v *string) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetDiscountType(*v)
+	}
+	return _u
+}
+
+// SetDiscountValue sets the "discount_value" field.
+func (_u *PromoCodeUpdate) SetDiscountValue(v int64) *PromoCodeUpdate {
+	_u.mutation.ResetDiscountValue()
+	_u.mutation.SetDiscountValue(v)
+	return _u
+}
+
+// SetNillableDiscountValue sets the "discount_value" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableDiscountValue(v *int64) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetDiscountValue(*v)
+	}
+	return _u
+}
+
+// AddDiscountValue adds value to the "discount_value" field.
+func (_u *PromoCodeUpdate) AddDiscountValue(v int64) *PromoCodeUpdate {
+	_u.mutation.AddDiscountValue(v)
+	return _u
+}
+
+// SetMaxDiscount sets the "max_discount" field.
+func (_u *PromoCodeUpdate) SetMaxDiscount(v int64) *PromoCodeUpdate {
+	_u.mutation.ResetMaxDiscount()
+	_u.mutation.SetMaxDiscount(v)
+	return _u
+}
+
+// SetNillableMaxDiscount sets the "max_discount" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableMaxDiscount(v *int64) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetMaxDiscount(*v)
+	}
+	return _u
+}
+
+// AddMaxDiscount adds value to the "max_discount" field.
+func (_u *PromoCodeUpdate) AddMaxDiscount(v int64) *PromoCodeUpdate {
+	_u.mutation.AddMaxDiscount(v)
+	return _u
+}
+
+// ClearMaxDiscount clears the value of the "max_discount" field.
+func (_u *PromoCodeUpdate) ClearMaxDiscount() *PromoCodeUpdate {
+	_u.mutation.ClearMaxDiscount()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PromoCodeUpdate) SetCurrency(v string) *PromoCodeUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableCurrency(v *string) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetMinSpend sets the "min_spend" field.
+func (_u *PromoCodeUpdate) SetMinSpend(v int64) *PromoCodeUpdate {
+	_u.mutation.ResetMinSpend()
+	_u.mutation.SetMinSpend(v)
+	return _u
+}
+
+// SetNillableMinSpend sets the "min_spend" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableMinSpend(v *int64) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetMinSpend(*v)
+	}
+	return _u
+}
+
+// AddMinSpend adds value to the "min_spend" field.
+func (_u *PromoCodeUpdate) AddMinSpend(v int64) *PromoCodeUpdate {
+	_u.mutation.AddMinSpend(v)
+	return _u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_u *PromoCodeUpdate) SetMaxUses(v int) *PromoCodeUpdate {
+	_u.mutation.ResetMaxUses()
+	_u.mutation.SetMaxUses(v)
+	return _u
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableMaxUses(v *int) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetMaxUses(*v)
+	}
+	return _u
+}
+
+// AddMaxUses adds value to the "max_uses" field.
+func (_u *PromoCodeUpdate) AddMaxUses(v int) *PromoCodeUpdate {
+	_u.mutation.AddMaxUses(v)
+	return _u
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (_u *PromoCodeUpdate) ClearMaxUses() *PromoCodeUpdate {
+	_u.mutation.ClearMaxUses()
+	return _u
+}
+
+// SetMaxUsesPerUser sets the "max_uses_per_user" field.
+func (_u *PromoCodeUpdate) SetMaxUsesPerUser(v int) *PromoCodeUpdate {
+	_u.mutation.ResetMaxUsesPerUser()
+	_u.mutation.SetMaxUsesPerUser(v)
+	return _u
+}
+
+// SetNillableMaxUsesPerUser sets the "max_uses_per_user" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableMaxUsesPerUser(v *int) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetMaxUsesPerUser(*v)
+	}
+	return _u
+}
+
+// AddMaxUsesPerUser adds value to the "max_uses_per_user" field.
+func (_u *PromoCodeUpdate) AddMaxUsesPerUser(v int) *PromoCodeUpdate {
+	_u.mutation.AddMaxUsesPerUser(v)
+	return _u
+}
+
+// ClearMaxUsesPerUser clears the value of the "max_uses_per_user" field.
+func (_u *PromoCodeUpdate) ClearMaxUsesPerUser() *PromoCodeUpdate {
+	_u.mutation.ClearMaxUsesPerUser()
+	return _u
+}
+
+// SetUsedCount sets the "used_count" field.
+func (_u *PromoCodeUpdate) SetUsedCount(v int) *PromoCodeUpdate {
+	_u.mutation.ResetUsedCount()
+	_u.mutation.SetUsedCount(v)
+	return _u
+}
+
+// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableUsedCount(v *int) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetUsedCount(*v)
+	}
+	return _u
+}
+
+// AddUsedCount adds value to the "used_count" field.
+func (_u *PromoCodeUpdate) AddUsedCount(v int) *PromoCodeUpdate {
+	_u.mutation.AddUsedCount(v)
+	return _u
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_u *PromoCodeUpdate) SetValidFrom(v time.Time) *PromoCodeUpdate {
+	_u.mutation.SetValidFrom(v)
+	return _u
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableValidFrom(v *time.Time) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetValidFrom(*v)
+	}
+	return _u
+}
+
+// ClearValidFrom clears the value of the "valid_from" field.
+func (_u *PromoCodeUpdate) ClearValidFrom() *PromoCodeUpdate {
+	_u.mutation.ClearValidFrom()
+	return _u
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (_u *PromoCodeUpdate) SetValidUntil(v time.Time) *PromoCodeUpdate {
+	_u.mutation.SetValidUntil(v)
+	return _u
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableValidUntil(v *time.Time) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetValidUntil(*v)
+	}
+	return _u
+}
+
+// ClearValidUntil clears the value of the "valid_until" field.
+func (_u *PromoCodeUpdate) ClearValidUntil() *PromoCodeUpdate {
+	_u.mutation.ClearValidUntil()
+	return _u
+}
+
+// SetOriginCity sets the "origin_city" field.
+func (_u *PromoCodeUpdate) SetOriginCity(v string) *PromoCodeUpdate {
+	_u.mutation.SetOriginCity(v)
+	return _u
+}
+
+// SetNillableOriginCity sets the "origin_city" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableOriginCity(v *string) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetOriginCity(*v)
+	}
+	return _u
+}
+
+// ClearOriginCity clears the value of the "origin_city" field.
+func (_u *PromoCodeUpdate) ClearOriginCity() *PromoCodeUpdate {
+	_u.mutation.ClearOriginCity()
+	return _u
+}
+
+// SetDestinationCity sets the "destination_city" field.
+func (_u *PromoCodeUpdate) SetDestinationCity(v string) *PromoCodeUpdate {
+	_u.mutation.SetDestinationCity(v)
+	return _u
+}
+
+// SetNillableDestinationCity sets the "destination_city" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableDestinationCity(v *string) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetDestinationCity(*v)
+	}
+	return _u
+}
+
+// ClearDestinationCity clears the value of the "destination_city" field.
+func (_u *PromoCodeUpdate) ClearDestinationCity() *PromoCodeUpdate {
+	_u.mutation.ClearDestinationCity()
+	return _u
+}
+
+// SetRideType sets the "ride_type" field.
+func (_u *PromoCodeUpdate) SetRideType(v string) *PromoCodeUpdate {
+	_u.mutation.SetRideType(v)
+	return _u
+}
+
+// SetNillableRideType sets the "ride_type" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableRideType(v *string) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetRideType(*v)
+	}
+	return _u
+}
+
+// ClearRideType clears the value of the "ride_type" field.
+func (_u *PromoCodeUpdate) ClearRideType() *PromoCodeUpdate {
+	_u.mutation.ClearRideType()
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *PromoCodeUpdate) SetActive(v bool) *PromoCodeUpdate {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *PromoCodeUpdate) SetNillableActive(v *bool) *PromoCodeUpdate {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PromoCodeUpdate) SetUpdatedAt(v time.Time) *PromoCodeUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the PromoRedemption entity by IDs.
+func (_u *PromoCodeUpdate) AddRedemptionIDs(ids ...string) *PromoCodeUpdate {
+	_u.mutation.AddRedemptionIDs(ids...)
+	return _u
+}
+
+// AddRedemptions adds the "redemptions" edges to the PromoRedemption entity.
+func (_u *PromoCodeUpdate) AddRedemptions(v ...*PromoRedemption) *PromoCodeUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRedemptionIDs(ids...)
+}
+
+// Mutation returns the PromoCodeMutation object of the builder.
+func (_u *PromoCodeUpdate) Mutation() *PromoCodeMutation {
+	return _u.mutation
+}
+
+// ClearRedemptions clears all "redemptions" edges to the PromoRedemption entity.
+func (_u *PromoCodeUpdate) ClearRedemptions() *PromoCodeUpdate {
+	_u.mutation.ClearRedemptions()
+	return _u
+}
+
+// RemoveRedemptionIDs removes the "redemptions" edge to PromoRedemption entities by IDs.
+func (_u *PromoCodeUpdate) RemoveRedemptionIDs(ids ...string) *PromoCodeUpdate {
+	_u.mutation.RemoveRedemptionIDs(ids...)
+	return _u
+}
+
+// RemoveRedemptions removes "redemptions" edges to PromoRedemption entities.
+func (_u *PromoCodeUpdate) RemoveRedemptions(v ...*PromoRedemption) *PromoCodeUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRedemptionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PromoCodeUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PromoCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PromoCodeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PromoCodeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PromoCodeUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := promocode.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PromoCodeUpdate) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := promocode.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "PromoCode.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountValue(); ok {
+		if err := promocode.DiscountValueValidator(v); err != nil {
+			return &ValidationError{Name: "discount_value", err: fmt.Errorf(`ent: validator failed for field "PromoCode.discount_value": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxDiscount(); ok {
+		if err := promocode.MaxDiscountValidator(v); err != nil {
+			return &ValidationError{Name: "max_discount", err: fmt.Errorf(`ent: validator failed for field "PromoCode.max_discount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinSpend(); ok {
+		if err := promocode.MinSpendValidator(v); err != nil {
+			return &ValidationError{Name: "min_spend", err: fmt.Errorf(`ent: validator failed for field "PromoCode.min_spend": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxUses(); ok {
+		if err := promocode.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "PromoCode.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxUsesPerUser(); ok {
+		if err := promocode.MaxUsesPerUserValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses_per_user", err: fmt.Errorf(`ent: validator failed for field "PromoCode.max_uses_per_user": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UsedCount(); ok {
+		if err := promocode.UsedCountValidator(v); err != nil {
+			return &ValidationError{Name: "used_count", err: fmt.Errorf(`ent: validator failed for field "PromoCode.used_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PromoCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promocode.Table, promocode.Columns, sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(promocode.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(promocode.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(promocode.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.DiscountType(); ok {
+		_spec.SetField(promocode.FieldDiscountType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DiscountValue(); ok {
+		_spec.SetField(promocode.FieldDiscountValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountValue(); ok {
+		_spec.AddField(promocode.FieldDiscountValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MaxDiscount(); ok {
+		_spec.SetField(promocode.FieldMaxDiscount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDiscount(); ok {
+		_spec.AddField(promocode.FieldMaxDiscount, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxDiscountCleared() {
+		_spec.ClearField(promocode.FieldMaxDiscount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(promocode.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MinSpend(); ok {
+		_spec.SetField(promocode.FieldMinSpend, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMinSpend(); ok {
+		_spec.AddField(promocode.FieldMinSpend, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MaxUses(); ok {
+		_spec.SetField(promocode.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUses(); ok {
+		_spec.AddField(promocode.FieldMaxUses, field.TypeInt, value)
+	}
+	if _u.mutation.MaxUsesCleared() {
+		_spec.ClearField(promocode.FieldMaxUses, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxUsesPerUser(); ok {
+		_spec.SetField(promocode.FieldMaxUsesPerUser, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUsesPerUser(); ok {
+		_spec.AddField(promocode.FieldMaxUsesPerUser, field.TypeInt, value)
+	}
+	if _u.mutation.MaxUsesPerUserCleared() {
+		_spec.ClearField(promocode.FieldMaxUsesPerUser, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UsedCount(); ok {
+		_spec.SetField(promocode.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUsedCount(); ok {
+		_spec.AddField(promocode.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidFrom(); ok {
+		_spec.SetField(promocode.FieldValidFrom, field.TypeTime, value)
+	}
+	if _u.mutation.ValidFromCleared() {
+		_spec.ClearField(promocode.FieldValidFrom, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidUntil(); ok {
+		_spec.SetField(promocode.FieldValidUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ValidUntilCleared() {
+		_spec.ClearField(promocode.FieldValidUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OriginCity(); ok {
+		_spec.SetField(promocode.FieldOriginCity, field.TypeString, value)
+	}
+	if _u.mutation.OriginCityCleared() {
+		_spec.ClearField(promocode.FieldOriginCity, field.TypeString)
+	}
+	if value, ok := _u.mutation.DestinationCity(); ok {
+		_spec.SetField(promocode.FieldDestinationCity, field.TypeString, value)
+	}
+	if _u.mutation.DestinationCityCleared() {
+		_spec.ClearField(promocode.FieldDestinationCity, field.TypeString)
+	}
+	if value, ok := _u.mutation.RideType(); ok {
+		_spec.SetField(promocode.FieldRideType, field.TypeString, value)
+	}
+	if _u.mutation.RideTypeCleared() {
+		_spec.ClearField(promocode.FieldRideType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(promocode.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(promocode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRedemptionsIDs(); len(nodes) > 0 && !_u.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promocode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PromoCodeUpdateOne is the builder for updating a single PromoCode entity.
+type PromoCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PromoCodeMutation
+}
+
+// SetCode sets the "code" field.
+func (_u *PromoCodeUpdateOne) SetCode(v string) *PromoCodeUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableCode(v *string) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PromoCodeUpdateOne) SetDescription(v string) *PromoCodeUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableDescription(v *string) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *PromoCodeUpdateOne) ClearDescription() *PromoCodeUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (_u *PromoCodeUpdateOne) SetDiscountType(v string) *PromoCodeUpdateOne {
+	_u.mutation.SetDiscountType(v)
+	return _u
+}
+
+// SetNillableDiscountType sets the "discount_type" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableDiscountType(v *string) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetDiscountType(*v)
+	}
+	return _u
+}
+
+// SetDiscountValue sets the "discount_value" field.
+func (_u *PromoCodeUpdateOne) SetDiscountValue(v int64) *PromoCodeUpdateOne {
+	_u.mutation.ResetDiscountValue()
+	_u.mutation.SetDiscountValue(v)
+	return _u
+}
+
+// SetNillableDiscountValue sets the "discount_value" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableDiscountValue(v *int64) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetDiscountValue(*v)
+	}
+	return _u
+}
+
+// AddDiscountValue adds value to the "discount_value" field.
+func (_u *PromoCodeUpdateOne) AddDiscountValue(v int64) *PromoCodeUpdateOne {
+	_u.mutation.AddDiscountValue(v)
+	return _u
+}
+
+// SetMaxDiscount sets the "max_discount" field.
+func (_u *PromoCodeUpdateOne) SetMaxDiscount(v int64) *PromoCodeUpdateOne {
+	_u.mutation.ResetMaxDiscount()
+	_u.mutation.SetMaxDiscount(v)
+	return _u
+}
+
+// SetNillableMaxDiscount sets the "max_discount" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableMaxDiscount(v *int64) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetMaxDiscount(*v)
+	}
+	return _u
+}
+
+// AddMaxDiscount adds value to the "max_discount" field.
+func (_u *PromoCodeUpdateOne) AddMaxDiscount(v int64) *PromoCodeUpdateOne {
+	_u.mutation.AddMaxDiscount(v)
+	return _u
+}
+
+// ClearMaxDiscount clears the value of the "max_discount" field.
+func (_u *PromoCodeUpdateOne) ClearMaxDiscount() *PromoCodeUpdateOne {
+	_u.mutation.ClearMaxDiscount()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PromoCodeUpdateOne) SetCurrency(v string) *PromoCodeUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableCurrency(v *string) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetMinSpend sets the "min_spend" field.
+func (_u *PromoCodeUpdateOne) SetMinSpend(v int64) *PromoCodeUpdateOne {
+	_u.mutation.ResetMinSpend()
+	_u.mutation.SetMinSpend(v)
+	return _u
+}
+
+// SetNillableMinSpend sets the "min_spend" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableMinSpend(v *int64) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetMinSpend(*v)
+	}
+	return _u
+}
+
+// AddMinSpend adds value to the "min_spend" field.
+func (_u *PromoCodeUpdateOne) AddMinSpend(v int64) *PromoCodeUpdateOne {
+	_u.mutation.AddMinSpend(v)
+	return _u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_u *PromoCodeUpdateOne) SetMaxUses(v int) *PromoCodeUpdateOne {
+	_u.mutation.ResetMaxUses()
+	_u.mutation.SetMaxUses(v)
+	return _u
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableMaxUses(v *int) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetMaxUses(*v)
+	}
+	return _u
+}
+
+// AddMaxUses adds value to the "max_uses" field.
+func (_u *PromoCodeUpdateOne) AddMaxUses(v int) *PromoCodeUpdateOne {
+	_u.mutation.AddMaxUses(v)
+	return _u
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (_u *PromoCodeUpdateOne) ClearMaxUses() *PromoCodeUpdateOne {
+	_u.mutation.ClearMaxUses()
+	return _u
+}
+
+// SetMaxUsesPerUser sets the "max_uses_per_user" field.
+func (_u *PromoCodeUpdateOne) SetMaxUsesPerUser(v int) *PromoCodeUpdateOne {
+	_u.mutation.ResetMaxUsesPerUser()
+	_u.mutation.SetMaxUsesPerUser(v)
+	return _u
+}
+
+// SetNillableMaxUsesPerUser sets the "max_uses_per_user" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableMaxUsesPerUser(v *int) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetMaxUsesPerUser(*v)
+	}
+	return _u
+}
+
+// AddMaxUsesPerUser adds value to the "max_uses_per_user" field.
+func (_u *PromoCodeUpdateOne) AddMaxUsesPerUser(v int) *PromoCodeUpdateOne {
+	_u.mutation.AddMaxUsesPerUser(v)
+	return _u
+}
+
+// ClearMaxUsesPerUser clears the value of the "max_uses_per_user" field.
+func (_u *PromoCodeUpdateOne) ClearMaxUsesPerUser() *PromoCodeUpdateOne {
+	_u.mutation.ClearMaxUsesPerUser()
+	return _u
+}
+
+// SetUsedCount sets the "used_count" field.
+func (_u *PromoCodeUpdateOne) SetUsedCount(v int) *PromoCodeUpdateOne {
+	_u.mutation.ResetUsedCount()
+	_u.mutation.SetUsedCount(v)
+	return _u
+}
+
+// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableUsedCount(v *int) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetUsedCount(*v)
+	}
+	return _u
+}
+
+// AddUsedCount adds value to the "used_count" field.
+func (_u *PromoCodeUpdateOne) AddUsedCount(v int) *PromoCodeUpdateOne {
+	_u.mutation.AddUsedCount(v)
+	return _u
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_u *PromoCodeUpdateOne) SetValidFrom(v time.Time) *PromoCodeUpdateOne {
+	_u.mutation.SetValidFrom(v)
+	return _u
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableValidFrom(v *time.Time) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetValidFrom(*v)
+	}
+	return _u
+}
+
+// ClearValidFrom clears the value of the "valid_from" field.
+func (_u *PromoCodeUpdateOne) ClearValidFrom() *PromoCodeUpdateOne {
+	_u.mutation.ClearValidFrom()
+	return _u
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (_u *PromoCodeUpdateOne) SetValidUntil(v time.Time) *PromoCodeUpdateOne {
+	_u.mutation.SetValidUntil(v)
+	return _u
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableValidUntil(v *time.Time) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetValidUntil(*v)
+	}
+	return _u
+}
+
+// ClearValidUntil clears the value of the "valid_until" field.
+func (_u *PromoCodeUpdateOne) ClearValidUntil() *PromoCodeUpdateOne {
+	_u.mutation.ClearValidUntil()
+	return _u
+}
+
+// SetOriginCity sets the "origin_city" field.
+func (_u *PromoCodeUpdateOne) SetOriginCity(v string) *PromoCodeUpdateOne {
+	_u.mutation.SetOriginCity(v)
+	return _u
+}
+
+// SetNillableOriginCity sets the "origin_city" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableOriginCity(v *string) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetOriginCity(*v)
+	}
+	return _u
+}
+
+// ClearOriginCity clears the value of the "origin_city" field.
+func (_u *PromoCodeUpdateOne) ClearOriginCity() *PromoCodeUpdateOne {
+	_u.mutation.ClearOriginCity()
+	return _u
+}
+
+// SetDestinationCity sets the "destination_city" field.
+func (_u *PromoCodeUpdateOne) SetDestinationCity(v string) *PromoCodeUpdateOne {
+	_u.mutation.SetDestinationCity(v)
+	return _u
+}
+
+// SetNillableDestinationCity sets the "destination_city" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableDestinationCity(v *string) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetDestinationCity(*v)
+	}
+	return _u
+}
+
+// ClearDestinationCity clears the value of the "destination_city" field.
+func (_u *PromoCodeUpdateOne) ClearDestinationCity() *PromoCodeUpdateOne {
+	_u.mutation.ClearDestinationCity()
+	return _u
+}
+
+// SetRideType sets the "ride_type" field.
+func (_u *PromoCodeUpdateOne) SetRideType(v string) *PromoCodeUpdateOne {
+	_u.mutation.SetRideType(v)
+	return _u
+}
+
+// SetNillableRideType sets the "ride_type" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableRideType(v *string) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetRideType(*v)
+	}
+	return _u
+}
+
+// ClearRideType clears the value of the "ride_type" field.
+func (_u *PromoCodeUpdateOne) ClearRideType() *PromoCodeUpdateOne {
+	_u.mutation.ClearRideType()
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *PromoCodeUpdateOne) SetActive(v bool) *PromoCodeUpdateOne {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *PromoCodeUpdateOne) SetNillableActive(v *bool) *PromoCodeUpdateOne {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PromoCodeUpdateOne) SetUpdatedAt(v time.Time) *PromoCodeUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddRedemptionIDs adds the "redemptions" edge to the PromoRedemption entity by IDs.
+func (_u *PromoCodeUpdateOne) AddRedemptionIDs(ids ...string) *PromoCodeUpdateOne {
+	_u.mutation.AddRedemptionIDs(ids...)
+	return _u
+}
+
+// AddRedemptions adds the "redemptions" edges to the PromoRedemption entity.
+func (_u *PromoCodeUpdateOne) AddRedemptions(v ...*PromoRedemption) *PromoCodeUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRedemptionIDs(ids...)
+}
+
+// Mutation returns the PromoCodeMutation object of the builder.
+func (_u *PromoCodeUpdateOne) Mutation() *PromoCodeMutation {
+	return _u.mutation
+}
+
+// ClearRedemptions clears all "redemptions" edges to the PromoRedemption entity.
+func (_u *PromoCodeUpdateOne) ClearRedemptions() *PromoCodeUpdateOne {
+	_u.mutation.ClearRedemptions()
+	return _u
+}
+
+// RemoveRedemptionIDs removes the "redemptions" edge to PromoRedemption entities by IDs.
+func (_u *PromoCodeUpdateOne) RemoveRedemptionIDs(ids ...string) *PromoCodeUpdateOne {
+	_u.mutation.RemoveRedemptionIDs(ids...)
+	return _u
+}
+
+// RemoveRedemptions removes "redemptions" edges to PromoRedemption entities.
+func (_u *PromoCodeUpdateOne) RemoveRedemptions(v ...*PromoRedemption) *PromoCodeUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRedemptionIDs(ids...)
+}
+
+// Where appends a list predicates to the PromoCodeUpdate builder.
+func (_u *PromoCodeUpdateOne) Where(ps ...predicate.PromoCode) *PromoCodeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PromoCodeUpdateOne) Select(field string, fields ...string) *PromoCodeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PromoCode entity.
+func (_u *PromoCodeUpdateOne) Save(ctx context.Context) (*PromoCode, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PromoCodeUpdateOne) SaveX(ctx context.Context) *PromoCode {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PromoCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PromoCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PromoCodeUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := promocode.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PromoCodeUpdateOne) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := promocode.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "PromoCode.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountValue(); ok {
+		if err := promocode.DiscountValueValidator(v); err != nil {
+			return &ValidationError{Name: "discount_value", err: fmt.Errorf(`ent: validator failed for field "PromoCode.discount_value": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxDiscount(); ok {
+		if err := promocode.MaxDiscountValidator(v); err != nil {
+			return &ValidationError{Name: "max_discount", err: fmt.Errorf(`ent: validator failed for field "PromoCode.max_discount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinSpend(); ok {
+		if err := promocode.MinSpendValidator(v); err != nil {
+			return &ValidationError{Name: "min_spend", err: fmt.Errorf(`ent: validator failed for field "PromoCode.min_spend": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxUses(); ok {
+		if err := promocode.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "PromoCode.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxUsesPerUser(); ok {
+		if err := promocode.MaxUsesPerUserValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses_per_user", err: fmt.Errorf(`ent: validator failed for field "PromoCode.max_uses_per_user": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UsedCount(); ok {
+		if err := promocode.UsedCountValidator(v); err != nil {
+			return &ValidationError{Name: "used_count", err: fmt.Errorf(`ent: validator failed for field "PromoCode.used_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PromoCodeUpdateOne) sqlSave(ctx context.Context) (_node *PromoCode, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promocode.Table, promocode.Columns, sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PromoCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promocode.FieldID)
+		for _, f := range fields {
+			if !promocode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != promocode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(promocode.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(promocode.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(promocode.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.DiscountType(); ok {
+		_spec.SetField(promocode.FieldDiscountType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DiscountValue(); ok {
+		_spec.SetField(promocode.FieldDiscountValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountValue(); ok {
+		_spec.AddField(promocode.FieldDiscountValue, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MaxDiscount(); ok {
+		_spec.SetField(promocode.FieldMaxDiscount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDiscount(); ok {
+		_spec.AddField(promocode.FieldMaxDiscount, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxDiscountCleared() {
+		_spec.ClearField(promocode.FieldMaxDiscount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(promocode.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MinSpend(); ok {
+		_spec.SetField(promocode.FieldMinSpend, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMinSpend(); ok {
+		_spec.AddField(promocode.FieldMinSpend, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MaxUses(); ok {
+		_spec.SetField(promocode.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUses(); ok {
+		_spec.AddField(promocode.FieldMaxUses, field.TypeInt, value)
+	}
+	if _u.mutation.MaxUsesCleared() {
+		_spec.ClearField(promocode.FieldMaxUses, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxUsesPerUser(); ok {
+		_spec.SetField(promocode.FieldMaxUsesPerUser, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUsesPerUser(); ok {
+		_spec.AddField(promocode.FieldMaxUsesPerUser, field.TypeInt, value)
+	}
+	if _u.mutation.MaxUsesPerUserCleared() {
+		_spec.ClearField(promocode.FieldMaxUsesPerUser, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UsedCount(); ok {
+		_spec.SetField(promocode.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUsedCount(); ok {
+		_spec.AddField(promocode.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidFrom(); ok {
+		_spec.SetField(promocode.FieldValidFrom, field.TypeTime, value)
+	}
+	if _u.mutation.ValidFromCleared() {
+		_spec.ClearField(promocode.FieldValidFrom, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidUntil(); ok {
+		_spec.SetField(promocode.FieldValidUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ValidUntilCleared() {
+		_spec.ClearField(promocode.FieldValidUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OriginCity(); ok {
+		_spec.SetField(promocode.FieldOriginCity, field.TypeString, value)
+	}
+	if _u.mutation.OriginCityCleared() {
+		_spec.ClearField(promocode.FieldOriginCity, field.TypeString)
+	}
+	if value, ok := _u.mutation.DestinationCity(); ok {
+		_spec.SetField(promocode.FieldDestinationCity, field.TypeString, value)
+	}
+	if _u.mutation.DestinationCityCleared() {
+		_spec.ClearField(promocode.FieldDestinationCity, field.TypeString)
+	}
+	if value, ok := _u.mutation.RideType(); ok {
+		_spec.SetField(promocode.FieldRideType, field.TypeString, value)
+	}
+	if _u.mutation.RideTypeCleared() {
+		_spec.ClearField(promocode.FieldRideType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(promocode.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(promocode.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRedemptionsIDs(); len(nodes) > 0 && !_u.mutation.RedemptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RedemptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   promocode.RedemptionsTable,
+			Columns: []string{promocode.RedemptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PromoCode{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promocode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
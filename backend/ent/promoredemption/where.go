@@ -0,0 +1,529 @@
+// Code generated by ent, DO NOT EDIT.
+
+package promoredemption
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/slowtyper/poolie/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContainsFold(FieldID, id))
+}
+
+// PromoCodeID applies equality check predicate on the "promo_code_id" field. It's identical to PromoCodeIDEQ.
+func PromoCodeID(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldPromoCodeID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldUserID, v))
+}
+
+// BookingID applies equality check predicate on the "booking_id" field. It's identical to BookingIDEQ.
+func BookingID(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldBookingID, v))
+}
+
+// DiscountAmount applies equality check predicate on the "discount_amount" field. It's identical to DiscountAmountEQ.
+func DiscountAmount(v int64) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldCurrency, v))
+}
+
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PromoCodeIDEQ applies the EQ predicate on the "promo_code_id" field.
+func PromoCodeIDEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDNEQ applies the NEQ predicate on the "promo_code_id" field.
+func PromoCodeIDNEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDIn applies the In predicate on the "promo_code_id" field.
+func PromoCodeIDIn(vs ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldPromoCodeID, vs...))
+}
+
+// PromoCodeIDNotIn applies the NotIn predicate on the "promo_code_id" field.
+func PromoCodeIDNotIn(vs ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldPromoCodeID, vs...))
+}
+
+// PromoCodeIDGT applies the GT predicate on the "promo_code_id" field.
+func PromoCodeIDGT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDGTE applies the GTE predicate on the "promo_code_id" field.
+func PromoCodeIDGTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDLT applies the LT predicate on the "promo_code_id" field.
+func PromoCodeIDLT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDLTE applies the LTE predicate on the "promo_code_id" field.
+func PromoCodeIDLTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDContains applies the Contains predicate on the "promo_code_id" field.
+func PromoCodeIDContains(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContains(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDHasPrefix applies the HasPrefix predicate on the "promo_code_id" field.
+func PromoCodeIDHasPrefix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldHasPrefix(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDHasSuffix applies the HasSuffix predicate on the "promo_code_id" field.
+func PromoCodeIDHasSuffix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldHasSuffix(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDEqualFold applies the EqualFold predicate on the "promo_code_id" field.
+func PromoCodeIDEqualFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEqualFold(FieldPromoCodeID, v))
+}
+
+// PromoCodeIDContainsFold applies the ContainsFold predicate on the "promo_code_id" field.
+func PromoCodeIDContainsFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContainsFold(FieldPromoCodeID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// BookingIDEQ applies the EQ predicate on the "booking_id" field.
+func BookingIDEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldBookingID, v))
+}
+
+// BookingIDNEQ applies the NEQ predicate on the "booking_id" field.
+func BookingIDNEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldBookingID, v))
+}
+
+// BookingIDIn applies the In predicate on the "booking_id" field.
+func BookingIDIn(vs ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldBookingID, vs...))
+}
+
+// BookingIDNotIn applies the NotIn predicate on the "booking_id" field.
+func BookingIDNotIn(vs ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldBookingID, vs...))
+}
+
+// BookingIDGT applies the GT predicate on the "booking_id" field.
+func BookingIDGT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldBookingID, v))
+}
+
+// BookingIDGTE applies the GTE predicate on the "booking_id" field.
+func BookingIDGTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldBookingID, v))
+}
+
+// BookingIDLT applies the LT predicate on the "booking_id" field.
+func BookingIDLT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldBookingID, v))
+}
+
+// BookingIDLTE applies the LTE predicate on the "booking_id" field.
+func BookingIDLTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldBookingID, v))
+}
+
+// BookingIDContains applies the Contains predicate on the "booking_id" field.
+func BookingIDContains(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContains(FieldBookingID, v))
+}
+
+// BookingIDHasPrefix applies the HasPrefix predicate on the "booking_id" field.
+func BookingIDHasPrefix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldHasPrefix(FieldBookingID, v))
+}
+
+// BookingIDHasSuffix applies the HasSuffix predicate on the "booking_id" field.
+func BookingIDHasSuffix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldHasSuffix(FieldBookingID, v))
+}
+
+// BookingIDEqualFold applies the EqualFold predicate on the "booking_id" field.
+func BookingIDEqualFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEqualFold(FieldBookingID, v))
+}
+
+// BookingIDContainsFold applies the ContainsFold predicate on the "booking_id" field.
+func BookingIDContainsFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContainsFold(FieldBookingID, v))
+}
+
+// DiscountAmountEQ applies the EQ predicate on the "discount_amount" field.
+func DiscountAmountEQ(v int64) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountNEQ applies the NEQ predicate on the "discount_amount" field.
+func DiscountAmountNEQ(v int64) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountIn applies the In predicate on the "discount_amount" field.
+func DiscountAmountIn(vs ...int64) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountNotIn applies the NotIn predicate on the "discount_amount" field.
+func DiscountAmountNotIn(vs ...int64) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountGT applies the GT predicate on the "discount_amount" field.
+func DiscountAmountGT(v int64) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountGTE applies the GTE predicate on the "discount_amount" field.
+func DiscountAmountGTE(v int64) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLT applies the LT predicate on the "discount_amount" field.
+func DiscountAmountLT(v int64) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLTE applies the LTE predicate on the "discount_amount" field.
+func DiscountAmountLTE(v int64) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldDiscountAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPromoCode applies the HasEdge predicate on the "promo_code" edge.
+func HasPromoCode() predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PromoCodeTable, PromoCodeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPromoCodeWith applies the HasEdge predicate on the "promo_code" edge with a given conditions (other predicates).
+func HasPromoCodeWith(preds ...predicate.PromoCode) predicate.PromoRedemption {
+	return predicate.PromoRedemption(func(s *sql.Selector) {
+		step := newPromoCodeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PromoRedemption) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PromoRedemption) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PromoRedemption) predicate.PromoRedemption {
+	return predicate.PromoRedemption(sql.NotPredicates(p))
+}
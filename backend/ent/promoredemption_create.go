@@ -0,0 +1,362 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/slowtyper/poolie/backend/ent/promocode"
+	"github.com/slowtyper/poolie/backend/ent/promoredemption"
+)
+
+// PromoRedemptionCreate is the builder for creating a PromoRedemption entity.
+type PromoRedemptionCreate struct {
+	config
+	mutation *PromoRedemptionMutation
+	hooks    []Hook
+}
+
+// SetPromoCodeID sets the "promo_code_id" field.
+func (_c *PromoRedemptionCreate) SetPromoCodeID(v string) *PromoRedemptionCreate {
+	_c.mutation.SetPromoCodeID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PromoRedemptionCreate) SetUserID(v string) *PromoRedemptionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetBookingID sets the "booking_id" field.
+func (_c *PromoRedemptionCreate) SetBookingID(v string) *PromoRedemptionCreate {
+	_c.mutation.SetBookingID(v)
+	return _c
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_c *PromoRedemptionCreate) SetDiscountAmount(v int64) *PromoRedemptionCreate {
+	_c.mutation.SetDiscountAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *PromoRedemptionCreate) SetCurrency(v string) *PromoRedemptionCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableCurrency(v *string) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_c *PromoRedemptionCreate) SetReleasedAt(v time.Time) *PromoRedemptionCreate {
+	_c.mutation.SetReleasedAt(v)
+	return _c
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableReleasedAt(v *time.Time) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetReleasedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PromoRedemptionCreate) SetCreatedAt(v time.Time) *PromoRedemptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableCreatedAt(v *time.Time) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PromoRedemptionCreate) SetID(v string) *PromoRedemptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PromoRedemptionCreate) SetNillableID(v *string) *PromoRedemptionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPromoCode sets the "promo_code" edge to the PromoCode entity.
+func (_c *PromoRedemptionCreate) SetPromoCode(v *PromoCode) *PromoRedemptionCreate {
+	return _c.SetPromoCodeID(v.ID)
+}
+
+// Mutation returns the PromoRedemptionMutation object of the builder.
+func (_c *PromoRedemptionCreate) Mutation() *PromoRedemptionMutation {
+	return _c.mutation
+}
+
+// Save creates the PromoRedemption in the database.
+func (_c *PromoRedemptionCreate) Save(ctx context.Context) (*PromoRedemption, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PromoRedemptionCreate) SaveX(ctx context.Context) *PromoRedemption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PromoRedemptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PromoRedemptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PromoRedemptionCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := promoredemption.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := promoredemption.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := promoredemption.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PromoRedemptionCreate) check() error {
+	if _, ok := _c.mutation.PromoCodeID(); !ok {
+		return &ValidationError{Name: "promo_code_id", err: errors.New(`ent: missing required field "PromoRedemption.promo_code_id"`)}
+	}
+	if v, ok := _c.mutation.PromoCodeID(); ok {
+		if err := promoredemption.PromoCodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "promo_code_id", err: fmt.Errorf(`ent: validator failed for field "PromoRedemption.promo_code_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PromoRedemption.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := promoredemption.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PromoRedemption.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BookingID(); !ok {
+		return &ValidationError{Name: "booking_id", err: errors.New(`ent: missing required field "PromoRedemption.booking_id"`)}
+	}
+	if v, ok := _c.mutation.BookingID(); ok {
+		if err := promoredemption.BookingIDValidator(v); err != nil {
+			return &ValidationError{Name: "booking_id", err: fmt.Errorf(`ent: validator failed for field "PromoRedemption.booking_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`ent: missing required field "PromoRedemption.discount_amount"`)}
+	}
+	if v, ok := _c.mutation.DiscountAmount(); ok {
+		if err := promoredemption.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`ent: validator failed for field "PromoRedemption.discount_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "PromoRedemption.currency"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PromoRedemption.created_at"`)}
+	}
+	if len(_c.mutation.PromoCodeIDs()) == 0 {
+		return &ValidationError{Name: "promo_code", err: errors.New(`ent: missing required edge "PromoRedemption.promo_code"`)}
+	}
+	return nil
+}
+
+func (_c *PromoRedemptionCreate) sqlSave(ctx context.Context) (*PromoRedemption, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PromoRedemption.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PromoRedemptionCreate) createSpec() (*PromoRedemption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PromoRedemption{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(promoredemption.Table, sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(promoredemption.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.BookingID(); ok {
+		_spec.SetField(promoredemption.FieldBookingID, field.TypeString, value)
+		_node.BookingID = value
+	}
+	if value, ok := _c.mutation.DiscountAmount(); ok {
+		_spec.SetField(promoredemption.FieldDiscountAmount, field.TypeInt64, value)
+		_node.DiscountAmount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(promoredemption.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.ReleasedAt(); ok {
+		_spec.SetField(promoredemption.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(promoredemption.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.PromoCodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.PromoCodeTable,
+			Columns: []string{promoredemption.PromoCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PromoCodeID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PromoRedemptionCreateBulk is the builder for creating many PromoRedemption entities in bulk.
+type PromoRedemptionCreateBulk struct {
+	config
+	err      error
+	builders []*PromoRedemptionCreate
+}
+
+// Save creates the PromoRedemption entities in the database.
+func (_c *PromoRedemptionCreateBulk) Save(ctx context.Context) ([]*PromoRedemption, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PromoRedemption, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PromoRedemptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PromoRedemptionCreateBulk) SaveX(ctx context.Context) []*PromoRedemption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PromoRedemptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PromoRedemptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
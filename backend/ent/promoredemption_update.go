@@ -0,0 +1,555 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/slowtyper/poolie/backend/ent/predicate"
+	"github.com/slowtyper/poolie/backend/ent/promocode"
+	"github.com/slowtyper/poolie/backend/ent/promoredemption"
+)
+
+// PromoRedemptionUpdate is the builder for updating PromoRedemption entities.
+type PromoRedemptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PromoRedemptionMutation
+}
+
+// Where appends a list predicates to the PromoRedemptionUpdate builder.
+func (_u *PromoRedemptionUpdate) Where(ps ...predicate.PromoRedemption) *PromoRedemptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPromoCodeID sets the "promo_code_id" field.
+func (_u *PromoRedemptionUpdate) SetPromoCodeID(v string) *PromoRedemptionUpdate {
+	_u.mutation.SetPromoCodeID(v)
+	return _u
+}
+
+// SetNillablePromoCodeID sets the "promo_code_id" field if the given value is not nil.
+func (_u *PromoRedemptionUpdate) SetNillablePromoCodeID(v *string) *PromoRedemptionUpdate {
+	if v != nil {
+		_u.SetPromoCodeID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PromoRedemptionUpdate) SetUserID(v string) *PromoRedemptionUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PromoRedemptionUpdate) SetNillableUserID(v *string) *PromoRedemptionUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetBookingID sets the "booking_id" field.
+func (_u *PromoRedemptionUpdate) SetBookingID(v string) *PromoRedemptionUpdate {
+	_u.mutation.SetBookingID(v)
+	return _u
+}
+
+// SetNillableBookingID sets the "booking_id" field if the given value is not nil.
+func (_u *PromoRedemptionUpdate) SetNillableBookingID(v *string) *PromoRedemptionUpdate {
+	if v != nil {
+		_u.SetBookingID(*v)
+	}
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *PromoRedemptionUpdate) SetDiscountAmount(v int64) *PromoRedemptionUpdate {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *PromoRedemptionUpdate) SetNillableDiscountAmount(v *int64) *PromoRedemptionUpdate {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *PromoRedemptionUpdate) AddDiscountAmount(v int64) *PromoRedemptionUpdate {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PromoRedemptionUpdate) SetCurrency(v string) *PromoRedemptionUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PromoRedemptionUpdate) SetNillableCurrency(v *string) *PromoRedemptionUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_u *PromoRedemptionUpdate) SetReleasedAt(v time.Time) *PromoRedemptionUpdate {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *PromoRedemptionUpdate) SetNillableReleasedAt(v *time.Time) *PromoRedemptionUpdate {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *PromoRedemptionUpdate) ClearReleasedAt() *PromoRedemptionUpdate {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// SetPromoCode sets the "promo_code" edge to the PromoCode entity.
+func (_u *PromoRedemptionUpdate) SetPromoCode(v *PromoCode) *PromoRedemptionUpdate {
+	return _u.SetPromoCodeID(v.ID)
+}
+
+// Mutation returns the PromoRedemptionMutation object of the builder.
+func (_u *PromoRedemptionUpdate) Mutation() *PromoRedemptionMutation {
+	return _u.mutation
+}
+
+// ClearPromoCode clears the "promo_code" edge to the PromoCode entity.
+func (_u *PromoRedemptionUpdate) ClearPromoCode() *PromoRedemptionUpdate {
+	_u.mutation.ClearPromoCode()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PromoRedemptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PromoRedemptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PromoRedemptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PromoRedemptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PromoRedemptionUpdate) check() error {
+	if v, ok := _u.mutation.PromoCodeID(); ok {
+		if err := promoredemption.PromoCodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "promo_code_id", err: fmt.Errorf(`ent: validator failed for field "PromoRedemption.promo_code_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := promoredemption.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PromoRedemption.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BookingID(); ok {
+		if err := promoredemption.BookingIDValidator(v); err != nil {
+			return &ValidationError{Name: "booking_id", err: fmt.Errorf(`ent: validator failed for field "PromoRedemption.booking_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountAmount(); ok {
+		if err := promoredemption.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`ent: validator failed for field "PromoRedemption.discount_amount": %w`, err)}
+		}
+	}
+	if _u.mutation.PromoCodeCleared() && len(_u.mutation.PromoCodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PromoRedemption.promo_code"`)
+	}
+	return nil
+}
+
+func (_u *PromoRedemptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promoredemption.Table, promoredemption.Columns, sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(promoredemption.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BookingID(); ok {
+		_spec.SetField(promoredemption.FieldBookingID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(promoredemption.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(promoredemption.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(promoredemption.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(promoredemption.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(promoredemption.FieldReleasedAt, field.TypeTime)
+	}
+	if _u.mutation.PromoCodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.PromoCodeTable,
+			Columns: []string{promoredemption.PromoCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PromoCodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.PromoCodeTable,
+			Columns: []string{promoredemption.PromoCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promoredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PromoRedemptionUpdateOne is the builder for updating a single PromoRedemption entity.
+type PromoRedemptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PromoRedemptionMutation
+}
+
+// SetPromoCodeID sets the "promo_code_id" field.
+func (_u *PromoRedemptionUpdateOne) SetPromoCodeID(v string) *PromoRedemptionUpdateOne {
+	_u.mutation.SetPromoCodeID(v)
+	return _u
+}
+
+// SetNillablePromoCodeID sets the "promo_code_id" field if the given value is not nil.
+func (_u *PromoRedemptionUpdateOne) SetNillablePromoCodeID(v *string) *PromoRedemptionUpdateOne {
+	if v != nil {
+		_u.SetPromoCodeID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PromoRedemptionUpdateOne) SetUserID(v string) *PromoRedemptionUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PromoRedemptionUpdateOne) SetNillableUserID(v *string) *PromoRedemptionUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetBookingID sets the "booking_id" field.
+func (_u *PromoRedemptionUpdateOne) SetBookingID(v string) *PromoRedemptionUpdateOne {
+	_u.mutation.SetBookingID(v)
+	return _u
+}
+
+// SetNillableBookingID sets the "booking_id" field if the given value is not nil.
+func (_u *PromoRedemptionUpdateOne) SetNillableBookingID(v *string) *PromoRedemptionUpdateOne {
+	if v != nil {
+		_u.SetBookingID(*v)
+	}
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *PromoRedemptionUpdateOne) SetDiscountAmount(v int64) *PromoRedemptionUpdateOne {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *PromoRedemptionUpdateOne) SetNillableDiscountAmount(v *int64) *PromoRedemptionUpdateOne {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *PromoRedemptionUpdateOne) AddDiscountAmount(v int64) *PromoRedemptionUpdateOne {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PromoRedemptionUpdateOne) SetCurrency(v string) *PromoRedemptionUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PromoRedemptionUpdateOne) SetNillableCurrency(v *string) *PromoRedemptionUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_u *PromoRedemptionUpdateOne) SetReleasedAt(v time.Time) *PromoRedemptionUpdateOne {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *PromoRedemptionUpdateOne) SetNillableReleasedAt(v *time.Time) *PromoRedemptionUpdateOne {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *PromoRedemptionUpdateOne) ClearReleasedAt() *PromoRedemptionUpdateOne {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// SetPromoCode sets the "promo_code" edge to the PromoCode entity.
+func (_u *PromoRedemptionUpdateOne) SetPromoCode(v *PromoCode) *PromoRedemptionUpdateOne {
+	return _u.SetPromoCodeID(v.ID)
+}
+
+// Mutation returns the PromoRedemptionMutation object of the builder.
+func (_u *PromoRedemptionUpdateOne) Mutation() *PromoRedemptionMutation {
+	return _u.mutation
+}
+
+// ClearPromoCode clears the "promo_code" edge to the PromoCode entity.
+func (_u *PromoRedemptionUpdateOne) ClearPromoCode() *PromoRedemptionUpdateOne {
+	_u.mutation.ClearPromoCode()
+	return _u
+}
+
+// Where appends a list predicates to the PromoRedemptionUpdate builder.
+func (_u *PromoRedemptionUpdateOne) Where(ps ...predicate.PromoRedemption) *PromoRedemptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PromoRedemptionUpdateOne) Select(field string, fields ...string) *PromoRedemptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PromoRedemption entity.
+func (_u *PromoRedemptionUpdateOne) Save(ctx context.Context) (*PromoRedemption, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PromoRedemptionUpdateOne) SaveX(ctx context.Context) *PromoRedemption {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PromoRedemptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PromoRedemptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PromoRedemptionUpdateOne) check() error {
+	if v, ok := _u.mutation.PromoCodeID(); ok {
+		if err := promoredemption.PromoCodeIDValidator(v); err != nil {
+			return &ValidationError{Name: "promo_code_id", err: fmt.Errorf(`ent: validator failed for field "PromoRedemption.promo_code_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := promoredemption.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PromoRedemption.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BookingID(); ok {
+		if err := promoredemption.BookingIDValidator(v); err != nil {
+			return &ValidationError{Name: "booking_id", err: fmt.Errorf(`ent: validator failed for field "PromoRedemption.booking_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountAmount(); ok {
+		if err := promoredemption.DiscountAmountValidator(v); err != nil {
+			return &ValidationError{Name: "discount_amount", err: fmt.Errorf(`ent: validator failed for field "PromoRedemption.discount_amount": %w`, err)}
+		}
+	}
+	if _u.mutation.PromoCodeCleared() && len(_u.mutation.PromoCodeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PromoRedemption.promo_code"`)
+	}
+	return nil
+}
+
+func (_u *PromoRedemptionUpdateOne) sqlSave(ctx context.Context) (_node *PromoRedemption, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(promoredemption.Table, promoredemption.Columns, sqlgraph.NewFieldSpec(promoredemption.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PromoRedemption.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, promoredemption.FieldID)
+		for _, f := range fields {
+			if !promoredemption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != promoredemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(promoredemption.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BookingID(); ok {
+		_spec.SetField(promoredemption.FieldBookingID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(promoredemption.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(promoredemption.FieldDiscountAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(promoredemption.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(promoredemption.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(promoredemption.FieldReleasedAt, field.TypeTime)
+	}
+	if _u.mutation.PromoCodeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.PromoCodeTable,
+			Columns: []string{promoredemption.PromoCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PromoCodeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   promoredemption.PromoCodeTable,
+			Columns: []string{promoredemption.PromoCodeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(promocode.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PromoRedemption{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{promoredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
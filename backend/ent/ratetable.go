@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/slowtyper/poolie/backend/ent/ratetable"
+)
+
+// RateTable is the model entity for the RateTable schema.
+type RateTable struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Base holds the value of the "base" field.
+	Base string `json:"base,omitempty"`
+	// Rates holds the value of the "rates" field.
+	Rates map[string]string `json:"rates,omitempty"`
+	// AsOf holds the value of the "as_of" field.
+	AsOf time.Time `json:"as_of,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RateTable) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ratetable.FieldRates:
+			values[i] = new([]byte)
+		case ratetable.FieldID, ratetable.FieldBase:
+			values[i] = new(sql.NullString)
+		case ratetable.FieldAsOf, ratetable.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RateTable fields.
+func (_m *RateTable) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ratetable.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case ratetable.FieldBase:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field base", values[i])
+			} else if value.Valid {
+				_m.Base = value.String
+			}
+		case ratetable.FieldRates:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rates", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Rates); err != nil {
+					return fmt.Errorf("unmarshal field rates: %w", err)
+				}
+			}
+		case ratetable.FieldAsOf:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field as_of", values[i])
+			} else if value.Valid {
+				_m.AsOf = value.Time
+			}
+		case ratetable.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RateTable.
+// This includes values selected through modifiers, order, etc.
+func (_m *RateTable) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RateTable.
+// Note that you need to call RateTable.Unwrap() before calling this method if this RateTable
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RateTable) Update() *RateTableUpdateOne {
+	return NewRateTableClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RateTable entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RateTable) Unwrap() *RateTable {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RateTable is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RateTable) String() string {
+	var builder strings.Builder
+	builder.WriteString("RateTable(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("base=")
+	builder.WriteString(_m.Base)
+	builder.WriteString(", ")
+	builder.WriteString("rates=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rates))
+	builder.WriteString(", ")
+	builder.WriteString("as_of=")
+	builder.WriteString(_m.AsOf.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RateTables is a parsable slice of RateTable.
+type RateTables []*RateTable
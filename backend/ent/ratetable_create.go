@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/slowtyper/poolie/backend/ent/ratetable"
+)
+
+// RateTableCreate is the builder for creating a RateTable entity.
+type RateTableCreate struct {
+	config
+	mutation *RateTableMutation
+	hooks    []Hook
+}
+
+// SetBase sets the "base" field.
+func (_c *RateTableCreate) SetBase(v string) *RateTableCreate {
+	_c.mutation.SetBase(v)
+	return _c
+}
+
+// SetRates sets the "rates" field.
+func (_c *RateTableCreate) SetRates(v map[string]string) *RateTableCreate {
+	_c.mutation.SetRates(v)
+	return _c
+}
+
+// SetAsOf sets the "as_of" field.
+func (_c *RateTableCreate) SetAsOf(v time.Time) *RateTableCreate {
+	_c.mutation.SetAsOf(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RateTableCreate) SetCreatedAt(v time.Time) *RateTableCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RateTableCreate) SetNillableCreatedAt(v *time.Time) *RateTableCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RateTableCreate) SetID(v string) *RateTableCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RateTableCreate) SetNillableID(v *string) *RateTableCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RateTableMutation object of the builder.
+func (_c *RateTableCreate) Mutation() *RateTableMutation {
+	return _c.mutation
+}
+
+// Save creates the RateTable in the database.
+func (_c *RateTableCreate) Save(ctx context.Context) (*RateTable, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RateTableCreate) SaveX(ctx context.Context) *RateTable {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RateTableCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RateTableCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RateTableCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ratetable.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := ratetable.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RateTableCreate) check() error {
+	if _, ok := _c.mutation.Base(); !ok {
+		return &ValidationError{Name: "base", err: errors.New(`ent: missing required field "RateTable.base"`)}
+	}
+	if v, ok := _c.mutation.Base(); ok {
+		if err := ratetable.BaseValidator(v); err != nil {
+			return &ValidationError{Name: "base", err: fmt.Errorf(`ent: validator failed for field "RateTable.base": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Rates(); !ok {
+		return &ValidationError{Name: "rates", err: errors.New(`ent: missing required field "RateTable.rates"`)}
+	}
+	if _, ok := _c.mutation.AsOf(); !ok {
+		return &ValidationError{Name: "as_of", err: errors.New(`ent: missing required field "RateTable.as_of"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RateTable.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *RateTableCreate) sqlSave(ctx context.Context) (*RateTable, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RateTable.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RateTableCreate) createSpec() (*RateTable, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RateTable{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ratetable.Table, sqlgraph.NewFieldSpec(ratetable.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Base(); ok {
+		_spec.SetField(ratetable.FieldBase, field.TypeString, value)
+		_node.Base = value
+	}
+	if value, ok := _c.mutation.Rates(); ok {
+		_spec.SetField(ratetable.FieldRates, field.TypeJSON, value)
+		_node.Rates = value
+	}
+	if value, ok := _c.mutation.AsOf(); ok {
+		_spec.SetField(ratetable.FieldAsOf, field.TypeTime, value)
+		_node.AsOf = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ratetable.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// RateTableCreateBulk is the builder for creating many RateTable entities in bulk.
+type RateTableCreateBulk struct {
+	config
+	err      error
+	builders []*RateTableCreate
+}
+
+// Save creates the RateTable entities in the database.
+func (_c *RateTableCreateBulk) Save(ctx context.Context) ([]*RateTable, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RateTable, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RateTableMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RateTableCreateBulk) SaveX(ctx context.Context) []*RateTable {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RateTableCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RateTableCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
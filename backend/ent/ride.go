@@ -89,6 +89,8 @@ type RideEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [3]bool
+
+	namedBookings map[string][]*Booking
 }
 
 // DriverOrErr returns the Driver value or an error if the edge
@@ -458,5 +460,29 @@ func (_m *Ride) String() string {
 	return builder.String()
 }
 
+// NamedBookings returns the Bookings named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Ride) NamedBookings(name string) ([]*Booking, error) {
+	if _m.Edges.namedBookings == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedBookings[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Ride) appendNamedBookings(name string, edges ...*Booking) {
+	if _m.Edges.namedBookings == nil {
+		_m.Edges.namedBookings = make(map[string][]*Booking)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedBookings[name] = []*Booking{}
+	} else {
+		_m.Edges.namedBookings[name] = append(_m.Edges.namedBookings[name], edges...)
+	}
+}
+
 // Rides is a parsable slice of Ride.
 type Rides []*Ride
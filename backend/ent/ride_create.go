@@ -297,6 +297,14 @@ func (_c *RideCreate) SetID(v string) *RideCreate {
 	return _c
 }
 
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RideCreate) SetNillableID(v *string) *RideCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
 // SetDriver sets the "driver" edge to the User entity.
 func (_c *RideCreate) SetDriver(v *User) *RideCreate {
 	return _c.SetDriverID(v.ID)
@@ -389,6 +397,10 @@ func (_c *RideCreate) defaults() {
 		v := ride.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := ride.DefaultID()
+		_c.mutation.SetID(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -22,13 +22,16 @@ import (
 // RideQuery is the builder for querying Ride entities.
 type RideQuery struct {
 	config
-	ctx          *QueryContext
-	order        []ride.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Ride
-	withDriver   *UserQuery
-	withVehicle  *VehicleQuery
-	withBookings *BookingQuery
+	ctx               *QueryContext
+	order             []ride.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Ride
+	withDriver        *UserQuery
+	withVehicle       *VehicleQuery
+	withBookings      *BookingQuery
+	modifiers         []func(*sql.Selector)
+	loadTotal         []func(context.Context, []*Ride) error
+	withNamedBookings map[string]*BookingQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -458,6 +461,9 @@ func (_q *RideQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ride, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -486,6 +492,18 @@ func (_q *RideQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ride, e
 			return nil, err
 		}
 	}
+	for name, query := range _q.withNamedBookings {
+		if err := _q.loadBookings(ctx, query, nodes,
+			func(n *Ride) { n.appendNamedBookings(name) },
+			func(n *Ride, e *Booking) { n.appendNamedBookings(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range _q.loadTotal {
+		if err := _q.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -580,6 +598,9 @@ func (_q *RideQuery) loadBookings(ctx context.Context, query *BookingQuery, node
 
 func (_q *RideQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -665,6 +686,20 @@ func (_q *RideQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedBookings tells the query-builder to eager-load the nodes that are connected to the "bookings"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *RideQuery) WithNamedBookings(name string, opts ...func(*BookingQuery)) *RideQuery {
+	query := (&BookingClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedBookings == nil {
+		_q.withNamedBookings = make(map[string]*BookingQuery)
+	}
+	_q.withNamedBookings[name] = query
+	return _q
+}
+
 // RideGroupBy is the group-by builder for Ride entities.
 type RideGroupBy struct {
 	selector
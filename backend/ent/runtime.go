@@ -6,6 +6,15 @@ import (
 	"time"
 
 	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/credit"
+	"github.com/slowtyper/poolie/backend/ent/idempotencykey"
+	"github.com/slowtyper/poolie/backend/ent/ledgerentry"
+	"github.com/slowtyper/poolie/backend/ent/payment"
+	"github.com/slowtyper/poolie/backend/ent/paymentevent"
+	"github.com/slowtyper/poolie/backend/ent/payout"
+	"github.com/slowtyper/poolie/backend/ent/promocode"
+	"github.com/slowtyper/poolie/backend/ent/promoredemption"
+	"github.com/slowtyper/poolie/backend/ent/ratetable"
 	"github.com/slowtyper/poolie/backend/ent/ride"
 	"github.com/slowtyper/poolie/backend/ent/schema"
 	"github.com/slowtyper/poolie/backend/ent/user"
@@ -34,24 +43,342 @@ func init() {
 	bookingDescPassengerCount := bookingFields[4].Descriptor()
 	// booking.PassengerCountValidator is a validator for the "passenger_count" field. It is called by the builders before save.
 	booking.PassengerCountValidator = bookingDescPassengerCount.Validators[0].(func(int) error)
+	// bookingDescSubtotalAmount is the schema descriptor for subtotal_amount field.
+	bookingDescSubtotalAmount := bookingFields[5].Descriptor()
+	// booking.DefaultSubtotalAmount holds the default value on creation for the subtotal_amount field.
+	booking.DefaultSubtotalAmount = bookingDescSubtotalAmount.Default.(int64)
+	// booking.SubtotalAmountValidator is a validator for the "subtotal_amount" field. It is called by the builders before save.
+	booking.SubtotalAmountValidator = bookingDescSubtotalAmount.Validators[0].(func(int64) error)
+	// bookingDescDiscountAmount is the schema descriptor for discount_amount field.
+	bookingDescDiscountAmount := bookingFields[7].Descriptor()
+	// booking.DefaultDiscountAmount holds the default value on creation for the discount_amount field.
+	booking.DefaultDiscountAmount = bookingDescDiscountAmount.Default.(int64)
+	// booking.DiscountAmountValidator is a validator for the "discount_amount" field. It is called by the builders before save.
+	booking.DiscountAmountValidator = bookingDescDiscountAmount.Validators[0].(func(int64) error)
+	// bookingDescCreditAmount is the schema descriptor for credit_amount field.
+	bookingDescCreditAmount := bookingFields[8].Descriptor()
+	// booking.DefaultCreditAmount holds the default value on creation for the credit_amount field.
+	booking.DefaultCreditAmount = bookingDescCreditAmount.Default.(int64)
+	// booking.CreditAmountValidator is a validator for the "credit_amount" field. It is called by the builders before save.
+	booking.CreditAmountValidator = bookingDescCreditAmount.Validators[0].(func(int64) error)
 	// bookingDescTotalPriceAmount is the schema descriptor for total_price_amount field.
-	bookingDescTotalPriceAmount := bookingFields[5].Descriptor()
+	bookingDescTotalPriceAmount := bookingFields[9].Descriptor()
 	// booking.TotalPriceAmountValidator is a validator for the "total_price_amount" field. It is called by the builders before save.
 	booking.TotalPriceAmountValidator = bookingDescTotalPriceAmount.Validators[0].(func(int64) error)
 	// bookingDescTotalPriceCurrency is the schema descriptor for total_price_currency field.
-	bookingDescTotalPriceCurrency := bookingFields[6].Descriptor()
+	bookingDescTotalPriceCurrency := bookingFields[10].Descriptor()
 	// booking.DefaultTotalPriceCurrency holds the default value on creation for the total_price_currency field.
 	booking.DefaultTotalPriceCurrency = bookingDescTotalPriceCurrency.Default.(string)
 	// bookingDescCreatedAt is the schema descriptor for created_at field.
-	bookingDescCreatedAt := bookingFields[9].Descriptor()
+	bookingDescCreatedAt := bookingFields[17].Descriptor()
 	// booking.DefaultCreatedAt holds the default value on creation for the created_at field.
 	booking.DefaultCreatedAt = bookingDescCreatedAt.Default.(func() time.Time)
+	// bookingDescRefundAmount is the schema descriptor for refund_amount field.
+	bookingDescRefundAmount := bookingFields[22].Descriptor()
+	// booking.DefaultRefundAmount holds the default value on creation for the refund_amount field.
+	booking.DefaultRefundAmount = bookingDescRefundAmount.Default.(int64)
+	// booking.RefundAmountValidator is a validator for the "refund_amount" field. It is called by the builders before save.
+	booking.RefundAmountValidator = bookingDescRefundAmount.Validators[0].(func(int64) error)
+	// bookingDescPenaltyAmount is the schema descriptor for penalty_amount field.
+	bookingDescPenaltyAmount := bookingFields[23].Descriptor()
+	// booking.DefaultPenaltyAmount holds the default value on creation for the penalty_amount field.
+	booking.DefaultPenaltyAmount = bookingDescPenaltyAmount.Default.(int64)
+	// booking.PenaltyAmountValidator is a validator for the "penalty_amount" field. It is called by the builders before save.
+	booking.PenaltyAmountValidator = bookingDescPenaltyAmount.Validators[0].(func(int64) error)
 	// bookingDescUpdatedAt is the schema descriptor for updated_at field.
-	bookingDescUpdatedAt := bookingFields[11].Descriptor()
+	bookingDescUpdatedAt := bookingFields[24].Descriptor()
 	// booking.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	booking.DefaultUpdatedAt = bookingDescUpdatedAt.Default.(func() time.Time)
 	// booking.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	booking.UpdateDefaultUpdatedAt = bookingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// bookingDescID is the schema descriptor for id field.
+	bookingDescID := bookingFields[0].Descriptor()
+	// booking.DefaultID holds the default value on creation for the id field.
+	booking.DefaultID = bookingDescID.Default.(func() string)
+	creditFields := schema.Credit{}.Fields()
+	_ = creditFields
+	// creditDescUserID is the schema descriptor for user_id field.
+	creditDescUserID := creditFields[1].Descriptor()
+	// credit.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	credit.UserIDValidator = creditDescUserID.Validators[0].(func(string) error)
+	// creditDescKind is the schema descriptor for kind field.
+	creditDescKind := creditFields[2].Descriptor()
+	// credit.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	credit.KindValidator = creditDescKind.Validators[0].(func(string) error)
+	// creditDescCurrency is the schema descriptor for currency field.
+	creditDescCurrency := creditFields[4].Descriptor()
+	// credit.DefaultCurrency holds the default value on creation for the currency field.
+	credit.DefaultCurrency = creditDescCurrency.Default.(string)
+	// creditDescCreatedAt is the schema descriptor for created_at field.
+	creditDescCreatedAt := creditFields[7].Descriptor()
+	// credit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	credit.DefaultCreatedAt = creditDescCreatedAt.Default.(func() time.Time)
+	// creditDescID is the schema descriptor for id field.
+	creditDescID := creditFields[0].Descriptor()
+	// credit.DefaultID holds the default value on creation for the id field.
+	credit.DefaultID = creditDescID.Default.(func() string)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescUserID is the schema descriptor for user_id field.
+	idempotencykeyDescUserID := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	idempotencykey.UserIDValidator = idempotencykeyDescUserID.Validators[0].(func(string) error)
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = func() func(string) error {
+		validators := idempotencykeyDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// idempotencykeyDescRequestHash is the schema descriptor for request_hash field.
+	idempotencykeyDescRequestHash := idempotencykeyFields[3].Descriptor()
+	// idempotencykey.RequestHashValidator is a validator for the "request_hash" field. It is called by the builders before save.
+	idempotencykey.RequestHashValidator = idempotencykeyDescRequestHash.Validators[0].(func(string) error)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[7].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() string)
+	ledgerentryFields := schema.LedgerEntry{}.Fields()
+	_ = ledgerentryFields
+	// ledgerentryDescTransactionID is the schema descriptor for transaction_id field.
+	ledgerentryDescTransactionID := ledgerentryFields[1].Descriptor()
+	// ledgerentry.TransactionIDValidator is a validator for the "transaction_id" field. It is called by the builders before save.
+	ledgerentry.TransactionIDValidator = ledgerentryDescTransactionID.Validators[0].(func(string) error)
+	// ledgerentryDescKind is the schema descriptor for kind field.
+	ledgerentryDescKind := ledgerentryFields[2].Descriptor()
+	// ledgerentry.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	ledgerentry.KindValidator = ledgerentryDescKind.Validators[0].(func(string) error)
+	// ledgerentryDescAccount is the schema descriptor for account field.
+	ledgerentryDescAccount := ledgerentryFields[3].Descriptor()
+	// ledgerentry.AccountValidator is a validator for the "account" field. It is called by the builders before save.
+	ledgerentry.AccountValidator = ledgerentryDescAccount.Validators[0].(func(string) error)
+	// ledgerentryDescCurrency is the schema descriptor for currency field.
+	ledgerentryDescCurrency := ledgerentryFields[8].Descriptor()
+	// ledgerentry.DefaultCurrency holds the default value on creation for the currency field.
+	ledgerentry.DefaultCurrency = ledgerentryDescCurrency.Default.(string)
+	// ledgerentryDescCreatedAt is the schema descriptor for created_at field.
+	ledgerentryDescCreatedAt := ledgerentryFields[9].Descriptor()
+	// ledgerentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ledgerentry.DefaultCreatedAt = ledgerentryDescCreatedAt.Default.(func() time.Time)
+	// ledgerentryDescID is the schema descriptor for id field.
+	ledgerentryDescID := ledgerentryFields[0].Descriptor()
+	// ledgerentry.DefaultID holds the default value on creation for the id field.
+	ledgerentry.DefaultID = ledgerentryDescID.Default.(func() string)
+	paymentFields := schema.Payment{}.Fields()
+	_ = paymentFields
+	// paymentDescBookingID is the schema descriptor for booking_id field.
+	paymentDescBookingID := paymentFields[1].Descriptor()
+	// payment.BookingIDValidator is a validator for the "booking_id" field. It is called by the builders before save.
+	payment.BookingIDValidator = paymentDescBookingID.Validators[0].(func(string) error)
+	// paymentDescProvider is the schema descriptor for provider field.
+	paymentDescProvider := paymentFields[2].Descriptor()
+	// payment.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	payment.ProviderValidator = paymentDescProvider.Validators[0].(func(string) error)
+	// paymentDescAmount is the schema descriptor for amount field.
+	paymentDescAmount := paymentFields[4].Descriptor()
+	// payment.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	payment.AmountValidator = paymentDescAmount.Validators[0].(func(int64) error)
+	// paymentDescCurrency is the schema descriptor for currency field.
+	paymentDescCurrency := paymentFields[5].Descriptor()
+	// payment.DefaultCurrency holds the default value on creation for the currency field.
+	payment.DefaultCurrency = paymentDescCurrency.Default.(string)
+	// paymentDescRefundedAmount is the schema descriptor for refunded_amount field.
+	paymentDescRefundedAmount := paymentFields[6].Descriptor()
+	// payment.DefaultRefundedAmount holds the default value on creation for the refunded_amount field.
+	payment.DefaultRefundedAmount = paymentDescRefundedAmount.Default.(int64)
+	// payment.RefundedAmountValidator is a validator for the "refunded_amount" field. It is called by the builders before save.
+	payment.RefundedAmountValidator = paymentDescRefundedAmount.Validators[0].(func(int64) error)
+	// paymentDescStatus is the schema descriptor for status field.
+	paymentDescStatus := paymentFields[7].Descriptor()
+	// payment.DefaultStatus holds the default value on creation for the status field.
+	payment.DefaultStatus = paymentDescStatus.Default.(string)
+	// paymentDescCreatedAt is the schema descriptor for created_at field.
+	paymentDescCreatedAt := paymentFields[10].Descriptor()
+	// payment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payment.DefaultCreatedAt = paymentDescCreatedAt.Default.(func() time.Time)
+	// paymentDescUpdatedAt is the schema descriptor for updated_at field.
+	paymentDescUpdatedAt := paymentFields[11].Descriptor()
+	// payment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	payment.DefaultUpdatedAt = paymentDescUpdatedAt.Default.(func() time.Time)
+	// payment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	payment.UpdateDefaultUpdatedAt = paymentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// paymentDescID is the schema descriptor for id field.
+	paymentDescID := paymentFields[0].Descriptor()
+	// payment.DefaultID holds the default value on creation for the id field.
+	payment.DefaultID = paymentDescID.Default.(func() string)
+	paymenteventFields := schema.PaymentEvent{}.Fields()
+	_ = paymenteventFields
+	// paymenteventDescProvider is the schema descriptor for provider field.
+	paymenteventDescProvider := paymenteventFields[1].Descriptor()
+	// paymentevent.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	paymentevent.ProviderValidator = paymenteventDescProvider.Validators[0].(func(string) error)
+	// paymenteventDescType is the schema descriptor for type field.
+	paymenteventDescType := paymenteventFields[2].Descriptor()
+	// paymentevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	paymentevent.TypeValidator = paymenteventDescType.Validators[0].(func(string) error)
+	// paymenteventDescProviderIntentID is the schema descriptor for provider_intent_id field.
+	paymenteventDescProviderIntentID := paymenteventFields[3].Descriptor()
+	// paymentevent.ProviderIntentIDValidator is a validator for the "provider_intent_id" field. It is called by the builders before save.
+	paymentevent.ProviderIntentIDValidator = paymenteventDescProviderIntentID.Validators[0].(func(string) error)
+	// paymenteventDescProcessedAt is the schema descriptor for processed_at field.
+	paymenteventDescProcessedAt := paymenteventFields[4].Descriptor()
+	// paymentevent.DefaultProcessedAt holds the default value on creation for the processed_at field.
+	paymentevent.DefaultProcessedAt = paymenteventDescProcessedAt.Default.(func() time.Time)
+	payoutFields := schema.Payout{}.Fields()
+	_ = payoutFields
+	// payoutDescDriverID is the schema descriptor for driver_id field.
+	payoutDescDriverID := payoutFields[1].Descriptor()
+	// payout.DriverIDValidator is a validator for the "driver_id" field. It is called by the builders before save.
+	payout.DriverIDValidator = payoutDescDriverID.Validators[0].(func(string) error)
+	// payoutDescAmount is the schema descriptor for amount field.
+	payoutDescAmount := payoutFields[2].Descriptor()
+	// payout.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	payout.AmountValidator = payoutDescAmount.Validators[0].(func(int64) error)
+	// payoutDescCurrency is the schema descriptor for currency field.
+	payoutDescCurrency := payoutFields[3].Descriptor()
+	// payout.DefaultCurrency holds the default value on creation for the currency field.
+	payout.DefaultCurrency = payoutDescCurrency.Default.(string)
+	// payoutDescStatus is the schema descriptor for status field.
+	payoutDescStatus := payoutFields[4].Descriptor()
+	// payout.DefaultStatus holds the default value on creation for the status field.
+	payout.DefaultStatus = payoutDescStatus.Default.(string)
+	// payoutDescProvider is the schema descriptor for provider field.
+	payoutDescProvider := payoutFields[5].Descriptor()
+	// payout.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	payout.ProviderValidator = payoutDescProvider.Validators[0].(func(string) error)
+	// payoutDescCreatedAt is the schema descriptor for created_at field.
+	payoutDescCreatedAt := payoutFields[8].Descriptor()
+	// payout.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payout.DefaultCreatedAt = payoutDescCreatedAt.Default.(func() time.Time)
+	// payoutDescUpdatedAt is the schema descriptor for updated_at field.
+	payoutDescUpdatedAt := payoutFields[9].Descriptor()
+	// payout.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	payout.DefaultUpdatedAt = payoutDescUpdatedAt.Default.(func() time.Time)
+	// payout.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	payout.UpdateDefaultUpdatedAt = payoutDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// payoutDescID is the schema descriptor for id field.
+	payoutDescID := payoutFields[0].Descriptor()
+	// payout.DefaultID holds the default value on creation for the id field.
+	payout.DefaultID = payoutDescID.Default.(func() string)
+	promocodeFields := schema.PromoCode{}.Fields()
+	_ = promocodeFields
+	// promocodeDescCode is the schema descriptor for code field.
+	promocodeDescCode := promocodeFields[1].Descriptor()
+	// promocode.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	promocode.CodeValidator = promocodeDescCode.Validators[0].(func(string) error)
+	// promocodeDescDiscountType is the schema descriptor for discount_type field.
+	promocodeDescDiscountType := promocodeFields[3].Descriptor()
+	// promocode.DefaultDiscountType holds the default value on creation for the discount_type field.
+	promocode.DefaultDiscountType = promocodeDescDiscountType.Default.(string)
+	// promocodeDescDiscountValue is the schema descriptor for discount_value field.
+	promocodeDescDiscountValue := promocodeFields[4].Descriptor()
+	// promocode.DiscountValueValidator is a validator for the "discount_value" field. It is called by the builders before save.
+	promocode.DiscountValueValidator = promocodeDescDiscountValue.Validators[0].(func(int64) error)
+	// promocodeDescMaxDiscount is the schema descriptor for max_discount field.
+	promocodeDescMaxDiscount := promocodeFields[5].Descriptor()
+	// promocode.MaxDiscountValidator is a validator for the "max_discount" field. It is called by the builders before save.
+	promocode.MaxDiscountValidator = promocodeDescMaxDiscount.Validators[0].(func(int64) error)
+	// promocodeDescCurrency is the schema descriptor for currency field.
+	promocodeDescCurrency := promocodeFields[6].Descriptor()
+	// promocode.DefaultCurrency holds the default value on creation for the currency field.
+	promocode.DefaultCurrency = promocodeDescCurrency.Default.(string)
+	// promocodeDescMinSpend is the schema descriptor for min_spend field.
+	promocodeDescMinSpend := promocodeFields[7].Descriptor()
+	// promocode.DefaultMinSpend holds the default value on creation for the min_spend field.
+	promocode.DefaultMinSpend = promocodeDescMinSpend.Default.(int64)
+	// promocode.MinSpendValidator is a validator for the "min_spend" field. It is called by the builders before save.
+	promocode.MinSpendValidator = promocodeDescMinSpend.Validators[0].(func(int64) error)
+	// promocodeDescMaxUses is the schema descriptor for max_uses field.
+	promocodeDescMaxUses := promocodeFields[8].Descriptor()
+	// promocode.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	promocode.MaxUsesValidator = promocodeDescMaxUses.Validators[0].(func(int) error)
+	// promocodeDescMaxUsesPerUser is the schema descriptor for max_uses_per_user field.
+	promocodeDescMaxUsesPerUser := promocodeFields[9].Descriptor()
+	// promocode.MaxUsesPerUserValidator is a validator for the "max_uses_per_user" field. It is called by the builders before save.
+	promocode.MaxUsesPerUserValidator = promocodeDescMaxUsesPerUser.Validators[0].(func(int) error)
+	// promocodeDescUsedCount is the schema descriptor for used_count field.
+	promocodeDescUsedCount := promocodeFields[10].Descriptor()
+	// promocode.DefaultUsedCount holds the default value on creation for the used_count field.
+	promocode.DefaultUsedCount = promocodeDescUsedCount.Default.(int)
+	// promocode.UsedCountValidator is a validator for the "used_count" field. It is called by the builders before save.
+	promocode.UsedCountValidator = promocodeDescUsedCount.Validators[0].(func(int) error)
+	// promocodeDescActive is the schema descriptor for active field.
+	promocodeDescActive := promocodeFields[16].Descriptor()
+	// promocode.DefaultActive holds the default value on creation for the active field.
+	promocode.DefaultActive = promocodeDescActive.Default.(bool)
+	// promocodeDescCreatedAt is the schema descriptor for created_at field.
+	promocodeDescCreatedAt := promocodeFields[17].Descriptor()
+	// promocode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	promocode.DefaultCreatedAt = promocodeDescCreatedAt.Default.(func() time.Time)
+	// promocodeDescUpdatedAt is the schema descriptor for updated_at field.
+	promocodeDescUpdatedAt := promocodeFields[18].Descriptor()
+	// promocode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	promocode.DefaultUpdatedAt = promocodeDescUpdatedAt.Default.(func() time.Time)
+	// promocode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	promocode.UpdateDefaultUpdatedAt = promocodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// promocodeDescID is the schema descriptor for id field.
+	promocodeDescID := promocodeFields[0].Descriptor()
+	// promocode.DefaultID holds the default value on creation for the id field.
+	promocode.DefaultID = promocodeDescID.Default.(func() string)
+	promoredemptionFields := schema.PromoRedemption{}.Fields()
+	_ = promoredemptionFields
+	// promoredemptionDescPromoCodeID is the schema descriptor for promo_code_id field.
+	promoredemptionDescPromoCodeID := promoredemptionFields[1].Descriptor()
+	// promoredemption.PromoCodeIDValidator is a validator for the "promo_code_id" field. It is called by the builders before save.
+	promoredemption.PromoCodeIDValidator = promoredemptionDescPromoCodeID.Validators[0].(func(string) error)
+	// promoredemptionDescUserID is the schema descriptor for user_id field.
+	promoredemptionDescUserID := promoredemptionFields[2].Descriptor()
+	// promoredemption.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	promoredemption.UserIDValidator = promoredemptionDescUserID.Validators[0].(func(string) error)
+	// promoredemptionDescBookingID is the schema descriptor for booking_id field.
+	promoredemptionDescBookingID := promoredemptionFields[3].Descriptor()
+	// promoredemption.BookingIDValidator is a validator for the "booking_id" field. It is called by the builders before save.
+	promoredemption.BookingIDValidator = promoredemptionDescBookingID.Validators[0].(func(string) error)
+	// promoredemptionDescDiscountAmount is the schema descriptor for discount_amount field.
+	promoredemptionDescDiscountAmount := promoredemptionFields[4].Descriptor()
+	// promoredemption.DiscountAmountValidator is a validator for the "discount_amount" field. It is called by the builders before save.
+	promoredemption.DiscountAmountValidator = promoredemptionDescDiscountAmount.Validators[0].(func(int64) error)
+	// promoredemptionDescCurrency is the schema descriptor for currency field.
+	promoredemptionDescCurrency := promoredemptionFields[5].Descriptor()
+	// promoredemption.DefaultCurrency holds the default value on creation for the currency field.
+	promoredemption.DefaultCurrency = promoredemptionDescCurrency.Default.(string)
+	// promoredemptionDescCreatedAt is the schema descriptor for created_at field.
+	promoredemptionDescCreatedAt := promoredemptionFields[7].Descriptor()
+	// promoredemption.DefaultCreatedAt holds the default value on creation for the created_at field.
+	promoredemption.DefaultCreatedAt = promoredemptionDescCreatedAt.Default.(func() time.Time)
+	// promoredemptionDescID is the schema descriptor for id field.
+	promoredemptionDescID := promoredemptionFields[0].Descriptor()
+	// promoredemption.DefaultID holds the default value on creation for the id field.
+	promoredemption.DefaultID = promoredemptionDescID.Default.(func() string)
+	ratetableFields := schema.RateTable{}.Fields()
+	_ = ratetableFields
+	// ratetableDescBase is the schema descriptor for base field.
+	ratetableDescBase := ratetableFields[1].Descriptor()
+	// ratetable.BaseValidator is a validator for the "base" field. It is called by the builders before save.
+	ratetable.BaseValidator = ratetableDescBase.Validators[0].(func(string) error)
+	// ratetableDescCreatedAt is the schema descriptor for created_at field.
+	ratetableDescCreatedAt := ratetableFields[4].Descriptor()
+	// ratetable.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ratetable.DefaultCreatedAt = ratetableDescCreatedAt.Default.(func() time.Time)
+	// ratetableDescID is the schema descriptor for id field.
+	ratetableDescID := ratetableFields[0].Descriptor()
+	// ratetable.DefaultID holds the default value on creation for the id field.
+	ratetable.DefaultID = ratetableDescID.Default.(func() string)
 	rideFields := schema.Ride{}.Fields()
 	_ = rideFields
 	// rideDescDriverID is the schema descriptor for driver_id field.
@@ -124,6 +451,10 @@ func init() {
 	ride.DefaultUpdatedAt = rideDescUpdatedAt.Default.(func() time.Time)
 	// ride.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	ride.UpdateDefaultUpdatedAt = rideDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// rideDescID is the schema descriptor for id field.
+	rideDescID := rideFields[0].Descriptor()
+	// ride.DefaultID holds the default value on creation for the id field.
+	ride.DefaultID = rideDescID.Default.(func() string)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescName is the schema descriptor for name field.
@@ -205,15 +536,19 @@ func init() {
 	// user.DefaultNeverCancels holds the default value on creation for the never_cancels field.
 	user.DefaultNeverCancels = userDescNeverCancels.Default.(bool)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[21].Descriptor()
+	userDescCreatedAt := userFields[23].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[22].Descriptor()
+	userDescUpdatedAt := userFields[24].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() string)
 	vehicleFields := schema.Vehicle{}.Fields()
 	_ = vehicleFields
 	// vehicleDescUserID is the schema descriptor for user_id field.
@@ -246,4 +581,8 @@ func init() {
 	vehicle.DefaultUpdatedAt = vehicleDescUpdatedAt.Default.(func() time.Time)
 	// vehicle.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	vehicle.UpdateDefaultUpdatedAt = vehicleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// vehicleDescID is the schema descriptor for id field.
+	vehicleDescID := vehicleFields[0].Descriptor()
+	// vehicle.DefaultID holds the default value on creation for the id field.
+	vehicle.DefaultID = vehicleDescID.Default.(func() string)
 }
@@ -1,10 +1,13 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/slowtyper/poolie/backend/internal/ids"
 	"time"
 )
 
@@ -17,6 +20,7 @@ type Booking struct {
 func (Booking) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").
+			DefaultFunc(ids.NewFunc(ids.Booking)).
 			Unique().
 			Immutable(),
 		field.String("ride_id").
@@ -24,23 +28,58 @@ func (Booking) Fields() []ent.Field {
 		field.String("passenger_id").
 			NotEmpty(),
 		field.String("status").
-			Default("pending"), // pending, confirmed, rejected, cancelled, completed
+			Default("pending"), // pending, confirmed, rejected, cancelled, completed, expired, failed
 		field.Int("passenger_count").
 			Positive(),
+		field.Int64("subtotal_amount").
+			Default(0).
+			NonNegative(),
+		field.String("promo_code").
+			Optional(),
+		field.Int64("discount_amount").
+			Default(0).
+			NonNegative(),
+		field.Int64("credit_amount").
+			Default(0).
+			NonNegative(),
 		field.Int64("total_price_amount").
-			Positive(),
+			NonNegative(),
 		field.String("total_price_currency").
 			Default("IDR"),
+		field.String("display_currency").
+			Optional(),
+		field.Int64("display_total_amount").
+			Optional().
+			Nillable(),
+		field.String("exchange_rate").
+			Optional(), // display_currency per unit of total_price_currency
+		field.Time("exchange_rate_as_of").
+			Optional().
+			Nillable(),
 		field.Text("message").
 			Optional(),
 		field.Text("driver_response_message").
 			Optional(),
 		field.Time("created_at").
 			Default(time.Now).
-			Immutable(),
+			Immutable().
+			Annotations(entgql.OrderField("CREATED_AT")),
 		field.Time("responded_at").
 			Optional().
 			Nillable(),
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+		field.String("cancelled_by").
+			Optional(), // passenger, driver
+		field.Text("cancellation_reason").
+			Optional(),
+		field.Int64("refund_amount").
+			Default(0).
+			NonNegative(),
+		field.Int64("penalty_amount").
+			Default(0).
+			NonNegative(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
@@ -60,6 +99,9 @@ func (Booking) Edges() []ent.Edge {
 			Field("passenger_id").
 			Unique().
 			Required(),
+		edge.To("payment", Payment.Type).
+			Unique().
+			Annotations(entgql.Skip()),
 	}
 }
 
@@ -71,3 +113,12 @@ func (Booking) Indexes() []ent.Index {
 		index.Fields("status"),
 	}
 }
+
+// Annotations of the Booking. Bookings are only reachable over GraphQL by
+// their passenger and the ride's driver.
+func (Booking) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+		entgql.Skip(entgql.SkipWhereInput),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/slowtyper/poolie/backend/internal/ids"
+	"time"
+)
+
+// Credit holds the schema definition for the Credit entity. A user's
+// credit balance is the sum of their rows: grants are positive, credits
+// spent on a booking are negative and released bookings add them back.
+type Credit struct {
+	ent.Schema
+}
+
+// Fields of the Credit.
+func (Credit) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(ids.NewFunc(ids.Credit)).
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty(),
+		field.String("kind").
+			NotEmpty(), // referral, booking, release
+		field.Int64("amount"),
+		field.String("currency").
+			Default("IDR"),
+		field.String("source_user_id").
+			Optional(),
+		field.String("booking_id").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the Credit.
+func (Credit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "currency"),
+		index.Fields("booking_id"),
+		index.Fields("kind", "source_user_id"),
+	}
+}
+
+// Annotations of the Credit. It is not exposed over GraphQL.
+func (Credit) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/slowtyper/poolie/backend/internal/ids"
+	"time"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+// Each row records a request sent with an Idempotency-Key header, so
+// retries get the stored response instead of being executed again.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(ids.NewFunc(ids.IdempotencyKey)).
+			Unique().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.String("key").
+			NotEmpty().
+			MaxLen(255).
+			Immutable(),
+		field.String("request_hash").
+			NotEmpty().
+			Immutable(),
+		// The response is unset while the request is in progress
+		field.Int("response_status").
+			Optional().
+			Nillable(),
+		field.String("response_content_type").
+			Optional(),
+		field.Bytes("response_body").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at"),
+	}
+}
+
+// Indexes of the IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "key").
+			Unique(),
+		index.Fields("expires_at"),
+	}
+}
+
+// Annotations of the IdempotencyKey. It is not exposed over GraphQL.
+func (IdempotencyKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/slowtyper/poolie/backend/internal/ids"
+	"time"
+)
+
+// LedgerEntry holds the schema definition for the LedgerEntry entity.
+// Entries sharing a transaction_id form one balanced double-entry
+// transaction: their amounts always sum to zero.
+type LedgerEntry struct {
+	ent.Schema
+}
+
+// Fields of the LedgerEntry.
+func (LedgerEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(ids.NewFunc(ids.LedgerEntry)).
+			Unique().
+			Immutable(),
+		field.String("transaction_id").
+			NotEmpty().
+			Immutable(),
+		field.String("kind").
+			NotEmpty().
+			Immutable(), // capture, refund, penalty, payout, payout_reversal
+		field.String("account").
+			NotEmpty().
+			Immutable(), // passenger, platform_fee, driver_payable, payouts
+		field.String("user_id").
+			Optional().
+			Immutable(),
+		field.String("booking_id").
+			Optional().
+			Immutable(),
+		field.String("payout_id").
+			Optional().
+			Immutable(),
+		field.Int64("amount").
+			Immutable(), // credits are positive, debits are negative
+		field.String("currency").
+			Default("IDR").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the LedgerEntry.
+func (LedgerEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account", "user_id"),
+		index.Fields("transaction_id"),
+		index.Fields("booking_id"),
+	}
+}
+
+// Annotations of the LedgerEntry. It is not exposed over GraphQL.
+func (LedgerEntry) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
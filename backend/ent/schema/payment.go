@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/slowtyper/poolie/backend/internal/ids"
+	"time"
+)
+
+// Payment holds the schema definition for the Payment entity.
+type Payment struct {
+	ent.Schema
+}
+
+// Fields of the Payment.
+func (Payment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(ids.NewFunc(ids.Payment)).
+			Unique().
+			Immutable(),
+		field.String("booking_id").
+			NotEmpty(),
+		field.String("provider").
+			NotEmpty(),
+		field.String("provider_intent_id").
+			Optional(), // unset until the provider has created the intent
+		field.Int64("amount").
+			Positive(),
+		field.String("currency").
+			Default("IDR"),
+		field.Int64("refunded_amount").
+			Default(0).
+			NonNegative(),
+		field.String("status").
+			Default("requires_capture"), // authorizing, requires_capture, succeeded, refunded, canceled, failed
+		field.Time("captured_at").
+			Optional().
+			Nillable(),
+		field.Time("voided_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Payment.
+func (Payment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("booking", Booking.Type).
+			Ref("payment").
+			Field("booking_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Payment.
+func (Payment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("booking_id").
+			Unique(),
+		index.Fields("provider", "provider_intent_id").
+			Unique(),
+		index.Fields("status"),
+	}
+}
+
+// Annotations of the Payment. It is not exposed over GraphQL.
+func (Payment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
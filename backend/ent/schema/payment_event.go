@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// PaymentEvent holds the schema definition for the PaymentEvent entity.
+// Each row records a provider webhook event that has been processed, so
+// redelivered events can be acknowledged without being applied twice. Rows
+// are keyed by the provider's event ID, so unlike other entities their IDs
+// are not generated.
+type PaymentEvent struct {
+	ent.Schema
+}
+
+// Fields of the PaymentEvent.
+func (PaymentEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			Immutable(),
+		field.String("provider").
+			NotEmpty(),
+		field.String("type").
+			NotEmpty(),
+		field.String("provider_intent_id").
+			NotEmpty(),
+		field.Time("processed_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Annotations of the PaymentEvent. It is not exposed over GraphQL.
+func (PaymentEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
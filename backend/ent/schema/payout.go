@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/slowtyper/poolie/backend/internal/ids"
+	"time"
+)
+
+// Payout holds the schema definition for the Payout entity.
+type Payout struct {
+	ent.Schema
+}
+
+// Fields of the Payout.
+func (Payout) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(ids.NewFunc(ids.Payout)).
+			Unique().
+			Immutable(),
+		field.String("driver_id").
+			NotEmpty(),
+		field.Int64("amount").
+			Positive(),
+		field.String("currency").
+			Default("IDR"),
+		field.String("status").
+			Default("pending"), // pending, paid, failed
+		field.String("provider").
+			NotEmpty(),
+		field.String("provider_reference").
+			Optional(),
+		field.Text("failure_reason").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Payout.
+func (Payout) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("driver_id"),
+		index.Fields("status"),
+	}
+}
+
+// Annotations of the Payout. It is not exposed over GraphQL.
+func (Payout) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
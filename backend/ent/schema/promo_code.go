@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/slowtyper/poolie/backend/internal/ids"
+	"time"
+)
+
+// PromoCode holds the schema definition for the PromoCode entity.
+type PromoCode struct {
+	ent.Schema
+}
+
+// Fields of the PromoCode.
+func (PromoCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(ids.NewFunc(ids.PromoCode)).
+			Unique().
+			Immutable(),
+		field.String("code").
+			Unique().
+			NotEmpty(), // stored upper case
+		field.String("description").
+			Optional(),
+		field.String("discount_type").
+			Default("percentage"), // percentage, fixed
+		field.Int64("discount_value").
+			Positive(), // percent for percentage codes, minor units for fixed codes
+		field.Int64("max_discount").
+			Optional().
+			Nillable().
+			Positive(),
+		field.String("currency").
+			Default("IDR"), // currency of fixed amounts, max discount and min spend
+		field.Int64("min_spend").
+			Default(0).
+			NonNegative(),
+		field.Int("max_uses").
+			Optional().
+			Nillable().
+			Positive(),
+		field.Int("max_uses_per_user").
+			Optional().
+			Nillable().
+			Positive(),
+		field.Int("used_count").
+			Default(0).
+			NonNegative(),
+		field.Time("valid_from").
+			Optional().
+			Nillable(),
+		field.Time("valid_until").
+			Optional().
+			Nillable(),
+		field.String("origin_city").
+			Optional(),
+		field.String("destination_city").
+			Optional(),
+		field.String("ride_type").
+			Optional(), // carpool, bus
+		field.Bool("active").
+			Default(true),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the PromoCode.
+func (PromoCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("redemptions", PromoRedemption.Type),
+	}
+}
+
+// Annotations of the PromoCode. It is not exposed over GraphQL.
+func (PromoCode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
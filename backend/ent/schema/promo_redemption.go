@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/slowtyper/poolie/backend/internal/ids"
+	"time"
+)
+
+// PromoRedemption holds the schema definition for the PromoRedemption entity.
+type PromoRedemption struct {
+	ent.Schema
+}
+
+// Fields of the PromoRedemption.
+func (PromoRedemption) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(ids.NewFunc(ids.PromoRedemption)).
+			Unique().
+			Immutable(),
+		field.String("promo_code_id").
+			NotEmpty(),
+		field.String("user_id").
+			NotEmpty(),
+		field.String("booking_id").
+			NotEmpty(),
+		field.Int64("discount_amount").
+			NonNegative(),
+		field.String("currency").
+			Default("IDR"),
+		field.Time("released_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PromoRedemption.
+func (PromoRedemption) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("promo_code", PromoCode.Type).
+			Ref("redemptions").
+			Field("promo_code_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PromoRedemption.
+func (PromoRedemption) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("promo_code_id", "user_id"),
+		index.Fields("booking_id").
+			Unique(),
+	}
+}
+
+// Annotations of the PromoRedemption. It is not exposed over GraphQL.
+func (PromoRedemption) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
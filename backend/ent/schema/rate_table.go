@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/slowtyper/poolie/backend/internal/ids"
+	"time"
+)
+
+// RateTable holds the schema definition for the RateTable entity. Each row
+// is an exchange rate table set through the admin API; the newest one is
+// used by every server.
+type RateTable struct {
+	ent.Schema
+}
+
+// Fields of the RateTable.
+func (RateTable) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			DefaultFunc(ids.NewFunc(ids.RateTable)).
+			Unique().
+			Immutable(),
+		field.String("base").
+			NotEmpty().
+			Immutable(),
+		// Decimal rates keyed by currency code, as in currency.RateTable
+		field.JSON("rates", map[string]string{}).
+			Immutable(),
+		field.Time("as_of").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the RateTable.
+func (RateTable) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
+
+// Annotations of the RateTable. It is not exposed over GraphQL.
+func (RateTable) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -1,10 +1,13 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/slowtyper/poolie/backend/internal/ids"
 	"time"
 )
 
@@ -17,6 +20,7 @@ type Ride struct {
 func (Ride) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").
+			DefaultFunc(ids.NewFunc(ids.Ride)).
 			Unique().
 			Immutable(),
 		field.String("driver_id").
@@ -29,7 +33,8 @@ func (Ride) Fields() []ent.Field {
 			Default("one_time"), // one_time, recurring
 		field.JSON("recurrence", map[string]interface{}{}).
 			Optional(),
-		field.Time("departure_time"),
+		field.Time("departure_time").
+			Annotations(entgql.OrderField("DEPARTURE_TIME")),
 		field.Time("arrival_time").
 			Optional().
 			Nillable(),
@@ -50,7 +55,8 @@ func (Ride) Fields() []ent.Field {
 		field.String("destination_location_point").
 			Optional(),
 		field.Int64("price_amount").
-			Positive(),
+			Positive().
+			Annotations(entgql.OrderField("PRICE")),
 		field.String("price_currency").
 			Default("IDR"),
 		field.Int("available_seats").
@@ -60,11 +66,12 @@ func (Ride) Fields() []ent.Field {
 		field.JSON("amenities", map[string]interface{}{}).
 			Optional(),
 		field.JSON("stops", []interface{}{}).
-			Optional(),
+			Optional().
+			Annotations(entgql.Type("[Any!]")),
 		field.Bool("instant_confirmation").
 			Default(true),
 		field.String("cancellation_policy").
-			Default("never_cancels"),
+			Default("moderate"), // flexible, moderate, strict
 		field.Text("description").
 			Optional(),
 		field.String("status").
@@ -85,12 +92,15 @@ func (Ride) Edges() []ent.Edge {
 			Ref("rides").
 			Field("driver_id").
 			Unique().
-			Required(),
+			Required().
+			Annotations(entgql.Skip()), // resolved in batches, see internal/graph
 		edge.From("vehicle", Vehicle.Type).
 			Ref("rides").
 			Field("vehicle_id").
-			Unique(),
-		edge.To("bookings", Booking.Type),
+			Unique().
+			Annotations(entgql.Skip()), // resolved in batches, see internal/graph
+		edge.To("bookings", Booking.Type).
+			Annotations(entgql.Skip()), // only visible to their parties, see internal/graph
 	}
 }
 
@@ -102,3 +112,11 @@ func (Ride) Indexes() []ent.Index {
 		index.Fields("status"),
 	}
 }
+
+// Annotations of the Ride
+func (Ride) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
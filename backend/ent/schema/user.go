@@ -1,9 +1,12 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/slowtyper/poolie/backend/internal/ids"
 	"time"
 )
 
@@ -16,15 +19,18 @@ type User struct {
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").
+			DefaultFunc(ids.NewFunc(ids.User)).
 			Unique().
 			Immutable(),
 		field.String("name").
 			NotEmpty(),
 		field.String("email").
 			Unique().
-			NotEmpty(),
+			NotEmpty().
+			Annotations(entgql.Skip()), // owner only, see internal/graph
 		field.String("phone").
-			Optional(),
+			Optional().
+			Annotations(entgql.Skip()), // owner only, see internal/graph
 		field.String("password_hash").
 			Sensitive(),
 		field.Int("age").
@@ -68,6 +74,14 @@ func (User) Fields() []ent.Field {
 			NonNegative(),
 		field.Bool("never_cancels").
 			Default(true),
+		field.String("referral_code").
+			Optional().
+			Nillable().
+			Unique().
+			Annotations(entgql.Skip()),
+		field.String("referred_by").
+			Optional().
+			Annotations(entgql.Skip()),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -80,8 +94,17 @@ func (User) Fields() []ent.Field {
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("rides", Ride.Type),
-		edge.To("bookings", Booking.Type),
+		edge.To("rides", Ride.Type).
+			Annotations(entgql.RelayConnection()),
+		edge.To("bookings", Booking.Type).
+			Annotations(entgql.Skip()),
 		edge.To("vehicles", Vehicle.Type),
 	}
 }
+
+// Annotations of the User. Users are public profiles over GraphQL.
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipWhereInput),
+	}
+}
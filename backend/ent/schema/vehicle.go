@@ -1,9 +1,12 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/slowtyper/poolie/backend/internal/ids"
 	"time"
 )
 
@@ -16,6 +19,7 @@ type Vehicle struct {
 func (Vehicle) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").
+			DefaultFunc(ids.NewFunc(ids.Vehicle)).
 			Unique().
 			Immutable(),
 		field.String("user_id").
@@ -27,7 +31,8 @@ func (Vehicle) Fields() []ent.Field {
 		field.String("color").
 			NotEmpty(),
 		field.String("license_plate").
-			Optional(),
+			Optional().
+			Annotations(entgql.Skip()),
 		field.Int("year").
 			Optional().
 			Positive(),
@@ -48,6 +53,14 @@ func (Vehicle) Edges() []ent.Edge {
 			Field("user_id").
 			Unique().
 			Required(),
-		edge.To("rides", Ride.Type),
+		edge.To("rides", Ride.Type).
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Annotations of the Vehicle
+func (Vehicle) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipWhereInput),
 	}
 }
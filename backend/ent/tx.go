@@ -14,6 +14,24 @@ type Tx struct {
 	config
 	// Booking is the client for interacting with the Booking builders.
 	Booking *BookingClient
+	// Credit is the client for interacting with the Credit builders.
+	Credit *CreditClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// LedgerEntry is the client for interacting with the LedgerEntry builders.
+	LedgerEntry *LedgerEntryClient
+	// Payment is the client for interacting with the Payment builders.
+	Payment *PaymentClient
+	// PaymentEvent is the client for interacting with the PaymentEvent builders.
+	PaymentEvent *PaymentEventClient
+	// Payout is the client for interacting with the Payout builders.
+	Payout *PayoutClient
+	// PromoCode is the client for interacting with the PromoCode builders.
+	PromoCode *PromoCodeClient
+	// PromoRedemption is the client for interacting with the PromoRedemption builders.
+	PromoRedemption *PromoRedemptionClient
+	// RateTable is the client for interacting with the RateTable builders.
+	RateTable *RateTableClient
 	// Ride is the client for interacting with the Ride builders.
 	Ride *RideClient
 	// User is the client for interacting with the User builders.
@@ -152,6 +170,15 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Booking = NewBookingClient(tx.config)
+	tx.Credit = NewCreditClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
+	tx.LedgerEntry = NewLedgerEntryClient(tx.config)
+	tx.Payment = NewPaymentClient(tx.config)
+	tx.PaymentEvent = NewPaymentEventClient(tx.config)
+	tx.Payout = NewPayoutClient(tx.config)
+	tx.PromoCode = NewPromoCodeClient(tx.config)
+	tx.PromoRedemption = NewPromoRedemptionClient(tx.config)
+	tx.RateTable = NewRateTableClient(tx.config)
 	tx.Ride = NewRideClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.Vehicle = NewVehicleClient(tx.config)
@@ -58,6 +58,10 @@ type User struct {
 	CompletedRides int `json:"completed_rides,omitempty"`
 	// NeverCancels holds the value of the "never_cancels" field.
 	NeverCancels bool `json:"never_cancels,omitempty"`
+	// ReferralCode holds the value of the "referral_code" field.
+	ReferralCode *string `json:"referral_code,omitempty"`
+	// ReferredBy holds the value of the "referred_by" field.
+	ReferredBy string `json:"referred_by,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -79,6 +83,12 @@ type UserEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [3]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedRides    map[string][]*Ride
+	namedBookings map[string][]*Booking
+	namedVehicles map[string][]*Vehicle
 }
 
 // RidesOrErr returns the Rides value or an error if the edge
@@ -121,7 +131,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case user.FieldAge, user.FieldRatingCount, user.FieldPublishedRides, user.FieldCompletedRides:
 			values[i] = new(sql.NullInt64)
-		case user.FieldID, user.FieldName, user.FieldEmail, user.FieldPhone, user.FieldPasswordHash, user.FieldExperienceLevel, user.FieldDrivingRating, user.FieldProfilePictureURL, user.FieldBio, user.FieldMembershipType:
+		case user.FieldID, user.FieldName, user.FieldEmail, user.FieldPhone, user.FieldPasswordHash, user.FieldExperienceLevel, user.FieldDrivingRating, user.FieldProfilePictureURL, user.FieldBio, user.FieldMembershipType, user.FieldReferralCode, user.FieldReferredBy:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -271,6 +281,19 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.NeverCancels = value.Bool
 			}
+		case user.FieldReferralCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referral_code", values[i])
+			} else if value.Valid {
+				_m.ReferralCode = new(string)
+				*_m.ReferralCode = value.String
+			}
+		case user.FieldReferredBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field referred_by", values[i])
+			} else if value.Valid {
+				_m.ReferredBy = value.String
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -399,6 +422,14 @@ func (_m *User) String() string {
 	builder.WriteString("never_cancels=")
 	builder.WriteString(fmt.Sprintf("%v", _m.NeverCancels))
 	builder.WriteString(", ")
+	if v := _m.ReferralCode; v != nil {
+		builder.WriteString("referral_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("referred_by=")
+	builder.WriteString(_m.ReferredBy)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -408,5 +439,77 @@ func (_m *User) String() string {
 	return builder.String()
 }
 
+// NamedRides returns the Rides named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *User) NamedRides(name string) ([]*Ride, error) {
+	if _m.Edges.namedRides == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedRides[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *User) appendNamedRides(name string, edges ...*Ride) {
+	if _m.Edges.namedRides == nil {
+		_m.Edges.namedRides = make(map[string][]*Ride)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedRides[name] = []*Ride{}
+	} else {
+		_m.Edges.namedRides[name] = append(_m.Edges.namedRides[name], edges...)
+	}
+}
+
+// NamedBookings returns the Bookings named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *User) NamedBookings(name string) ([]*Booking, error) {
+	if _m.Edges.namedBookings == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedBookings[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *User) appendNamedBookings(name string, edges ...*Booking) {
+	if _m.Edges.namedBookings == nil {
+		_m.Edges.namedBookings = make(map[string][]*Booking)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedBookings[name] = []*Booking{}
+	} else {
+		_m.Edges.namedBookings[name] = append(_m.Edges.namedBookings[name], edges...)
+	}
+}
+
+// NamedVehicles returns the Vehicles named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *User) NamedVehicles(name string) ([]*Vehicle, error) {
+	if _m.Edges.namedVehicles == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedVehicles[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *User) appendNamedVehicles(name string, edges ...*Vehicle) {
+	if _m.Edges.namedVehicles == nil {
+		_m.Edges.namedVehicles = make(map[string][]*Vehicle)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedVehicles[name] = []*Vehicle{}
+	} else {
+		_m.Edges.namedVehicles[name] = append(_m.Edges.namedVehicles[name], edges...)
+	}
+}
+
 // Users is a parsable slice of User.
 type Users []*User
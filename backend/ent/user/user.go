@@ -54,6 +54,10 @@ const (
 	FieldCompletedRides = "completed_rides"
 	// FieldNeverCancels holds the string denoting the never_cancels field in the database.
 	FieldNeverCancels = "never_cancels"
+	// FieldReferralCode holds the string denoting the referral_code field in the database.
+	FieldReferralCode = "referral_code"
+	// FieldReferredBy holds the string denoting the referred_by field in the database.
+	FieldReferredBy = "referred_by"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -112,6 +116,8 @@ var Columns = []string{
 	FieldPublishedRides,
 	FieldCompletedRides,
 	FieldNeverCancels,
+	FieldReferralCode,
+	FieldReferredBy,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -169,6 +175,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
 )
 
 // OrderOption defines the ordering options for the User queries.
@@ -274,6 +282,16 @@ func ByNeverCancels(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNeverCancels, opts...).ToFunc()
 }
 
+// ByReferralCode orders the results by the referral_code field.
+func ByReferralCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferralCode, opts...).ToFunc()
+}
+
+// ByReferredBy orders the results by the referred_by field.
+func ByReferredBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferredBy, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
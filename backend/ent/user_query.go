@@ -22,13 +22,18 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx          *QueryContext
-	order        []user.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.User
-	withRides    *RideQuery
-	withBookings *BookingQuery
-	withVehicles *VehicleQuery
+	ctx               *QueryContext
+	order             []user.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.User
+	withRides         *RideQuery
+	withBookings      *BookingQuery
+	withVehicles      *VehicleQuery
+	modifiers         []func(*sql.Selector)
+	loadTotal         []func(context.Context, []*User) error
+	withNamedRides    map[string]*RideQuery
+	withNamedBookings map[string]*BookingQuery
+	withNamedVehicles map[string]*VehicleQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -458,6 +463,9 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -488,6 +496,32 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	for name, query := range _q.withNamedRides {
+		if err := _q.loadRides(ctx, query, nodes,
+			func(n *User) { n.appendNamedRides(name) },
+			func(n *User, e *Ride) { n.appendNamedRides(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedBookings {
+		if err := _q.loadBookings(ctx, query, nodes,
+			func(n *User) { n.appendNamedBookings(name) },
+			func(n *User, e *Booking) { n.appendNamedBookings(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedVehicles {
+		if err := _q.loadVehicles(ctx, query, nodes,
+			func(n *User) { n.appendNamedVehicles(name) },
+			func(n *User, e *Vehicle) { n.appendNamedVehicles(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range _q.loadTotal {
+		if err := _q.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -584,6 +618,9 @@ func (_q *UserQuery) loadVehicles(ctx context.Context, query *VehicleQuery, node
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -663,6 +700,48 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedRides tells the query-builder to eager-load the nodes that are connected to the "rides"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNamedRides(name string, opts ...func(*RideQuery)) *UserQuery {
+	query := (&RideClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedRides == nil {
+		_q.withNamedRides = make(map[string]*RideQuery)
+	}
+	_q.withNamedRides[name] = query
+	return _q
+}
+
+// WithNamedBookings tells the query-builder to eager-load the nodes that are connected to the "bookings"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNamedBookings(name string, opts ...func(*BookingQuery)) *UserQuery {
+	query := (&BookingClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedBookings == nil {
+		_q.withNamedBookings = make(map[string]*BookingQuery)
+	}
+	_q.withNamedBookings[name] = query
+	return _q
+}
+
+// WithNamedVehicles tells the query-builder to eager-load the nodes that are connected to the "vehicles"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNamedVehicles(name string, opts ...func(*VehicleQuery)) *UserQuery {
+	query := (&VehicleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedVehicles == nil {
+		_q.withNamedVehicles = make(map[string]*VehicleQuery)
+	}
+	_q.withNamedVehicles[name] = query
+	return _q
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
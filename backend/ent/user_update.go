@@ -374,6 +374,46 @@ func (_u *UserUpdate) SetNillableNeverCancels(v *bool) *UserUpdate {
 	return _u
 }
 
+// SetReferralCode sets the "referral_code" field.
+func (_u *UserUpdate) SetReferralCode(v string) *UserUpdate {
+	_u.mutation.SetReferralCode(v)
+	return _u
+}
+
+// SetNillableReferralCode sets the "referral_code" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableReferralCode(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetReferralCode(*v)
+	}
+	return _u
+}
+
+// ClearReferralCode clears the value of the "referral_code" field.
+func (_u *UserUpdate) ClearReferralCode() *UserUpdate {
+	_u.mutation.ClearReferralCode()
+	return _u
+}
+
+// SetReferredBy sets the "referred_by" field.
+func (_u *UserUpdate) SetReferredBy(v string) *UserUpdate {
+	_u.mutation.SetReferredBy(v)
+	return _u
+}
+
+// SetNillableReferredBy sets the "referred_by" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableReferredBy(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetReferredBy(*v)
+	}
+	return _u
+}
+
+// ClearReferredBy clears the value of the "referred_by" field.
+func (_u *UserUpdate) ClearReferredBy() *UserUpdate {
+	_u.mutation.ClearReferredBy()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdate) SetUpdatedAt(v time.Time) *UserUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -674,6 +714,18 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.NeverCancels(); ok {
 		_spec.SetField(user.FieldNeverCancels, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ReferralCode(); ok {
+		_spec.SetField(user.FieldReferralCode, field.TypeString, value)
+	}
+	if _u.mutation.ReferralCodeCleared() {
+		_spec.ClearField(user.FieldReferralCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferredBy(); ok {
+		_spec.SetField(user.FieldReferredBy, field.TypeString, value)
+	}
+	if _u.mutation.ReferredByCleared() {
+		_spec.ClearField(user.FieldReferredBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1175,6 +1227,46 @@ func (_u *UserUpdateOne) SetNillableNeverCancels(v *bool) *UserUpdateOne {
 	return _u
 }
 
+// SetReferralCode sets the "referral_code" field.
+func (_u *UserUpdateOne) SetReferralCode(v string) *UserUpdateOne {
+	_u.mutation.SetReferralCode(v)
+	return _u
+}
+
+// SetNillableReferralCode sets the "referral_code" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableReferralCode(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetReferralCode(*v)
+	}
+	return _u
+}
+
+// ClearReferralCode clears the value of the "referral_code" field.
+func (_u *UserUpdateOne) ClearReferralCode() *UserUpdateOne {
+	_u.mutation.ClearReferralCode()
+	return _u
+}
+
+// SetReferredBy sets the "referred_by" field.
+func (_u *UserUpdateOne) SetReferredBy(v string) *UserUpdateOne {
+	_u.mutation.SetReferredBy(v)
+	return _u
+}
+
+// SetNillableReferredBy sets the "referred_by" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableReferredBy(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetReferredBy(*v)
+	}
+	return _u
+}
+
+// ClearReferredBy clears the value of the "referred_by" field.
+func (_u *UserUpdateOne) ClearReferredBy() *UserUpdateOne {
+	_u.mutation.ClearReferredBy()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -1505,6 +1597,18 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.NeverCancels(); ok {
 		_spec.SetField(user.FieldNeverCancels, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ReferralCode(); ok {
+		_spec.SetField(user.FieldReferralCode, field.TypeString, value)
+	}
+	if _u.mutation.ReferralCodeCleared() {
+		_spec.ClearField(user.FieldReferralCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReferredBy(); ok {
+		_spec.SetField(user.FieldReferredBy, field.TypeString, value)
+	}
+	if _u.mutation.ReferredByCleared() {
+		_spec.ClearField(user.FieldReferredBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
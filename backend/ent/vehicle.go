@@ -49,6 +49,10 @@ type VehicleEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedRides map[string][]*Ride
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -224,5 +228,29 @@ func (_m *Vehicle) String() string {
 	return builder.String()
 }
 
+// NamedRides returns the Rides named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Vehicle) NamedRides(name string) ([]*Ride, error) {
+	if _m.Edges.namedRides == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedRides[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Vehicle) appendNamedRides(name string, edges ...*Ride) {
+	if _m.Edges.namedRides == nil {
+		_m.Edges.namedRides = make(map[string][]*Ride)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedRides[name] = []*Ride{}
+	} else {
+		_m.Edges.namedRides[name] = append(_m.Edges.namedRides[name], edges...)
+	}
+}
+
 // Vehicles is a parsable slice of Vehicle.
 type Vehicles []*Vehicle
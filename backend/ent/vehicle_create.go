@@ -108,6 +108,14 @@ func (_c *VehicleCreate) SetID(v string) *VehicleCreate {
 	return _c
 }
 
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *VehicleCreate) SetNillableID(v *string) *VehicleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_c *VehicleCreate) SetOwnerID(id string) *VehicleCreate {
 	_c.mutation.SetOwnerID(id)
@@ -177,6 +185,10 @@ func (_c *VehicleCreate) defaults() {
 		v := vehicle.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := vehicle.DefaultID()
+		_c.mutation.SetID(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -21,12 +21,15 @@ import (
 // VehicleQuery is the builder for querying Vehicle entities.
 type VehicleQuery struct {
 	config
-	ctx        *QueryContext
-	order      []vehicle.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Vehicle
-	withOwner  *UserQuery
-	withRides  *RideQuery
+	ctx            *QueryContext
+	order          []vehicle.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.Vehicle
+	withOwner      *UserQuery
+	withRides      *RideQuery
+	modifiers      []func(*sql.Selector)
+	loadTotal      []func(context.Context, []*Vehicle) error
+	withNamedRides map[string]*RideQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -421,6 +424,9 @@ func (_q *VehicleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vehi
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -443,6 +449,18 @@ func (_q *VehicleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vehi
 			return nil, err
 		}
 	}
+	for name, query := range _q.withNamedRides {
+		if err := _q.loadRides(ctx, query, nodes,
+			func(n *Vehicle) { n.appendNamedRides(name) },
+			func(n *Vehicle, e *Ride) { n.appendNamedRides(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range _q.loadTotal {
+		if err := _q.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -508,6 +526,9 @@ func (_q *VehicleQuery) loadRides(ctx context.Context, query *RideQuery, nodes [
 
 func (_q *VehicleQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -590,6 +611,20 @@ func (_q *VehicleQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedRides tells the query-builder to eager-load the nodes that are connected to the "rides"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *VehicleQuery) WithNamedRides(name string, opts ...func(*RideQuery)) *VehicleQuery {
+	query := (&RideClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedRides == nil {
+		_q.withNamedRides = make(map[string]*RideQuery)
+	}
+	_q.withNamedRides[name] = query
+	return _q
+}
+
 // VehicleGroupBy is the group-by builder for Vehicle entities.
 type VehicleGroupBy struct {
 	selector
@@ -0,0 +1,157 @@
+// Package apperr defines the errors handlers return and how they map to
+// HTTP error responses
+package apperr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/ent"
+)
+
+// Error is an error with the HTTP status, code and message sent to the
+// client. The wrapped cause is logged but never exposed.
+type Error struct {
+	Status  int
+	Code    string
+	Message string
+	Details any
+	Err     error
+}
+
+// FieldError describes a problem with a single request field
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationDetails is the details payload of validation errors
+type ValidationDetails struct {
+	Fields []FieldError `json:"fields"`
+}
+
+func (e *Error) Error() string {
+	msg := e.Code + ": " + e.Message
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Wrap returns a copy of e caused by err
+func (e *Error) Wrap(err error) *Error {
+	wrapped := *e
+	wrapped.Err = err
+	return &wrapped
+}
+
+// WithDetails returns a copy of e carrying details
+func (e *Error) WithDetails(details any) *Error {
+	detailed := *e
+	detailed.Details = details
+	return &detailed
+}
+
+// New creates an Error
+func New(status int, code, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+// BadRequest is a 400 for malformed or unacceptable input
+func BadRequest(code, message string) *Error {
+	return New(fiber.StatusBadRequest, code, message)
+}
+
+// Unauthorized is a 401 for requests without valid credentials
+func Unauthorized(message string) *Error {
+	return New(fiber.StatusUnauthorized, "UNAUTHORIZED", message)
+}
+
+// Forbidden is a 403 for callers not allowed to perform an action
+func Forbidden(message string) *Error {
+	return New(fiber.StatusForbidden, "FORBIDDEN", message)
+}
+
+// NotFound is a 404 for resources that do not exist
+func NotFound(message string) *Error {
+	return New(fiber.StatusNotFound, "NOT_FOUND", message)
+}
+
+// Conflict is a 409 for requests the resource's current state does not allow
+func Conflict(code, message string) *Error {
+	return New(fiber.StatusConflict, code, message)
+}
+
+// Validation is a 400 listing the request fields that failed validation
+func Validation(fields ...FieldError) *Error {
+	return New(fiber.StatusBadRequest, "VALIDATION_FAILED", "Request validation failed").
+		WithDetails(ValidationDetails{Fields: fields})
+}
+
+// Internal is a 500 caused by err
+func Internal(message string, err error) *Error {
+	return New(fiber.StatusInternalServerError, "INTERNAL_ERROR", message).Wrap(err)
+}
+
+// From converts any error returned by a handler into an Error. Errors that
+// are not already an Error are mapped by kind: ent not-found, constraint
+// and validation errors, deadlines and Fiber errors; anything else is a 500.
+func From(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+
+	var ve *ent.ValidationError
+	var fe *fiber.Error
+	switch {
+	case ent.IsNotFound(err):
+		return NotFound("Resource not found").Wrap(err)
+	case ent.IsConstraintError(err):
+		return Conflict("CONFLICT", "Request conflicts with existing data").Wrap(err)
+	case errors.As(err, &ve):
+		return Validation(FieldError{Field: ve.Name, Message: validationMessage(ve)}).Wrap(err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return New(fiber.StatusGatewayTimeout, "REQUEST_TIMEOUT", "Request timed out").Wrap(err)
+	case errors.As(err, &fe):
+		if fe.Code == fiber.StatusGatewayTimeout {
+			return New(fe.Code, "REQUEST_TIMEOUT", "Request timed out")
+		}
+		return New(fe.Code, statusCode(fe.Code), fe.Message)
+	default:
+		return Internal("Internal server error", err)
+	}
+}
+
+// Status returns the HTTP status err is rendered with
+func Status(err error) int {
+	return From(err).Status
+}
+
+// validationMessage strips ent's prefix from a field validation error, e.g.
+// "value out of range" rather than `ent: validator failed for field ...`
+func validationMessage(ve *ent.ValidationError) string {
+	if cause := errors.Unwrap(ve.Unwrap()); cause != nil {
+		return cause.Error()
+	}
+	if strings.Contains(ve.Error(), "missing required field") {
+		return "is required"
+	}
+	return "is invalid"
+}
+
+// statusCode derives an error code from an HTTP status, e.g. METHOD_NOT_ALLOWED
+func statusCode(status int) string {
+	text := http.StatusText(status)
+	if text == "" {
+		return "ERROR"
+	}
+	return strings.ToUpper(strings.ReplaceAll(text, " ", "_"))
+}
@@ -0,0 +1,124 @@
+package apperr_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+)
+
+func TestFrom(t *testing.T) {
+	db, _ := testutil.NewDB(t)
+	ctx := t.Context()
+	driver := testutil.CreateUser(t, db)
+	r := testutil.CreateRide(t, db, driver)
+
+	_, notFound := db.Ride.Get(ctx, "ride_missing")
+	_, constraint := db.User.Create().
+		SetID(driver.ID).
+		SetName(driver.Name).
+		SetEmail(driver.Email).
+		SetPasswordHash("").
+		SetAge(30).
+		Save(ctx)
+	_, invalid := db.Booking.Create().
+		SetRideID(r.ID).
+		SetPassengerID(driver.ID).
+		SetStatus("pending").
+		SetPassengerCount(0).
+		SetSubtotalAmount(r.PriceAmount).
+		SetTotalPriceAmount(r.PriceAmount).
+		SetTotalPriceCurrency(r.PriceCurrency).
+		Save(ctx)
+	taken := apperr.Conflict("SEATS_TAKEN", "Seats were taken")
+	secret := errors.New("dial tcp 10.0.0.5:5432: connection refused")
+
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantCode    string
+		wantMessage string
+		wantDetails any
+	}{
+		{
+			name: "app error", err: taken,
+			wantStatus: fiber.StatusConflict, wantCode: "SEATS_TAKEN", wantMessage: "Seats were taken",
+		},
+		{
+			name: "wrapped app error", err: fmt.Errorf("failed to book: %w", taken),
+			wantStatus: fiber.StatusConflict, wantCode: "SEATS_TAKEN", wantMessage: "Seats were taken",
+		},
+		{
+			name: "not found", err: fmt.Errorf("failed to get ride: %w", notFound),
+			wantStatus: fiber.StatusNotFound, wantCode: "NOT_FOUND", wantMessage: "Resource not found",
+		},
+		{
+			name: "constraint", err: constraint,
+			wantStatus: fiber.StatusConflict, wantCode: "CONFLICT", wantMessage: "Request conflicts with existing data",
+		},
+		{
+			name: "validation", err: invalid,
+			wantStatus: fiber.StatusBadRequest, wantCode: "VALIDATION_FAILED", wantMessage: "Request validation failed",
+			wantDetails: apperr.ValidationDetails{Fields: []apperr.FieldError{
+				{Field: "passenger_count", Message: "value out of range"},
+			}},
+		},
+		{
+			name: "deadline", err: fmt.Errorf("failed to search rides: %w", context.DeadlineExceeded),
+			wantStatus: fiber.StatusGatewayTimeout, wantCode: "REQUEST_TIMEOUT", wantMessage: "Request timed out",
+		},
+		{
+			name: "fiber error", err: fiber.ErrMethodNotAllowed,
+			wantStatus: fiber.StatusMethodNotAllowed, wantCode: "METHOD_NOT_ALLOWED", wantMessage: "Method Not Allowed",
+		},
+		{
+			name: "fiber error with message", err: fiber.NewError(fiber.StatusRequestEntityTooLarge, "Body too large"),
+			wantStatus: fiber.StatusRequestEntityTooLarge, wantCode: "REQUEST_ENTITY_TOO_LARGE", wantMessage: "Body too large",
+		},
+		{
+			name: "fiber timeout", err: fiber.ErrGatewayTimeout,
+			wantStatus: fiber.StatusGatewayTimeout, wantCode: "REQUEST_TIMEOUT", wantMessage: "Request timed out",
+		},
+		{
+			name: "fiber error without status text", err: fiber.NewError(599, "Odd"),
+			wantStatus: 599, wantCode: "ERROR", wantMessage: "Odd",
+		},
+		{
+			name: "unknown", err: secret,
+			wantStatus: fiber.StatusInternalServerError, wantCode: "INTERNAL_ERROR", wantMessage: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.err == nil {
+				t.Fatal("test setup did not produce an error")
+			}
+
+			e := apperr.From(tt.err)
+			if e.Status != tt.wantStatus || e.Code != tt.wantCode || e.Message != tt.wantMessage {
+				t.Errorf("From() = %d %s %q, want %d %s %q", e.Status, e.Code, e.Message, tt.wantStatus, tt.wantCode, tt.wantMessage)
+			}
+			if !reflect.DeepEqual(e.Details, tt.wantDetails) {
+				t.Errorf("details = %+v, want %+v", e.Details, tt.wantDetails)
+			}
+			if apperr.Status(tt.err) != tt.wantStatus {
+				t.Errorf("Status() = %d, want %d", apperr.Status(tt.err), tt.wantStatus)
+			}
+			// The cause is kept for logging but never sent to the client
+			if strings.Contains(e.Message, secret.Error()) {
+				t.Errorf("message %q leaks the cause", e.Message)
+			}
+			if e.Status == fiber.StatusInternalServerError && !errors.Is(e, tt.err) {
+				t.Errorf("From() = %v, does not wrap %v", e, tt.err)
+			}
+		})
+	}
+}
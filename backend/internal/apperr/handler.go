@@ -0,0 +1,37 @@
+package apperr
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+	"go.uber.org/zap"
+)
+
+// Handler returns the app's Fiber error handler. It renders every error a
+// handler or middleware returns as an ErrorResponse tagged with the request
+// ID, and logs server errors together with their cause.
+func Handler() fiber.ErrorHandler {
+	return func(c fiber.Ctx, err error) error {
+		e := From(err)
+		ctx := c.UserContext()
+
+		if e.Status >= fiber.StatusInternalServerError {
+			requestctx.Logger(ctx).Error("request failed",
+				zap.String("path", c.Path()),
+				zap.String("route", c.Route().Path),
+				zap.Int("status", e.Status),
+				zap.String("code", e.Code),
+				zap.Error(err),
+			)
+		}
+
+		return c.Status(e.Status).JSON(models.ErrorResponse{
+			Error: models.ErrorDetail{
+				Code:      e.Code,
+				Message:   e.Message,
+				Details:   e.Details,
+				RequestID: requestctx.RequestID(ctx),
+			},
+		})
+	}
+}
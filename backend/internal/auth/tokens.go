@@ -0,0 +1,75 @@
+// Package auth issues and verifies the bearer tokens that authenticate API
+// callers
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/slowtyper/poolie/backend/internal/config"
+)
+
+// ErrInvalidToken is returned for tokens that are malformed, expired or not
+// signed with the configured secret
+var ErrInvalidToken = errors.New("invalid token")
+
+// Tokens issues and verifies HS256 JWTs whose subject is the user ID
+type Tokens struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewTokens creates Tokens signing with cfg.Secret. Issued tokens expire
+// after cfg.Expiration seconds.
+func NewTokens(cfg *config.JWTConfig) *Tokens {
+	return &Tokens{
+		secret: []byte(cfg.Secret),
+		ttl:    time.Duration(cfg.Expiration) * time.Second,
+	}
+}
+
+// Issue returns a signed token authenticating userID
+func (t *Tokens) Issue(userID string) (string, error) {
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   userID,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(t.ttl)),
+	})
+	signed, err := token.SignedString(t.secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+	return signed, nil
+}
+
+// Parse verifies token and returns the user ID it authenticates
+func (t *Tokens) Parse(token string) (string, error) {
+	var claims jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (any, error) {
+		return t.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if claims.Subject == "" {
+		return "", fmt.Errorf("%w: no subject", ErrInvalidToken)
+	}
+	return claims.Subject, nil
+}
+
+// BearerToken extracts the token from a "Bearer <token>" Authorization
+// header value
+func BearerToken(header string) (string, bool) {
+	parts := strings.Split(header, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" || parts[1] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
@@ -0,0 +1,443 @@
+package bookings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/payment"
+	"github.com/slowtyper/poolie/backend/ent/ride"
+	"github.com/slowtyper/poolie/backend/internal/cancellation"
+	"github.com/slowtyper/poolie/backend/internal/currency"
+	"github.com/slowtyper/poolie/backend/internal/ledger"
+	"github.com/slowtyper/poolie/backend/internal/metrics"
+	"github.com/slowtyper/poolie/backend/internal/payments"
+	"github.com/slowtyper/poolie/backend/internal/promotions"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+	"go.uber.org/zap"
+)
+
+// Booking statuses
+const (
+	StatusPending   = "pending"
+	StatusConfirmed = "confirmed"
+	StatusRejected  = "rejected"
+	StatusCancelled = "cancelled"
+	StatusCompleted = "completed"
+	StatusExpired   = "expired"
+	StatusFailed    = "failed"
+)
+
+// Parties that can cancel a booking
+const (
+	CancelledByPassenger = "passenger"
+	CancelledByDriver    = "driver"
+)
+
+// transitions lists the statuses each status may move to. Statuses missing
+// from the map are final.
+var transitions = map[string][]string{
+	StatusPending:   {StatusConfirmed, StatusRejected, StatusCancelled, StatusExpired, StatusFailed},
+	StatusConfirmed: {StatusCancelled, StatusCompleted},
+}
+
+var (
+	// ErrInvalidTransition is returned when a booking cannot move from its
+	// current status to the requested one
+	ErrInvalidTransition = errors.New("bookings: invalid status transition")
+	// ErrRideDeparted is returned when cancelling a booking after departure
+	ErrRideDeparted = errors.New("bookings: ride has departed")
+	// ErrPaymentFailed is returned when the payment provider declines to
+	// hold or capture the funds of a booking
+	ErrPaymentFailed = errors.New("bookings: payment failed")
+	// ErrNotFound is returned for bookings that do not exist
+	ErrNotFound = errors.New("bookings: booking not found")
+	// ErrForbidden is returned when a user acts on a booking they are not
+	// allowed to
+	ErrForbidden = errors.New("bookings: not allowed")
+	// ErrRideNotFound is returned when booking a ride that does not exist
+	ErrRideNotFound = errors.New("bookings: ride not found")
+	// ErrRideNotAvailable is returned when booking a ride that is no
+	// longer active
+	ErrRideNotAvailable = errors.New("bookings: ride is not available")
+	// ErrInsufficientSeats is returned when a ride has fewer seats left than
+	// the booking asks for
+	ErrInsufficientSeats = errors.New("bookings: not enough seats")
+)
+
+// CheckTransition reports whether a booking may move from one status to another
+func CheckTransition(from, to string) error {
+	if !slices.Contains(transitions[from], to) {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, from, to)
+	}
+	return nil
+}
+
+// IsFinal reports whether a booking in status can no longer change
+func IsFinal(status string) bool {
+	return len(transitions[status]) == 0
+}
+
+// Service creates bookings and applies their status transitions together
+// with the seat, payment, ledger and promotion side effects. Respond and
+// Cancel leave deciding who may perform a transition to the caller;
+// RespondAs and CancelAs check it.
+type Service struct {
+	db         *ent.Client
+	payments   *payments.Service
+	ledger     *ledger.Ledger
+	promotions *promotions.Service
+	rates      *currency.Rates
+	logger     *zap.Logger
+}
+
+// NewService creates a new bookings Service
+func NewService(db *ent.Client, payments *payments.Service, ledger *ledger.Ledger, promotions *promotions.Service, rates *currency.Rates, logger *zap.Logger) *Service {
+	return &Service{
+		db:         db,
+		payments:   payments,
+		ledger:     ledger,
+		promotions: promotions,
+		rates:      rates,
+		logger:     logger,
+	}
+}
+
+// Get returns a booking loaded with its ride and payment
+func (s *Service) Get(ctx context.Context, id string) (*ent.Booking, error) {
+	b, err := s.db.Booking.Query().
+		Where(booking.IDEQ(id)).
+		WithRide().
+		WithPayment().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booking: %w", err)
+	}
+	return b, nil
+}
+
+// GetAs returns a booking on behalf of userID, who must be its passenger or
+// the ride's driver
+func (s *Service) GetAs(ctx context.Context, userID, id string) (*ent.Booking, error) {
+	b, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if userID != b.PassengerID && userID != b.Edges.Ride.DriverID {
+		return nil, ErrForbidden
+	}
+	return b, nil
+}
+
+// Respond confirms or rejects a pending booking on behalf of the driver. b
+// must be loaded with its ride. Confirming captures the passenger's payment
+// once the seats are taken, and puts the booking back to pending if the
+// capture fails; rejecting returns their promotions and releases the held
+// funds.
+func (s *Service) Respond(ctx context.Context, b *ent.Booking, accept bool, message string) error {
+	newStatus := StatusConfirmed
+	if !accept {
+		newStatus = StatusRejected
+	}
+	if err := CheckTransition(b.Status, newStatus); err != nil {
+		return err
+	}
+
+	logger := requestctx.Logger(ctx)
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// The status is checked again so that concurrent responses cannot both
+	// apply
+	updateBuilder := tx.Booking.Update().
+		Where(booking.IDEQ(b.ID), booking.StatusEQ(b.Status)).
+		SetStatus(newStatus).
+		SetRespondedAt(time.Now())
+
+	if message != "" {
+		updateBuilder = updateBuilder.SetDriverResponseMessage(message)
+	}
+
+	updated, err := updateBuilder.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to update booking: %w", err)
+	}
+	if updated == 0 {
+		_ = tx.Rollback()
+		return fmt.Errorf("%w: booking was updated concurrently", ErrInvalidTransition)
+	}
+
+	if accept {
+		// Take the seats off the ride, unless bookings confirmed since it
+		// was loaded left too few
+		taken, err := tx.Ride.Update().
+			Where(ride.IDEQ(b.RideID), ride.AvailableSeatsGTE(b.PassengerCount)).
+			AddAvailableSeats(-b.PassengerCount).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to update ride seats: %w", err)
+		}
+		if taken == 0 {
+			_ = tx.Rollback()
+			return ErrInsufficientSeats
+		}
+
+		// Fully discounted bookings have no payment and are paid to the
+		// driver by promotions
+		paid, err := tx.Payment.Query().
+			Where(payment.BookingIDEQ(b.ID)).
+			Exist(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to check payment: %w", err)
+		}
+		if !paid && b.TotalPriceAmount == 0 && b.SubtotalAmount > 0 {
+			if err := s.ledger.RecordCapture(ctx, tx.Client(), b.ID); err != nil {
+				_ = tx.Rollback()
+				return err
+			}
+		}
+	} else {
+		// Return the promo code and credits of a rejected booking
+		if err := s.promotions.Release(ctx, tx.Client(), b.ID); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to release promotions: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit booking response: %w", err)
+	}
+
+	if accept {
+		// Release the escrowed funds to the driver. A confirmation whose
+		// capture fails is undone; if that fails too the capture is retried
+		// by the payments sweeper.
+		if _, err := s.payments.Capture(ctx, b.ID); err != nil && !errors.Is(err, payments.ErrNoPayment) {
+			s.unconfirm(ctx, b)
+			return fmt.Errorf("%w: %w", ErrPaymentFailed, err)
+		}
+		metrics.BookingsConfirmed.Inc()
+		return nil
+	}
+	metrics.BookingsRejected.Inc()
+
+	// Release the hold on the passenger's funds. A failure here is retried
+	// by the payments sweeper.
+	if _, err := s.payments.Void(ctx, b.ID); err != nil && !errors.Is(err, payments.ErrNoPayment) {
+		logger.Error("failed to void payment", zap.Error(err))
+	}
+
+	return nil
+}
+
+// Cancel cancels a pending or confirmed booking on behalf of the passenger
+// or the driver. b must be loaded with its ride. Confirmed bookings are
+// refunded and penalized according to the ride's cancellation policy. The
+// promo code usage of either is returned.
+func (s *Service) Cancel(ctx context.Context, b *ent.Booking, cancelledBy, reason string) error {
+	if err := CheckTransition(b.Status, StatusCancelled); err != nil {
+		return err
+	}
+
+	r := b.Edges.Ride
+	notice := time.Until(r.DepartureTime)
+	if notice < 0 {
+		return ErrRideDeparted
+	}
+
+	cancellerID := b.PassengerID
+	if cancelledBy == CancelledByDriver {
+		cancellerID = r.DriverID
+	}
+
+	// Pending bookings have not been paid yet and are released in full.
+	// Confirmed bookings are refunded according to the ride's policy, and
+	// the credits spent on them are returned in the same proportion.
+	var refund, penalty int64
+	creditRefund := b.CreditAmount
+	if b.Status == StatusConfirmed {
+		policy := cancellation.LookupOrDefault(r.CancellationPolicy, s.logger)
+		if cancelledBy == CancelledByPassenger {
+			refund = policy.PassengerRefund(b.TotalPriceAmount, notice)
+			creditRefund = policy.PassengerRefund(b.CreditAmount, notice)
+		} else {
+			refund = b.TotalPriceAmount
+			penalty = policy.DriverPenalty(b.TotalPriceAmount, notice)
+		}
+	}
+
+	logger := requestctx.Logger(ctx)
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// The status is checked again so that a concurrent cancel or response
+	// cannot return the seats or charge the penalty twice
+	updateBuilder := tx.Booking.Update().
+		Where(booking.IDEQ(b.ID), booking.StatusEQ(b.Status)).
+		SetStatus(StatusCancelled).
+		SetCancelledAt(time.Now()).
+		SetCancelledBy(cancelledBy).
+		SetRefundAmount(refund).
+		SetPenaltyAmount(penalty)
+
+	if reason != "" {
+		updateBuilder = updateBuilder.SetCancellationReason(reason)
+	}
+
+	updated, err := updateBuilder.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to cancel booking: %w", err)
+	}
+	if updated == 0 {
+		_ = tx.Rollback()
+		return fmt.Errorf("%w: booking was updated concurrently", ErrInvalidTransition)
+	}
+
+	// Return the promo code usage and the refunded credits
+	if err := s.promotions.Refund(ctx, tx.Client(), b.ID, creditRefund); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to release promotions: %w", err)
+	}
+
+	if b.Status == StatusConfirmed {
+		// Return the seats to the ride
+		_, err := tx.Ride.UpdateOne(r).
+			AddAvailableSeats(b.PassengerCount).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to update ride seats: %w", err)
+		}
+
+		// Cancelling a confirmed booking counts against the canceller
+		_, err = tx.User.UpdateOneID(cancellerID).
+			SetNeverCancels(false).
+			Save(ctx)
+		if err != nil {
+			logger.Error("failed to update user stats", zap.Error(err))
+		}
+
+		if penalty > 0 {
+			if err := s.ledger.RecordPenalty(ctx, tx.Client(), b.ID, r.DriverID, penalty, b.TotalPriceCurrency); err != nil {
+				_ = tx.Rollback()
+				return fmt.Errorf("failed to record cancellation penalty: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit booking cancellation: %w", err)
+	}
+
+	// Release the hold on a booking that was never captured, or refund a
+	// captured one. Failures here are retried by the payments sweeper.
+	if b.Status == StatusPending {
+		if _, err := s.payments.Void(ctx, b.ID); err != nil && !errors.Is(err, payments.ErrNoPayment) {
+			logger.Error("failed to void payment", zap.Error(err))
+		}
+	}
+	if b.Status == StatusConfirmed && refund > 0 {
+		if _, err := s.payments.Refund(ctx, b.ID, refund); err != nil && !errors.Is(err, payments.ErrNoPayment) {
+			logger.Error("failed to refund payment", zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// unconfirm puts a booking whose capture failed back to pending and returns
+// its seats to the ride
+func (s *Service) unconfirm(ctx context.Context, b *ent.Booking) {
+	logger := requestctx.Logger(ctx)
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		logger.Error("failed to start transaction", zap.Error(err))
+		return
+	}
+
+	updated, err := tx.Booking.Update().
+		Where(booking.IDEQ(b.ID), booking.StatusEQ(StatusConfirmed)).
+		SetStatus(StatusPending).
+		ClearRespondedAt().
+		ClearDriverResponseMessage().
+		Save(ctx)
+	if err != nil || updated == 0 {
+		_ = tx.Rollback()
+		if err != nil {
+			logger.Error("failed to revert booking confirmation", zap.Error(err))
+		}
+		return
+	}
+
+	_, err = tx.Ride.UpdateOneID(b.RideID).
+		AddAvailableSeats(b.PassengerCount).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Error("failed to update ride seats", zap.Error(err))
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("failed to commit booking confirmation revert", zap.Error(err))
+	}
+}
+
+// RespondAs confirms or rejects a pending booking on behalf of userID, who
+// must drive the booked ride
+func (s *Service) RespondAs(ctx context.Context, userID, bookingID string, accept bool, message string) (*ent.Booking, error) {
+	b, err := s.Get(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if b.Edges.Ride.DriverID != userID {
+		return nil, ErrForbidden
+	}
+
+	if err := s.Respond(ctx, b, accept, message); err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, b.ID)
+}
+
+// CancelAs cancels a booking on behalf of userID, who must be its passenger
+// or the ride's driver
+func (s *Service) CancelAs(ctx context.Context, userID, bookingID, reason string) (*ent.Booking, error) {
+	b, err := s.Get(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	var cancelledBy string
+	switch userID {
+	case b.PassengerID:
+		cancelledBy = CancelledByPassenger
+	case b.Edges.Ride.DriverID:
+		cancelledBy = CancelledByDriver
+	default:
+		return nil, ErrForbidden
+	}
+
+	if err := s.Cancel(ctx, b, cancelledBy, reason); err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, b.ID)
+}
@@ -0,0 +1,181 @@
+package bookings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/ride"
+	"github.com/slowtyper/poolie/backend/internal/currency"
+	"github.com/slowtyper/poolie/backend/internal/metrics"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+	"go.uber.org/zap"
+)
+
+// NewBooking is a passenger's request for seats on a ride. PromoCode and
+// DisplayCurrency are optional.
+type NewBooking struct {
+	RideID          string
+	PassengerID     string
+	PassengerCount  int
+	Message         string
+	PromoCode       string
+	DisplayCurrency string
+}
+
+// Create books seats for a passenger. The total is the fare for every seat
+// less the passenger's promo code and credits, and is held on their payment
+// method until the driver responds. If the hold is declined the booking is
+// marked failed and ErrPaymentFailed is returned. With a display currency
+// the converted total and the rate used are stored with the booking.
+// Promotion errors, currency.ErrNoRate and ent validation errors are
+// returned as is.
+func (s *Service) Create(ctx context.Context, n NewBooking) (*ent.Booking, error) {
+	r, err := s.db.Ride.Query().
+		Where(ride.IDEQ(n.RideID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrRideNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ride: %w", err)
+	}
+
+	if r.Status != "active" {
+		return nil, ErrRideNotAvailable
+	}
+	if r.AvailableSeats < n.PassengerCount {
+		return nil, ErrInsufficientSeats
+	}
+
+	// Snapshot the exchange rate so the displayed total can be reproduced
+	// even after the rate table changes
+	var conversion *currency.Conversion
+	if n.DisplayCurrency != "" {
+		conv, err := s.rates.Conversion(r.PriceCurrency, n.DisplayCurrency)
+		if err != nil {
+			return nil, err
+		}
+		conversion = &conv
+	}
+
+	subtotal := r.PriceAmount * int64(n.PassengerCount)
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Apply promo code and credits
+	quote, err := s.promotions.Quote(ctx, tx.Client(), n.PassengerID, r, subtotal, n.PromoCode)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	builder := tx.Booking.Create().
+		SetRideID(r.ID).
+		SetPassengerID(n.PassengerID).
+		SetStatus(StatusPending).
+		SetPassengerCount(n.PassengerCount).
+		SetSubtotalAmount(quote.Subtotal).
+		SetDiscountAmount(quote.Discount).
+		SetCreditAmount(quote.Credits).
+		SetTotalPriceAmount(quote.Total).
+		SetTotalPriceCurrency(r.PriceCurrency)
+
+	if quote.PromoCode != "" {
+		builder = builder.SetPromoCode(quote.PromoCode)
+	}
+
+	if conversion != nil {
+		displayTotal, err := conversion.Apply(quote.Total)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		builder = builder.
+			SetDisplayCurrency(conversion.To).
+			SetDisplayTotalAmount(displayTotal).
+			SetExchangeRate(conversion.RateString()).
+			SetExchangeRateAsOf(conversion.AsOf)
+	}
+
+	if n.Message != "" {
+		builder = builder.SetMessage(n.Message)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		if ent.IsValidationError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to create booking: %w", err)
+	}
+
+	if err := s.promotions.Redeem(ctx, tx.Client(), n.PassengerID, created.ID, quote); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	// Record the payment holding the booking amount in escrow until the
+	// driver responds. Bookings fully covered by promotions have nothing to
+	// collect.
+	if created.TotalPriceAmount > 0 {
+		if _, err := s.payments.Prepare(ctx, tx.Client(), created); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit booking: %w", err)
+	}
+
+	if created.TotalPriceAmount > 0 {
+		if _, err := s.payments.Authorize(ctx, created.ID); err != nil {
+			s.fail(ctx, created.ID)
+			return nil, fmt.Errorf("%w: %w", ErrPaymentFailed, err)
+		}
+	}
+	metrics.BookingsCreated.Inc()
+
+	return s.Get(ctx, created.ID)
+}
+
+// fail moves a booking whose payment could not be authorized from pending to
+// failed and returns its promotions. If that fails too the booking expires
+// like any other unanswered booking.
+func (s *Service) fail(ctx context.Context, bookingID string) {
+	logger := requestctx.Logger(ctx)
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		logger.Error("failed to start transaction", zap.Error(err))
+		return
+	}
+
+	updated, err := tx.Booking.Update().
+		Where(booking.IDEQ(bookingID), booking.StatusEQ(StatusPending)).
+		SetStatus(StatusFailed).
+		Save(ctx)
+	if err != nil || updated == 0 {
+		_ = tx.Rollback()
+		if err != nil {
+			logger.Error("failed to mark booking failed", zap.Error(err))
+		}
+		return
+	}
+
+	if err := s.promotions.Release(ctx, tx.Client(), bookingID); err != nil {
+		_ = tx.Rollback()
+		logger.Error("failed to release promotions", zap.Error(err))
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("failed to commit booking failure", zap.Error(err))
+	}
+}
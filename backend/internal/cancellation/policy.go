@@ -0,0 +1,116 @@
+package cancellation
+
+import (
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Default is the policy applied to rides that do not choose one
+const Default = "moderate"
+
+// Tier grants a refund percentage to passengers who cancel at least
+// MinNotice before departure
+type Tier struct {
+	MinNotice     time.Duration
+	RefundPercent int
+}
+
+// Policy describes how much of a confirmed booking is refunded when either
+// side cancels. Passengers are refunded according to the first tier whose
+// notice they meet. Drivers always refund the passenger in full and pay
+// DriverPenaltyPercent of the booking when they cancel with less than
+// DriverPenaltyNotice to go.
+type Policy struct {
+	Name                 string
+	Tiers                []Tier
+	DriverPenaltyPercent int
+	DriverPenaltyNotice  time.Duration
+}
+
+const day = 24 * time.Hour
+
+var policies = map[string]Policy{
+	"flexible": {
+		Name: "flexible",
+		Tiers: []Tier{
+			{MinNotice: day, RefundPercent: 100},
+			{MinNotice: 0, RefundPercent: 50},
+		},
+	},
+	"moderate": {
+		Name: "moderate",
+		Tiers: []Tier{
+			{MinNotice: 3 * day, RefundPercent: 100},
+			{MinNotice: day, RefundPercent: 50},
+			{MinNotice: 0, RefundPercent: 0},
+		},
+		DriverPenaltyPercent: 10,
+		DriverPenaltyNotice:  day,
+	},
+	"strict": {
+		Name: "strict",
+		Tiers: []Tier{
+			{MinNotice: 7 * day, RefundPercent: 100},
+			{MinNotice: 3 * day, RefundPercent: 50},
+			{MinNotice: 0, RefundPercent: 0},
+		},
+		DriverPenaltyPercent: 20,
+		DriverPenaltyNotice:  3 * day,
+	},
+}
+
+// Lookup returns the named policy
+func Lookup(name string) (Policy, bool) {
+	p, ok := policies[name]
+	return p, ok
+}
+
+// LookupOrDefault returns the named policy, falling back to the default
+// policy for rides created before policies were enforced. Falling back is
+// logged to logger, as it also hides policies that were stored misspelled.
+func LookupOrDefault(name string, logger *zap.Logger) Policy {
+	if p, ok := policies[name]; ok {
+		return p
+	}
+	logger.Warn("unknown cancellation policy, using the default",
+		zap.String("policy", name),
+		zap.String("default", Default),
+	)
+	return policies[Default]
+}
+
+// Names returns the names of all policies in alphabetical order
+func Names() []string {
+	names := make([]string, 0, len(policies))
+	for name := range policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RefundPercent returns the share of the booking refunded to a passenger who
+// cancels notice before departure
+func (p Policy) RefundPercent(notice time.Duration) int {
+	for _, t := range p.Tiers {
+		if notice >= t.MinNotice {
+			return t.RefundPercent
+		}
+	}
+	return 0
+}
+
+// PassengerRefund returns the amount refunded when the passenger cancels
+func (p Policy) PassengerRefund(amount int64, notice time.Duration) int64 {
+	return amount * int64(p.RefundPercent(notice)) / 100
+}
+
+// DriverPenalty returns the amount charged to the driver when they cancel
+func (p Policy) DriverPenalty(amount int64, notice time.Duration) int64 {
+	if notice >= p.DriverPenaltyNotice {
+		return 0
+	}
+	return amount * int64(p.DriverPenaltyPercent) / 100
+}
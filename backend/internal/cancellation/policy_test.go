@@ -0,0 +1,103 @@
+package cancellation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/internal/cancellation"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+const day = 24 * time.Hour
+
+func TestPassengerRefund(t *testing.T) {
+	tests := []struct {
+		policy string
+		notice time.Duration
+		amount int64
+		want   int64
+	}{
+		{policy: "flexible", notice: 2 * day, amount: 120000, want: 120000},
+		{policy: "flexible", notice: day, amount: 120000, want: 120000},
+		{policy: "flexible", notice: day - time.Second, amount: 120000, want: 60000},
+		{policy: "flexible", notice: 0, amount: 120000, want: 60000},
+		{policy: "flexible", notice: time.Hour, amount: 99999, want: 49999},
+		{policy: "moderate", notice: 3 * day, amount: 120000, want: 120000},
+		{policy: "moderate", notice: 3*day - time.Second, amount: 120000, want: 60000},
+		{policy: "moderate", notice: day, amount: 120000, want: 60000},
+		{policy: "moderate", notice: day - time.Second, amount: 120000, want: 0},
+		{policy: "strict", notice: 7 * day, amount: 120000, want: 120000},
+		{policy: "strict", notice: 7*day - time.Second, amount: 120000, want: 60000},
+		{policy: "strict", notice: 3 * day, amount: 120000, want: 60000},
+		{policy: "strict", notice: 3*day - time.Second, amount: 120000, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policy+"/"+tt.notice.String(), func(t *testing.T) {
+			p, ok := cancellation.Lookup(tt.policy)
+			if !ok {
+				t.Fatalf("policy %q not found", tt.policy)
+			}
+			if got := p.PassengerRefund(tt.amount, tt.notice); got != tt.want {
+				t.Errorf("PassengerRefund(%d, %s) = %d, want %d", tt.amount, tt.notice, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDriverPenalty(t *testing.T) {
+	tests := []struct {
+		policy string
+		notice time.Duration
+		amount int64
+		want   int64
+	}{
+		{policy: "flexible", notice: 0, amount: 120000, want: 0},
+		{policy: "moderate", notice: day, amount: 120000, want: 0},
+		{policy: "moderate", notice: day - time.Second, amount: 120000, want: 12000},
+		{policy: "moderate", notice: 0, amount: 99999, want: 9999},
+		{policy: "strict", notice: 3 * day, amount: 120000, want: 0},
+		{policy: "strict", notice: 3*day - time.Second, amount: 120000, want: 24000},
+		{policy: "strict", notice: time.Hour, amount: 99999, want: 19999},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policy+"/"+tt.notice.String(), func(t *testing.T) {
+			p, ok := cancellation.Lookup(tt.policy)
+			if !ok {
+				t.Fatalf("policy %q not found", tt.policy)
+			}
+			if got := p.DriverPenalty(tt.amount, tt.notice); got != tt.want {
+				t.Errorf("DriverPenalty(%d, %s) = %d, want %d", tt.amount, tt.notice, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupOrDefault(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	logger := zap.New(core)
+
+	tests := []struct {
+		name     string
+		want     string
+		wantLogs int
+	}{
+		{name: "strict", want: "strict"},
+		{name: "", want: cancellation.Default, wantLogs: 1},
+		{name: "Strict", want: cancellation.Default, wantLogs: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs.TakeAll()
+			if got := cancellation.LookupOrDefault(tt.name, logger); got.Name != tt.want {
+				t.Errorf("LookupOrDefault(%q) = %q, want %q", tt.name, got.Name, tt.want)
+			}
+			if n := logs.Len(); n != tt.wantLogs {
+				t.Errorf("%d warnings logged, want %d", n, tt.wantLogs)
+			}
+		})
+	}
+}
@@ -9,18 +9,32 @@ import (
 
 // Config holds all configuration for the application
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
+	Server      ServerConfig
+	Database    DatabaseConfig
+	JWT         JWTConfig
+	Payments    PaymentsConfig
+	Ledger      LedgerConfig
+	Promotions  PromotionsConfig
+	Currency    CurrencyConfig
+	Admin       AdminConfig
+	GraphQL     GraphQLConfig
+	Idempotency IdempotencyConfig
+	RateLimit   RateLimitConfig
+	Tracing     TracingConfig
 }
 
 // ServerConfig holds server-related configuration
 type ServerConfig struct {
-	Port         string
-	Host         string
-	ReadTimeout  int
-	WriteTimeout int
-	Environment  string
+	Port           string
+	Host           string
+	AdminPort      string
+	GRPCPort       string
+	ReadTimeout    int
+	WriteTimeout   int
+	RequestTimeout int
+	ShutdownDelay  int
+	HealthTimeout  int
+	Environment    string
 }
 
 // DatabaseConfig holds database-related configuration
@@ -31,6 +45,21 @@ type DatabaseConfig struct {
 	Password string
 	DBName   string
 	SSLMode  string
+
+	// Connection pool
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime int
+	ConnMaxIdleTime int
+
+	// StatementTimeout aborts queries running longer than this many
+	// milliseconds; 0 disables it
+	StatementTimeout int
+
+	// Startup verification
+	ConnectTimeout int
+	ConnectRetries int
+	ConnectBackoff int
 }
 
 // JWTConfig holds JWT-related configuration
@@ -39,6 +68,80 @@ type JWTConfig struct {
 	Expiration int
 }
 
+// PaymentsConfig holds payment-related configuration
+type PaymentsConfig struct {
+	Provider      string
+	WebhookSecret string
+	PendingExpiry int
+	SweepInterval int
+}
+
+// LedgerConfig holds ledger and payout-related configuration
+type LedgerConfig struct {
+	CommissionBps  int
+	PayoutProvider string
+	PayoutInterval int
+	PayoutMinimum  int64
+}
+
+// PromotionsConfig holds promotions-related configuration
+type PromotionsConfig struct {
+	ReferralCreditAmount   int64
+	ReferralCreditCurrency string
+}
+
+// CurrencyConfig holds currency-related configuration. Rates stored through
+// the admin API are reloaded from the database every RefreshInterval
+// seconds.
+type CurrencyConfig struct {
+	RatesFile       string
+	RefreshInterval int
+}
+
+// AdminConfig holds configuration for the admin endpoints
+type AdminConfig struct {
+	Token string
+}
+
+// GraphQLConfig holds GraphQL API configuration. ComplexityLimit bounds the
+// cost of a query, where every field costs one and connections multiply the
+// cost of their nodes by the page size.
+type GraphQLConfig struct {
+	ComplexityLimit int
+	MaxPageSize     int
+}
+
+// IdempotencyConfig holds configuration for Idempotency-Key handling.
+// Keys expire after KeyTTL seconds and are deleted every SweepInterval
+// seconds.
+type IdempotencyConfig struct {
+	KeyTTL        int
+	SweepInterval int
+}
+
+// RateLimitConfig holds rate limiting configuration. Limits are requests
+// per Window seconds.
+type RateLimitConfig struct {
+	Enabled            bool
+	Store              string
+	RedisAddr          string
+	RedisPassword      string
+	RedisDB            int
+	Window             int
+	AuthenticatedLimit int
+	SearchLimit        int
+	PublicLimit        int
+}
+
+// TracingConfig holds OpenTelemetry tracing configuration
+type TracingConfig struct {
+	Exporter     string
+	ServiceName  string
+	OTLPEndpoint string
+	OTLPInsecure bool
+	SampleRatio  float64
+}
+
 // Load reads configuration from environment variables and config files
 func Load() (*Config, error) {
 	viper.SetConfigName("config")
@@ -74,8 +177,13 @@ func setDefaults() {
 	// Server defaults
 	viper.SetDefault("server.port", "8080")
 	viper.SetDefault("server.host", "0.0.0.0")
+	viper.SetDefault("server.adminPort", "9090")
+	viper.SetDefault("server.grpcPort", "50051")
 	viper.SetDefault("server.readTimeout", 10)
 	viper.SetDefault("server.writeTimeout", 10)
+	viper.SetDefault("server.requestTimeout", 8) // bounds handler and database time
+	viper.SetDefault("server.shutdownDelay", 5)  // keep serving while load balancers drain
+	viper.SetDefault("server.healthTimeout", 2)  // readiness database ping
 	viper.SetDefault("server.environment", "development")
 
 	// Database defaults
@@ -85,16 +193,81 @@ func setDefaults() {
 	viper.SetDefault("database.password", "postgres")
 	viper.SetDefault("database.dbname", "poolie")
 	viper.SetDefault("database.sslmode", "disable")
+	viper.SetDefault("database.maxOpenConns", 25)
+	viper.SetDefault("database.maxIdleConns", 10)
+	viper.SetDefault("database.connMaxLifetime", 1800) // 30 minutes
+	viper.SetDefault("database.connMaxIdleTime", 300)  // 5 minutes
+	viper.SetDefault("database.statementTimeout", 5000)
+	viper.SetDefault("database.connectTimeout", 5)
+	viper.SetDefault("database.connectRetries", 5)
+	viper.SetDefault("database.connectBackoff", 1) // doubles after each attempt
 
 	// JWT defaults
 	viper.SetDefault("jwt.secret", "your-secret-key-change-in-production")
 	viper.SetDefault("jwt.expiration", 86400) // 24 hours
+
+	// Payments defaults
+	viper.SetDefault("payments.provider", "fake")
+	viper.SetDefault("payments.webhookSecret", "your-webhook-secret-change-in-production")
+	viper.SetDefault("payments.pendingExpiry", 86400) // 24 hours
+	viper.SetDefault("payments.sweepInterval", 300)   // 5 minutes
+
+	// Ledger defaults
+	viper.SetDefault("ledger.commissionBps", 1000) // 10%
+	viper.SetDefault("ledger.payoutProvider", "fake")
+	viper.SetDefault("ledger.payoutInterval", 86400) // 24 hours
+	viper.SetDefault("ledger.payoutMinimum", 50000)
+
+	// Promotions defaults
+	viper.SetDefault("promotions.referralCreditAmount", 25000)
+	viper.SetDefault("promotions.referralCreditCurrency", "IDR")
+
+	// Currency defaults
+	viper.SetDefault("currency.ratesFile", "")
+	viper.SetDefault("currency.refreshInterval", 60)
+
+	// Admin defaults; admin endpoints are disabled without a token
+	viper.SetDefault("admin.token", "")
+
+	// GraphQL defaults
+	viper.SetDefault("graphql.complexityLimit", 2000)
+	viper.SetDefault("graphql.maxPageSize", 100)
+
+	// Idempotency defaults
+	viper.SetDefault("idempotency.keyTTL", 86400)       // 24 hours
+	viper.SetDefault("idempotency.sweepInterval", 3600) // 1 hour
+
+	// Rate limit defaults
+	viper.SetDefault("rateLimit.enabled", true)
+	viper.SetDefault("rateLimit.store", "memory")
+	viper.SetDefault("rateLimit.redisAddr", "localhost:6379")
+	viper.SetDefault("rateLimit.redisPassword", "")
+	viper.SetDefault("rateLimit.redisDB", 0)
+	viper.SetDefault("rateLimit.window", 3600) // 1 hour
+	viper.SetDefault("rateLimit.authenticatedLimit", 1000)
+	viper.SetDefault("rateLimit.searchLimit", 100)
+	viper.SetDefault("rateLimit.publicLimit", 1000)
+
+	// Tracing defaults
+	viper.SetDefault("tracing.exporter", "none") // none, stdout, otlp
+	viper.SetDefault("tracing.serviceName", "poolie-api")
+	viper.SetDefault("tracing.otlpEndpoint", "localhost:4318")
+	viper.SetDefault("tracing.otlpInsecure", true)
+	viper.SetDefault("tracing.sampleRatio", 1.0)
 }
 
 // GetDSN returns the database connection string
 func (c *DatabaseConfig) GetDSN() string {
-	return fmt.Sprintf(
+	dsn := fmt.Sprintf(
 		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		c.Host, c.Port, c.User, c.Password, c.DBName, c.SSLMode,
 	)
+	if c.ConnectTimeout > 0 {
+		dsn += fmt.Sprintf(" connect_timeout=%d", c.ConnectTimeout)
+	}
+	// Unknown keys are sent to Postgres as session parameters
+	if c.StatementTimeout > 0 {
+		dsn += fmt.Sprintf(" statement_timeout=%d", c.StatementTimeout)
+	}
+	return dsn
 }
@@ -0,0 +1,49 @@
+package currency
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrUnknownCurrency is returned for codes that are not ISO 4217 currencies
+var ErrUnknownCurrency = errors.New("currency: unknown ISO 4217 currency code")
+
+// Default is the currency used when none is given
+const Default = "IDR"
+
+// minorUnits maps ISO 4217 codes to the number of decimal places in their
+// minor unit. Amounts throughout the API are integers in that minor unit.
+// IDR is listed with 0 decimals: rupiah have no circulating subunit and
+// amounts have always been stored in whole rupiah.
+var minorUnits = map[string]int{
+	"AED": 2, "ARS": 2, "AUD": 2, "BDT": 2, "BHD": 3, "BND": 2, "BRL": 2,
+	"CAD": 2, "CHF": 2, "CLP": 0, "CNY": 2, "COP": 2, "CZK": 2, "DKK": 2,
+	"EGP": 2, "EUR": 2, "GBP": 2, "HKD": 2, "HUF": 2, "IDR": 0, "ILS": 2,
+	"INR": 2, "ISK": 0, "JOD": 3, "JPY": 0, "KRW": 0, "KWD": 3, "LKR": 2,
+	"MMK": 2, "MXN": 2, "MYR": 2, "NGN": 2, "NOK": 2, "NZD": 2, "OMR": 3,
+	"PHP": 2, "PKR": 2, "PLN": 2, "QAR": 2, "RON": 2, "SAR": 2, "SEK": 2,
+	"SGD": 2, "THB": 2, "TND": 3, "TRY": 2, "TWD": 2, "UAH": 2, "USD": 2,
+	"VND": 0, "ZAR": 2,
+}
+
+// Normalize returns the canonical upper-case form of a currency code
+func Normalize(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Validate checks that code is a supported ISO 4217 currency code
+func Validate(code string) error {
+	if _, ok := minorUnits[code]; !ok {
+		return ErrUnknownCurrency
+	}
+	return nil
+}
+
+// MinorUnits returns the number of decimal places of code's minor unit
+func MinorUnits(code string) (int, error) {
+	units, ok := minorUnits[code]
+	if !ok {
+		return 0, ErrUnknownCurrency
+	}
+	return units, nil
+}
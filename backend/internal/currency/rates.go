@@ -0,0 +1,207 @@
+package currency
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrNoRate is returned when the rate table cannot convert between two
+	// currencies
+	ErrNoRate = errors.New("currency: no exchange rate available")
+	// ErrInvalidTable is returned for rate tables with unknown currencies or
+	// rates that are not positive decimals
+	ErrInvalidTable = errors.New("currency: invalid rate table")
+)
+
+// ratePrecision is the number of decimal places kept for cross rates
+const ratePrecision = 12
+
+// RateTable is the wire and file format of a set of exchange rates. Rates
+// are decimal strings giving the amount of each currency worth one unit of
+// Base, e.g. {"base": "USD", "rates": {"IDR": "16250.5"}}. AsOf defaults to
+// the time the table is set.
+type RateTable struct {
+	Base  string            `json:"base"`
+	AsOf  time.Time         `json:"as_of,omitzero"`
+	Rates map[string]string `json:"rates"`
+}
+
+// Conversion is an exchange rate between two currencies as it was applied,
+// kept so converted amounts can be reproduced later
+type Conversion struct {
+	From string
+	To   string
+	Rate *big.Rat
+	AsOf time.Time
+}
+
+// Rates holds the current exchange rate table and converts amounts with it.
+// It is safe for concurrent use.
+type Rates struct {
+	mu    sync.RWMutex
+	table RateTable
+	rates map[string]*big.Rat
+}
+
+// NewRates creates an empty rate table that can only convert a currency to itself
+func NewRates() *Rates {
+	return &Rates{}
+}
+
+// LoadFile replaces the current rates with the table stored at path
+func (r *Rates) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read rates file: %w", err)
+	}
+
+	var table RateTable
+	if err := json.Unmarshal(data, &table); err != nil {
+		return fmt.Errorf("failed to parse rates file: %w", err)
+	}
+
+	return r.Set(table)
+}
+
+// Set validates table and makes it the current rate table
+func (r *Rates) Set(table RateTable) error {
+	table, rates, err := table.parse()
+	if err != nil {
+		return err
+	}
+	r.use(table, rates)
+	return nil
+}
+
+// use makes a parsed table the current rate table
+func (r *Rates) use(table RateTable, rates map[string]*big.Rat) {
+	r.mu.Lock()
+	r.table = table
+	r.rates = rates
+	r.mu.Unlock()
+}
+
+// parse validates and normalizes the table and returns it with its rates
+// parsed, including the base currency's own rate of 1
+func (t RateTable) parse() (RateTable, map[string]*big.Rat, error) {
+	t.Base = Normalize(t.Base)
+	if err := Validate(t.Base); err != nil {
+		return RateTable{}, nil, fmt.Errorf("%w: unknown base currency %q", ErrInvalidTable, t.Base)
+	}
+	if t.AsOf.IsZero() {
+		t.AsOf = time.Now().UTC()
+	}
+
+	rates := make(map[string]*big.Rat, len(t.Rates)+1)
+	normalized := make(map[string]string, len(t.Rates))
+	for code, value := range t.Rates {
+		code = Normalize(code)
+		if err := Validate(code); err != nil {
+			return RateTable{}, nil, fmt.Errorf("%w: unknown currency %q", ErrInvalidTable, code)
+		}
+		rate, ok := new(big.Rat).SetString(value)
+		if !ok || rate.Sign() <= 0 {
+			return RateTable{}, nil, fmt.Errorf("%w: invalid rate %q for %s", ErrInvalidTable, value, code)
+		}
+		rates[code] = rate
+		normalized[code] = value
+	}
+	rates[t.Base] = big.NewRat(1, 1)
+	t.Rates = normalized
+
+	return t, rates, nil
+}
+
+// Table returns the current rate table
+func (r *Rates) Table() RateTable {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.table
+}
+
+// Conversion returns the rate that converts one unit of from into to.
+// Both codes must be valid; converting a currency to itself always succeeds.
+func (r *Rates) Conversion(from, to string) (Conversion, error) {
+	if err := Validate(from); err != nil {
+		return Conversion{}, err
+	}
+	if err := Validate(to); err != nil {
+		return Conversion{}, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if from == to {
+		return Conversion{From: from, To: to, Rate: big.NewRat(1, 1), AsOf: r.table.AsOf}, nil
+	}
+
+	fromRate, ok := r.rates[from]
+	if !ok {
+		return Conversion{}, ErrNoRate
+	}
+	toRate, ok := r.rates[to]
+	if !ok {
+		return Conversion{}, ErrNoRate
+	}
+
+	// Round the cross rate to the precision it is stored with, so amounts
+	// converted now and amounts recomputed from a snapshot always agree
+	rate, _ := new(big.Rat).SetString(new(big.Rat).Quo(toRate, fromRate).FloatString(ratePrecision))
+	return Conversion{From: from, To: to, Rate: rate, AsOf: r.table.AsOf}, nil
+}
+
+// ParseConversion rebuilds a Conversion from a stored decimal rate
+func ParseConversion(from, to, rate string, asOf time.Time) (Conversion, error) {
+	r, ok := new(big.Rat).SetString(rate)
+	if !ok || r.Sign() <= 0 {
+		return Conversion{}, fmt.Errorf("invalid rate %q", rate)
+	}
+	return Conversion{From: from, To: to, Rate: r, AsOf: asOf}, nil
+}
+
+// RateString formats the conversion rate as a decimal string
+func (c Conversion) RateString() string {
+	return c.Rate.FloatString(ratePrecision)
+}
+
+// Apply converts an amount in the minor unit of c.From to the minor unit of
+// c.To, rounding half away from zero
+func (c Conversion) Apply(amount int64) (int64, error) {
+	fromUnits, err := MinorUnits(c.From)
+	if err != nil {
+		return 0, err
+	}
+	toUnits, err := MinorUnits(c.To)
+	if err != nil {
+		return 0, err
+	}
+
+	v := new(big.Rat).SetInt64(amount)
+	v.Mul(v, c.Rate)
+	v.Mul(v, new(big.Rat).SetFrac(pow10(toUnits), pow10(fromUnits)))
+
+	return round(v), nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func round(v *big.Rat) int64 {
+	num := new(big.Int).Abs(v.Num())
+	q, m := new(big.Int).QuoRem(num, v.Denom(), new(big.Int))
+	if m.Mul(m, big.NewInt(2)).Cmp(v.Denom()) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if v.Sign() < 0 {
+		q.Neg(q)
+	}
+	return q.Int64()
+}
@@ -0,0 +1,115 @@
+package currency_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/slowtyper/poolie/backend/internal/currency"
+)
+
+func newRates(t *testing.T) *currency.Rates {
+	t.Helper()
+
+	rates := currency.NewRates()
+	err := rates.Set(currency.RateTable{Base: "IDR", Rates: map[string]string{
+		"USD": "0.000064",
+		"EUR": "0.000058",
+		"KWD": "0.0000197",
+	}})
+	if err != nil {
+		t.Fatalf("failed to set rates: %v", err)
+	}
+	return rates
+}
+
+func TestConvert(t *testing.T) {
+	rates := newRates(t)
+
+	tests := []struct {
+		name     string
+		from, to string
+		amount   int64
+		err      error
+		want     int64
+		wantRate string
+	}{
+		{name: "to base", from: "USD", to: "IDR", amount: 100, want: 15625, wantRate: "15625.000000000000"},
+		{name: "from base", from: "IDR", to: "USD", amount: 120000, want: 768, wantRate: "0.000064000000"},
+		{name: "same currency", from: "IDR", to: "IDR", amount: 120000, want: 120000, wantRate: "1.000000000000"},
+		{name: "cross rate", from: "USD", to: "EUR", amount: 1000, want: 906, wantRate: "0.906250000000"},
+		{name: "three decimals", from: "IDR", to: "KWD", amount: 100000, want: 1970},
+		{name: "rounds down", from: "USD", to: "IDR", amount: 1, want: 156},
+		{name: "rounds half up", from: "USD", to: "IDR", amount: 2, want: 313},
+		{name: "rounds half away from zero", from: "USD", to: "IDR", amount: -2, want: -313},
+		{name: "no rate", from: "IDR", to: "JPY", err: currency.ErrNoRate},
+		{name: "unknown currency", from: "IDR", to: "XYZ", err: currency.ErrUnknownCurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv, err := rates.Conversion(tt.from, tt.to)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Conversion() error = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if tt.wantRate != "" && conv.RateString() != tt.wantRate {
+				t.Errorf("rate = %s, want %s", conv.RateString(), tt.wantRate)
+			}
+
+			got, err := conv.Apply(tt.amount)
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Apply(%d) = %d, want %d", tt.amount, got, tt.want)
+			}
+
+			// Amounts recomputed from a stored snapshot match
+			snapshot, err := currency.ParseConversion(conv.From, conv.To, conv.RateString(), conv.AsOf)
+			if err != nil {
+				t.Fatalf("ParseConversion() error = %v", err)
+			}
+			if again, _ := snapshot.Apply(tt.amount); again != got {
+				t.Errorf("snapshot Apply(%d) = %d, want %d", tt.amount, again, got)
+			}
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		table   currency.RateTable
+		wantErr bool
+	}{
+		{name: "valid", table: currency.RateTable{Base: "idr", Rates: map[string]string{"usd": "0.000064"}}},
+		{name: "unknown base", table: currency.RateTable{Base: "XYZ"}, wantErr: true},
+		{name: "unknown currency", table: currency.RateTable{Base: "IDR", Rates: map[string]string{"XYZ": "1"}}, wantErr: true},
+		{name: "zero rate", table: currency.RateTable{Base: "IDR", Rates: map[string]string{"USD": "0"}}, wantErr: true},
+		{name: "negative rate", table: currency.RateTable{Base: "IDR", Rates: map[string]string{"USD": "-0.1"}}, wantErr: true},
+		{name: "not a number", table: currency.RateTable{Base: "IDR", Rates: map[string]string{"USD": "cheap"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rates := newRates(t)
+			before := rates.Table()
+
+			err := rates.Set(tt.table)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Set() error = %v, want error %v", err, tt.wantErr)
+			}
+			// A rejected table leaves the current one in place
+			if after := rates.Table(); tt.wantErr && len(after.Rates) != len(before.Rates) {
+				t.Errorf("rates = %v after failed Set, want %v", after.Rates, before.Rates)
+			}
+			if !tt.wantErr {
+				if _, err := rates.Conversion("IDR", "USD"); err != nil {
+					t.Errorf("Conversion() error = %v after Set", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package currency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/ratetable"
+	"go.uber.org/zap"
+)
+
+// Store keeps rate tables set through the admin API in the database, so that
+// every server converts with the same rates and updates survive restarts.
+// The newest stored table takes precedence over a rates file loaded at
+// startup, which only applies until a table is first stored.
+type Store struct {
+	db     *ent.Client
+	rates  *Rates
+	logger *zap.Logger
+}
+
+// NewStore creates a Store that keeps rates up to date with the database
+func NewStore(db *ent.Client, rates *Rates, logger *zap.Logger) *Store {
+	return &Store{
+		db:     db,
+		rates:  rates,
+		logger: logger,
+	}
+}
+
+// Save validates table, stores it as the newest rate table and makes it
+// current. Other servers pick it up the next time they Load.
+func (s *Store) Save(ctx context.Context, table RateTable) (RateTable, error) {
+	table, rates, err := table.parse()
+	if err != nil {
+		return RateTable{}, err
+	}
+
+	_, err = s.db.RateTable.Create().
+		SetBase(table.Base).
+		SetRates(table.Rates).
+		SetAsOf(table.AsOf).
+		Save(ctx)
+	if err != nil {
+		return RateTable{}, fmt.Errorf("failed to store exchange rates: %w", err)
+	}
+
+	s.rates.use(table, rates)
+	return table, nil
+}
+
+// Load makes the newest stored rate table current. It reports false and
+// leaves the current rates alone if no table was stored yet.
+func (s *Store) Load(ctx context.Context) (bool, error) {
+	stored, err := s.db.RateTable.Query().
+		Order(ent.Desc(ratetable.FieldCreatedAt), ent.Desc(ratetable.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch exchange rates: %w", err)
+	}
+
+	table, rates, err := RateTable{Base: stored.Base, AsOf: stored.AsOf, Rates: stored.Rates}.parse()
+	if err != nil {
+		return false, fmt.Errorf("stored exchange rates %s: %w", stored.ID, err)
+	}
+	s.rates.use(table, rates)
+	return true, nil
+}
+
+// Run calls Load every interval until ctx is cancelled
+func (s *Store) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.Load(ctx); err != nil {
+				s.logger.Error("exchange rate refresh failed", zap.Error(err))
+			}
+		}
+	}
+}
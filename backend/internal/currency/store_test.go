@@ -0,0 +1,71 @@
+package currency_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/internal/currency"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+	"go.uber.org/zap"
+)
+
+func TestStore(t *testing.T) {
+	db, _ := testutil.NewDB(t)
+	ctx := t.Context()
+
+	// Two servers sharing the database, both started with the rates file
+	file := currency.RateTable{Base: "USD", Rates: map[string]string{"IDR": "16000"}}
+	var servers [2]*currency.Store
+	var rates [2]*currency.Rates
+	for i := range servers {
+		rates[i] = currency.NewRates()
+		if err := rates[i].Set(file); err != nil {
+			t.Fatalf("Set() error = %v", err)
+		}
+		servers[i] = currency.NewStore(db, rates[i], zap.NewNop())
+	}
+
+	// Nothing was stored yet, so the file's rates stay
+	if loaded, err := servers[1].Load(ctx); err != nil || loaded {
+		t.Fatalf("Load() = %v, %v; want false, nil", loaded, err)
+	}
+	if got := rates[1].Table().Rates["IDR"]; got != "16000" {
+		t.Errorf("IDR rate = %s, want the file's 16000", got)
+	}
+
+	if _, err := servers[0].Save(ctx, currency.RateTable{Base: "usd", Rates: map[string]string{"XYZ": "1"}}); !errors.Is(err, currency.ErrInvalidTable) {
+		t.Fatalf("Save() error = %v, want %v", err, currency.ErrInvalidTable)
+	}
+	if n := db.RateTable.Query().CountX(ctx); n != 0 {
+		t.Fatalf("%d tables stored after an invalid update, want 0", n)
+	}
+
+	asOf := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	for _, idr := range []string{"16250", "16300"} {
+		saved, err := servers[0].Save(ctx, currency.RateTable{Base: "usd", AsOf: asOf, Rates: map[string]string{"idr": idr}})
+		if err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+		if saved.Base != "USD" || saved.Rates["IDR"] != idr {
+			t.Errorf("saved table = %+v, want it normalized", saved)
+		}
+	}
+
+	// The other server converts with the newest stored table
+	if loaded, err := servers[1].Load(ctx); err != nil || !loaded {
+		t.Fatalf("Load() = %v, %v; want true, nil", loaded, err)
+	}
+	for i, r := range rates {
+		conversion, err := r.Conversion("USD", "IDR")
+		if err != nil {
+			t.Fatalf("server %d: Conversion() error = %v", i, err)
+		}
+		if got := conversion.RateString(); got != "16300.000000000000" {
+			t.Errorf("server %d: rate = %s, want 16300", i, got)
+		}
+		if !conversion.AsOf.Equal(asOf) {
+			t.Errorf("server %d: as of = %s, want %s", i, conversion.AsOf, asOf)
+		}
+	}
+}
@@ -2,31 +2,86 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/slowtyper/poolie/backend/ent"
 	"github.com/slowtyper/poolie/backend/internal/config"
+	"github.com/slowtyper/poolie/backend/internal/tracing"
 	"go.uber.org/zap"
 
 	_ "github.com/lib/pq"
 )
 
-// New creates a new database client
-func New(cfg *config.DatabaseConfig, logger *zap.Logger) (*ent.Client, error) {
-	client, err := ent.Open("postgres", cfg.GetDSN())
+// maxConnectBackoff caps the wait between connection attempts on startup
+const maxConnectBackoff = 30 * time.Second
+
+// New creates a new database client with a tuned connection pool and waits
+// for the database to accept connections, retrying with exponential
+// backoff. The underlying sql.DB is returned alongside the client for
+// connection pool stats and health checks.
+func New(cfg *config.DatabaseConfig, logger *zap.Logger) (*ent.Client, *sql.DB, error) {
+	sqlDB, err := sql.Open("postgres", cfg.GetDSN())
 	if err != nil {
-		return nil, fmt.Errorf("failed to open database connection: %w", err)
+		return nil, nil, fmt.Errorf("failed to open database connection: %w", err)
+	}
+
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(time.Duration(cfg.ConnMaxLifetime) * time.Second)
+	sqlDB.SetConnMaxIdleTime(time.Duration(cfg.ConnMaxIdleTime) * time.Second)
+
+	if err := connect(sqlDB, cfg, logger); err != nil {
+		_ = sqlDB.Close()
+		return nil, nil, err
 	}
 
-	// Note: Ent doesn't expose DB() method directly in newer versions
-	// The connection is verified during Open()
+	// Trace every query as a child of the request span
+	drv := entsql.OpenDB(dialect.Postgres, sqlDB)
+	client := ent.NewClient(ent.Driver(tracing.WrapDriver(drv)))
 
 	logger.Info("database connection established",
 		zap.String("host", cfg.Host),
 		zap.String("database", cfg.DBName),
+		zap.Int("max_open_conns", cfg.MaxOpenConns),
+		zap.Int("max_idle_conns", cfg.MaxIdleConns),
 	)
 
-	return client, nil
+	return client, sqlDB, nil
+}
+
+// connect pings the database until it answers or the retries run out
+func connect(sqlDB *sql.DB, cfg *config.DatabaseConfig, logger *zap.Logger) error {
+	timeout := time.Duration(cfg.ConnectTimeout) * time.Second
+	backoff := time.Duration(cfg.ConnectBackoff) * time.Second
+
+	for attempt := 1; ; attempt++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		if timeout > 0 {
+			ctx, cancel = context.WithTimeout(context.Background(), timeout)
+		}
+		err := sqlDB.PingContext(ctx)
+		cancel()
+		if err == nil {
+			return nil
+		}
+
+		if attempt > cfg.ConnectRetries {
+			return fmt.Errorf("database at %s:%s unreachable after %d attempts: %w",
+				cfg.Host, cfg.Port, attempt, err)
+		}
+
+		logger.Warn("database not reachable, retrying",
+			zap.Int("attempt", attempt),
+			zap.Duration("backoff", backoff),
+			zap.Error(err),
+		)
+		time.Sleep(backoff)
+		backoff = min(backoff*2, maxConnectBackoff)
+	}
 }
 
 // Close closes the database connection
@@ -37,15 +92,3 @@ func Close(client *ent.Client, logger *zap.Logger) {
 		logger.Info("database connection closed")
 	}
 }
-
-// RunMigrations runs the Ent auto-migrations
-func RunMigrations(ctx context.Context, client *ent.Client, logger *zap.Logger) error {
-	logger.Info("running database migrations")
-
-	if err := client.Schema.Create(ctx); err != nil {
-		return fmt.Errorf("failed to run migrations: %w", err)
-	}
-
-	logger.Info("database migrations completed successfully")
-	return nil
-}
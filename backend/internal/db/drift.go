@@ -0,0 +1,177 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	entschema "entgo.io/ent/dialect/sql/schema"
+	entmigrate "github.com/slowtyper/poolie/backend/ent/migrate"
+	"go.uber.org/zap"
+)
+
+// scratchSchema is the schema wiped and rebuilt by CheckDrift
+const scratchSchema = "public"
+
+// Drift is the set of statements that would bring a database built from the
+// goose migrations in line with the ent schemas
+type Drift struct {
+	Changes []*migrate.Change
+}
+
+// Empty reports whether the migrations and the ent schemas agree
+func (d *Drift) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+// Migration renders the drift as a goose migration. Changes Atlas cannot
+// reverse are listed as comments in the Down section.
+func (d *Drift) Migration() []byte {
+	var b bytes.Buffer
+	b.WriteString("-- +goose Up\n-- +goose StatementBegin\n")
+	for _, c := range d.Changes {
+		if c.Comment != "" {
+			fmt.Fprintf(&b, "-- %s\n", c.Comment)
+		}
+		fmt.Fprintf(&b, "%s;\n", c.Cmd)
+	}
+	b.WriteString("-- +goose StatementEnd\n\n")
+
+	b.WriteString("-- +goose Down\n-- +goose StatementBegin\n")
+	for i := len(d.Changes) - 1; i >= 0; i-- {
+		c := d.Changes[i]
+		reverse := reverseStatements(c)
+		if len(reverse) == 0 {
+			fmt.Fprintf(&b, "-- irreversible: %s\n", c.Comment)
+			continue
+		}
+		if c.Comment != "" {
+			fmt.Fprintf(&b, "-- reverse: %s\n", c.Comment)
+		}
+		for _, stmt := range reverse {
+			fmt.Fprintf(&b, "%s;\n", stmt)
+		}
+	}
+	b.WriteString("-- +goose StatementEnd\n")
+
+	return b.Bytes()
+}
+
+// CheckDrift applies the embedded migrations to the scratch database behind
+// sqlDB, introspects the result and diffs it against the ent-generated
+// schema. The scratch database's public schema is dropped first, so sqlDB
+// must never point at a database holding real data.
+func CheckDrift(ctx context.Context, sqlDB *sql.DB, logger *zap.Logger) (*Drift, error) {
+	if err := resetScratch(ctx, sqlDB); err != nil {
+		return nil, err
+	}
+
+	atlasDriver, err := postgres.Open(sqlDB)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open atlas driver: %w", err)
+	}
+
+	// Normalize the ent schema on the still empty database so column types
+	// and defaults are spelled the way Postgres reports them
+	desired, err := entSchema(ctx, sqlDB, atlasDriver)
+	if err != nil {
+		return nil, err
+	}
+
+	migrator, err := NewMigrator(sqlDB, logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := migrator.Up(ctx); err != nil {
+		return nil, err
+	}
+
+	current, err := atlasDriver.InspectSchema(ctx, scratchSchema, &schema.InspectOptions{
+		Exclude: []string{"goose_db_version"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect migrated schema: %w", err)
+	}
+	desired.Name, desired.Attrs = current.Name, current.Attrs
+
+	changes, err := atlasDriver.SchemaDiff(current, desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff schemas: %w", err)
+	}
+	if len(changes) == 0 {
+		return &Drift{}, nil
+	}
+
+	plan, err := atlasDriver.PlanChanges(ctx, "drift", changes, func(opts *migrate.PlanOptions) {
+		// Match the unqualified table names used by the hand-written migrations
+		var noQualifier string
+		opts.SchemaQualifier = &noQualifier
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan schema changes: %w", err)
+	}
+
+	return &Drift{Changes: plan.Changes}, nil
+}
+
+// entSchema converts the tables in ent/migrate to their normalized Atlas form
+func entSchema(ctx context.Context, sqlDB *sql.DB, atlasDriver migrate.Driver) (*schema.Schema, error) {
+	m, err := entschema.NewMigrate(entsql.OpenDB(dialect.Postgres, sqlDB))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ent schema: %w", err)
+	}
+
+	realm, err := m.StateReader(entmigrate.Tables...).ReadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ent schema: %w", err)
+	}
+	desired := schema.New(scratchSchema)
+	if len(realm.Schemas) > 0 {
+		desired.AddTables(realm.Schemas[0].Tables...)
+	}
+
+	normalizer, ok := atlasDriver.(schema.Normalizer)
+	if !ok {
+		return desired, nil
+	}
+	normalized, err := normalizer.NormalizeSchema(ctx, desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalize ent schema: %w", err)
+	}
+	return normalized, nil
+}
+
+// resetScratch drops every object in the scratch schema
+func resetScratch(ctx context.Context, sqlDB *sql.DB) error {
+	stmts := []string{
+		"DROP SCHEMA IF EXISTS " + scratchSchema + " CASCADE",
+		"CREATE SCHEMA " + scratchSchema,
+	}
+	for _, stmt := range stmts {
+		if _, err := sqlDB.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to reset scratch database: %w", err)
+		}
+	}
+	return nil
+}
+
+func reverseStatements(c *migrate.Change) []string {
+	switch r := c.Reverse.(type) {
+	case string:
+		if strings.TrimSpace(r) == "" {
+			return nil
+		}
+		return []string{r}
+	case []string:
+		return r
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,15 @@
+package db
+
+import (
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// ForUpdate is a predicate of any ent query that locks the selected rows
+// until the transaction ends. SQLite, used in tests, has no row locks and
+// serializes write transactions instead.
+func ForUpdate(s *entsql.Selector) {
+	if s.Dialect() == dialect.Postgres {
+		s.ForUpdate()
+	}
+}
@@ -0,0 +1,160 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+	"github.com/slowtyper/poolie/backend/migrations"
+	"go.uber.org/zap"
+)
+
+// Migrator applies the goose migrations embedded in the binary. Every
+// operation holds a Postgres advisory lock, so replicas started together
+// wait for each other instead of migrating concurrently.
+type Migrator struct {
+	provider *goose.Provider
+	logger   *zap.Logger
+}
+
+// NewMigrator creates a Migrator for the database behind sqlDB
+func NewMigrator(sqlDB *sql.DB, logger *zap.Logger) (*Migrator, error) {
+	locker, err := lock.NewPostgresSessionLocker()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration lock: %w", err)
+	}
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, sqlDB, migrations.FS,
+		goose.WithSessionLocker(locker),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
+	}
+
+	return &Migrator{
+		provider: provider,
+		logger:   logger,
+	}, nil
+}
+
+// Up applies every pending migration
+func (m *Migrator) Up(ctx context.Context) error {
+	results, err := m.provider.Up(ctx)
+	for _, r := range results {
+		m.logResult(r)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	version, err := m.provider.GetDBVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read migration version: %w", err)
+	}
+	m.logger.Info("database is up to date",
+		zap.Int64("version", version),
+		zap.Int("applied", len(results)),
+	)
+	return nil
+}
+
+// Down rolls back the most recently applied migration
+func (m *Migrator) Down(ctx context.Context) error {
+	r, err := m.provider.Down(ctx)
+	if r != nil {
+		m.logResult(r)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to roll back migration: %w", err)
+	}
+	return nil
+}
+
+// Redo rolls back the most recently applied migration and applies it again
+func (m *Migrator) Redo(ctx context.Context) error {
+	if err := m.Down(ctx); err != nil {
+		return err
+	}
+
+	r, err := m.provider.UpByOne(ctx)
+	if r != nil {
+		m.logResult(r)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to reapply migration: %w", err)
+	}
+	return nil
+}
+
+// Status reports whether each embedded migration has been applied
+func (m *Migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	statuses, err := m.provider.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migration status: %w", err)
+	}
+	return statuses, nil
+}
+
+func (m *Migrator) logResult(r *goose.MigrationResult) {
+	fields := []zap.Field{
+		zap.String("migration", r.Source.Path),
+		zap.String("direction", r.Direction),
+		zap.Duration("duration", r.Duration.Round(time.Millisecond)),
+	}
+	if r.Error != nil {
+		m.logger.Error("migration failed", append(fields, zap.Error(r.Error))...)
+		return
+	}
+	m.logger.Info("migration applied", fields...)
+}
+
+// LatestMigrationVersion returns the highest migration version embedded in
+// the binary, which is the version a fully migrated database reports
+func LatestMigrationVersion() (int64, error) {
+	entries, err := fs.ReadDir(migrations.FS, ".")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read embedded migrations: %w", err)
+	}
+
+	var latest int64
+	for _, e := range entries {
+		if e.IsDir() || path.Ext(e.Name()) != ".sql" {
+			continue
+		}
+		prefix, _, ok := strings.Cut(e.Name(), "_")
+		if !ok {
+			continue
+		}
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			continue
+		}
+		if version > latest {
+			latest = version
+		}
+	}
+
+	return latest, nil
+}
+
+// MigrationVersion returns the goose migration version the database is at
+func MigrationVersion(ctx context.Context, db *sql.DB) (int64, error) {
+	var version int64
+	err := db.QueryRowContext(ctx,
+		`SELECT version_id FROM goose_db_version WHERE is_applied ORDER BY id DESC LIMIT 1`,
+	).Scan(&version)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to read migration version: %w", err)
+	}
+	return version, nil
+}
@@ -0,0 +1,76 @@
+package graph
+
+import (
+	"context"
+	"strings"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/ride"
+	"github.com/slowtyper/poolie/backend/ent/user"
+	"github.com/slowtyper/poolie/backend/ent/vehicle"
+	"github.com/slowtyper/poolie/backend/internal/ids"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+)
+
+// owner implements @owner: the field resolves for the user it belongs to
+// and is null for everybody else
+func owner(ctx context.Context, obj any, next graphql.Resolver) (any, error) {
+	u, ok := obj.(*ent.User)
+	if !ok || u.ID != requestctx.UserID(ctx) {
+		return nil, nil
+	}
+	return next(ctx)
+}
+
+// nodeTables maps ID prefixes to the tables of the types exposed as nodes
+var nodeTables = map[string]string{
+	ids.Booking: booking.Table,
+	ids.Ride:    ride.Table,
+	ids.User:    user.Table,
+	ids.Vehicle: vehicle.Table,
+}
+
+// nodeType resolves the table of a node from the prefix of its ID
+func nodeType(_ context.Context, id string) (string, error) {
+	prefix, _, _ := strings.Cut(id, "_")
+	if table, ok := nodeTables[prefix]; ok {
+		return table, nil
+	}
+	return "", entgql.ErrNodeNotFound(id)
+}
+
+// authorize returns an error unless the viewer may see node. Bookings are
+// only visible to their passenger and the driver of their ride, as over
+// REST; other nodes are public.
+func (r *Resolver) authorize(ctx context.Context, node ent.Noder) error {
+	b, ok := node.(*ent.Booking)
+	if !ok {
+		return nil
+	}
+
+	viewer := requestctx.UserID(ctx)
+	if viewer == "" {
+		return userError("UNAUTHENTICATED", "authentication required")
+	}
+	// Only the fields the client selected are loaded, so the parties of the
+	// booking are looked up again
+	party, err := r.client.Booking.Query().
+		Where(
+			booking.ID(b.ID),
+			booking.Or(
+				booking.PassengerID(viewer),
+				booking.HasRideWith(ride.DriverID(viewer)),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !party {
+		return userError("FORBIDDEN", "not a party to this booking")
+	}
+	return nil
+}
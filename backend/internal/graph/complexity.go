@@ -0,0 +1,65 @@
+package graph
+
+import (
+	"entgo.io/contrib/entgql"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// defaultPageSize is the page size of connections queried without first or
+// last, which entgql would otherwise return in full
+const defaultPageSize = 20
+
+// setComplexity prices connections by the page they can return, so nesting
+// them multiplies the cost of a query
+func setComplexity(c *ComplexityRoot, maxPageSize int) {
+	c.Query.Rides = func(child int, _ *entgql.Cursor[string], first *int, _ *entgql.Cursor[string], last *int, _ *ent.RideOrder, _ *ent.RideWhereInput) int {
+		return connectionComplexity(child, first, last, maxPageSize)
+	}
+	c.User.Rides = func(child int, _ *entgql.Cursor[string], first *int, _ *entgql.Cursor[string], last *int, _ *ent.RideOrder, _ *ent.RideWhereInput) int {
+		return connectionComplexity(child, first, last, maxPageSize)
+	}
+	c.Ride.Bookings = func(child int, _ *entgql.Cursor[string], first *int, _ *entgql.Cursor[string], last *int, _ *ent.BookingOrder) int {
+		return connectionComplexity(child, first, last, maxPageSize)
+	}
+}
+
+// connectionComplexity returns the cost of a connection whose fields cost
+// child per node
+func connectionComplexity(child int, first, last *int, maxPageSize int) int {
+	size := defaultPageSize
+	switch {
+	case first != nil:
+		size = *first
+	case last != nil:
+		size = *last
+	}
+	size = min(max(size, 1), maxPageSize)
+	return 1 + child*size
+}
+
+// page validates the page arguments of a connection against the maximum
+// page size and returns first, defaulted when no page size was given
+func (r *Resolver) page(first, last *int) (*int, error) {
+	for _, n := range []*int{first, last} {
+		if n == nil {
+			continue
+		}
+		if *n < 0 || *n > r.maxPageSize {
+			return nil, userError("BAD_USER_INPUT", "page size must be between 0 and %d", r.maxPageSize)
+		}
+	}
+	if first == nil && last == nil {
+		size := min(defaultPageSize, r.maxPageSize)
+		return &size, nil
+	}
+	return first, nil
+}
+
+// userError returns an error shown to the client as is, with code in its
+// extensions
+func userError(code, format string, args ...any) *gqlerror.Error {
+	err := gqlerror.Errorf(format, args...)
+	err.Extensions = map[string]any{"code": code}
+	return err
+}
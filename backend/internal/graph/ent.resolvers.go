@@ -0,0 +1,67 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.87
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/slowtyper/poolie/backend/ent"
+)
+
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, id string) (ent.Noder, error) {
+	node, err := r.client.Noder(ctx, id, ent.WithNodeType(nodeType))
+	if err != nil {
+		return nil, err
+	}
+	if err := r.authorize(ctx, node); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]ent.Noder, error) {
+	nodes, err := r.client.Noders(ctx, ids, ent.WithNodeType(nodeType))
+	if err != nil {
+		return nil, err
+	}
+	for i, node := range nodes {
+		if node == nil {
+			continue
+		}
+		if err := r.authorize(ctx, node); err != nil {
+			nodes[i] = nil
+			graphql.AddError(graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i)), err)
+		}
+	}
+	return nodes, nil
+}
+
+// Rides is the resolver for the rides field.
+func (r *queryResolver) Rides(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *ent.RideOrder, where *ent.RideWhereInput) (*ent.RideConnection, error) {
+	return r.rides(ctx, r.client.Ride.Query(), after, first, before, last, orderBy, where)
+}
+
+// Rides is the resolver for the rides field.
+func (r *userResolver) Rides(ctx context.Context, obj *ent.User, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *ent.RideOrder, where *ent.RideWhereInput) (*ent.RideConnection, error) {
+	return r.rides(ctx, obj.QueryRides(), after, first, before, last, orderBy, where)
+}
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// Ride returns RideResolver implementation.
+func (r *Resolver) Ride() RideResolver { return &rideResolver{r} }
+
+// User returns UserResolver implementation.
+func (r *Resolver) User() UserResolver { return &userResolver{r} }
+
+type queryResolver struct{ *Resolver }
+type rideResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
@@ -0,0 +1,74 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/graph-gophers/dataloader/v7"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/user"
+	"github.com/slowtyper/poolie/backend/ent/vehicle"
+)
+
+type loadersKey struct{}
+
+// loaders batch the lookups of ride drivers and vehicles made while
+// resolving a single operation, so a page of rides costs one query per edge
+// rather than one per ride
+type loaders struct {
+	users    *dataloader.Loader[string, *ent.User]
+	vehicles *dataloader.Loader[string, *ent.Vehicle]
+}
+
+// withLoaders returns ctx carrying new loaders reading from client
+func withLoaders(ctx context.Context, client *ent.Client) context.Context {
+	return context.WithValue(ctx, loadersKey{}, &loaders{
+		users: dataloader.NewBatchedLoader(loadByID(
+			func(ctx context.Context, ids []string) ([]*ent.User, error) {
+				return client.User.Query().Where(user.IDIn(ids...)).All(ctx)
+			},
+			func(u *ent.User) string { return u.ID },
+		)),
+		vehicles: dataloader.NewBatchedLoader(loadByID(
+			func(ctx context.Context, ids []string) ([]*ent.Vehicle, error) {
+				return client.Vehicle.Query().Where(vehicle.IDIn(ids...)).All(ctx)
+			},
+			func(v *ent.Vehicle) string { return v.ID },
+		)),
+	})
+}
+
+// loadersFor returns the loaders of the operation ctx belongs to
+func loadersFor(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// loadByID returns a batch function running query once for all requested
+// IDs. IDs missing from the result fail with a not found error.
+func loadByID[T any](query func(context.Context, []string) ([]T, error), id func(T) string) dataloader.BatchFunc[string, T] {
+	return func(ctx context.Context, ids []string) []*dataloader.Result[T] {
+		results := make([]*dataloader.Result[T], len(ids))
+
+		rows, err := query(ctx, ids)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[T]{Error: err}
+			}
+			return results
+		}
+
+		byID := make(map[string]T, len(rows))
+		for _, row := range rows {
+			byID[id(row)] = row
+		}
+		for i, key := range ids {
+			row, ok := byID[key]
+			if !ok {
+				results[i] = &dataloader.Result[T]{Error: fmt.Errorf("%s not found", key)}
+				continue
+			}
+			results[i] = &dataloader.Result[T]{Data: row}
+		}
+		return results
+	}
+}
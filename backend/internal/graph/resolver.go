@@ -0,0 +1,83 @@
+// Package graph serves the GraphQL API. The schema and most resolvers are
+// generated from the ent schemas by entgql and gqlgen; this package adds
+// authorization, page size and complexity limits, and batched loading of
+// ride drivers and vehicles. Run `go generate ./ent` after changing a
+// .graphql file.
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/internal/config"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+)
+
+// Resolver resolves queries straight from the database
+type Resolver struct {
+	client      *ent.Client
+	maxPageSize int
+}
+
+// NewServer creates the GraphQL server. Requests are authenticated by the
+// caller, which puts the user ID on the request context.
+func NewServer(client *ent.Client, cfg *config.GraphQLConfig) *handler.Server {
+	c := Config{Resolvers: &Resolver{client: client, maxPageSize: cfg.MaxPageSize}}
+	c.Directives.Owner = owner
+	setComplexity(&c.Complexity, cfg.MaxPageSize)
+
+	srv := handler.New(NewExecutableSchema(c))
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.FixedComplexityLimit(cfg.ComplexityLimit))
+
+	// Every operation gets its own loaders so batches never mix users
+	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		return next(withLoaders(ctx, client))
+	})
+
+	srv.SetErrorPresenter(presentError)
+	srv.SetRecoverFunc(func(ctx context.Context, p any) error {
+		return fmt.Errorf("panic: %v", p)
+	})
+
+	return srv
+}
+
+// presentError hides the cause of unexpected errors from clients and logs
+// it instead. Errors built with gqlerror are meant for clients.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) && gqlErr.Unwrap() == nil {
+		return graphql.DefaultErrorPresenter(ctx, err)
+	}
+
+	requestctx.Logger(ctx).Error("graphql resolver failed",
+		zap.String("path", graphql.GetPath(ctx).String()),
+		zap.Error(err),
+	)
+	presented := graphql.DefaultErrorPresenter(ctx, errors.New("internal server error"))
+	presented.Extensions = map[string]any{"code": "INTERNAL_ERROR"}
+	return presented
+}
+
+// rides paginates query within the page size limits
+func (r *Resolver) rides(ctx context.Context, query *ent.RideQuery, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *ent.RideOrder, where *ent.RideWhereInput) (*ent.RideConnection, error) {
+	first, err := r.page(first, last)
+	if err != nil {
+		return nil, err
+	}
+	return query.Paginate(ctx, after, first, before, last,
+		ent.WithRideOrder(orderBy),
+		ent.WithRideFilter(where.Filter),
+	)
+}
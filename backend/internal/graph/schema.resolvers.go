@@ -0,0 +1,79 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.87
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/ride"
+	"github.com/slowtyper/poolie/backend/ent/user"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+)
+
+// Viewer is the resolver for the viewer field.
+func (r *queryResolver) Viewer(ctx context.Context) (*ent.User, error) {
+	viewer := requestctx.UserID(ctx)
+	if viewer == "" {
+		return nil, nil
+	}
+	return r.User(ctx, viewer)
+}
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, id string) (*ent.User, error) {
+	u, err := r.client.User.Query().Where(user.ID(id)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return u, err
+}
+
+// Ride is the resolver for the ride field.
+func (r *queryResolver) Ride(ctx context.Context, id string) (*ent.Ride, error) {
+	query, err := r.client.Ride.Query().Where(ride.ID(id)).CollectFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rd, err := query.Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return rd, err
+}
+
+// Driver is the resolver for the driver field.
+func (r *rideResolver) Driver(ctx context.Context, obj *ent.Ride) (*ent.User, error) {
+	return loadersFor(ctx).users.Load(ctx, obj.DriverID)()
+}
+
+// Vehicle is the resolver for the vehicle field.
+func (r *rideResolver) Vehicle(ctx context.Context, obj *ent.Ride) (*ent.Vehicle, error) {
+	if obj.VehicleID == "" {
+		return nil, nil
+	}
+	return loadersFor(ctx).vehicles.Load(ctx, obj.VehicleID)()
+}
+
+// Bookings is the resolver for the bookings field.
+func (r *rideResolver) Bookings(ctx context.Context, obj *ent.Ride, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *ent.BookingOrder) (*ent.BookingConnection, error) {
+	viewer := requestctx.UserID(ctx)
+	if viewer == "" {
+		return &ent.BookingConnection{}, nil
+	}
+	first, err := r.page(first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	query := obj.QueryBookings()
+	if obj.DriverID != viewer {
+		query = query.Where(booking.PassengerID(viewer))
+	}
+	return query.Paginate(ctx, after, first, before, last, ent.WithBookingOrder(orderBy))
+}
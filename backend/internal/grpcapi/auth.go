@@ -0,0 +1,86 @@
+package grpcapi
+
+import (
+	"context"
+
+	pooliev1 "github.com/slowtyper/poolie/backend/api/poolie/v1"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/auth"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// publicMethods may be called without a token, like their REST
+// counterparts. A valid token still authenticates the call.
+var publicMethods = map[string]bool{
+	pooliev1.RideService_SearchRides_FullMethodName:    true,
+	pooliev1.RideService_GetRide_FullMethodName:        true,
+	pooliev1.UserService_GetUserProfile_FullMethodName: true,
+}
+
+// authenticator checks the bearer token in the authorization metadata and
+// records the user it was issued to on the call's context
+type authenticator struct {
+	tokens *auth.Tokens
+}
+
+func (a *authenticator) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := a.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authenticator) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticate returns ctx carrying the authenticated user. Calls to public
+// methods without a valid token go through anonymously.
+func (a *authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	public := publicMethods[method]
+
+	values := metadata.ValueFromIncomingContext(ctx, "authorization")
+	if len(values) == 0 {
+		if public {
+			return ctx, nil
+		}
+		return nil, apperr.Unauthorized("Missing authorization metadata")
+	}
+
+	token, ok := auth.BearerToken(values[0])
+	if !ok {
+		if public {
+			return ctx, nil
+		}
+		return nil, apperr.Unauthorized("Invalid authorization metadata format")
+	}
+
+	userID, err := a.tokens.Parse(token)
+	if err != nil {
+		if public {
+			return ctx, nil
+		}
+		return nil, apperr.Unauthorized("Invalid or expired token").Wrap(err)
+	}
+
+	ctx = requestctx.WithUserID(ctx, userID)
+	return requestctx.WithLogger(ctx, requestctx.Logger(ctx).With(zap.String("user_id", userID))), nil
+}
+
+// contextStream is a ServerStream with its context replaced
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,181 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	pooliev1 "github.com/slowtyper/poolie/backend/api/poolie/v1"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+	"github.com/slowtyper/poolie/backend/internal/currency"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/promotions"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+	"github.com/slowtyper/poolie/backend/internal/validate"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// bookingServer implements BookingService
+type bookingServer struct {
+	pooliev1.UnimplementedBookingServiceServer
+	bookings      *bookings.Service
+	watchInterval time.Duration
+}
+
+func (s *bookingServer) CreateBooking(ctx context.Context, req *pooliev1.CreateBookingRequest) (*pooliev1.Booking, error) {
+	r := models.CreateBookingRequest{
+		RideID:          req.RideId,
+		PassengerCount:  int(req.PassengerCount),
+		Message:         req.Message,
+		PromoCode:       req.PromoCode,
+		DisplayCurrency: currency.Normalize(req.DisplayCurrency),
+	}
+	if err := validate.CreateBooking(&r); err != nil {
+		return nil, err
+	}
+
+	created, err := s.bookings.Create(ctx, bookings.NewBooking{
+		RideID:          r.RideID,
+		PassengerID:     requestctx.UserID(ctx),
+		PassengerCount:  r.PassengerCount,
+		Message:         r.Message,
+		PromoCode:       r.PromoCode,
+		DisplayCurrency: r.DisplayCurrency,
+	})
+	if err != nil {
+		var promoErr *promotions.Error
+		switch {
+		case errors.Is(err, bookings.ErrRideNotFound):
+			return nil, apperr.NotFound("Ride not found")
+		case errors.Is(err, bookings.ErrRideNotAvailable):
+			return nil, apperr.Conflict("RIDE_NOT_AVAILABLE", "Ride is no longer available")
+		case errors.Is(err, bookings.ErrInsufficientSeats):
+			return nil, apperr.Conflict("INSUFFICIENT_SEATS", "Not enough available seats")
+		case errors.Is(err, bookings.ErrPaymentFailed):
+			return nil, paymentError(ctx, "Failed to authorize payment for booking", err)
+		case errors.As(err, &promoErr):
+			return nil, apperr.BadRequest(promoErr.Code, promoErr.Message).Wrap(err)
+		case errors.Is(err, currency.ErrNoRate):
+			return nil, apperr.New(http.StatusUnprocessableEntity, "UNSUPPORTED_CURRENCY",
+				"No exchange rate is available for the requested currency").Wrap(err)
+		}
+		return nil, err
+	}
+	return toBooking(created), nil
+}
+
+func (s *bookingServer) GetBooking(ctx context.Context, req *pooliev1.GetBookingRequest) (*pooliev1.Booking, error) {
+	b, err := s.bookings.GetAs(ctx, requestctx.UserID(ctx), req.BookingId)
+	if err != nil {
+		return nil, bookingError(err, "Failed to get booking")
+	}
+	return toBooking(b), nil
+}
+
+func (s *bookingServer) RespondToBooking(ctx context.Context, req *pooliev1.RespondToBookingRequest) (*pooliev1.Booking, error) {
+	if err := validate.RespondToBooking(&models.RespondToBookingRequest{
+		Action:  req.Action,
+		Message: req.Message,
+	}); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.bookings.RespondAs(ctx, requestctx.UserID(ctx), req.BookingId, req.Action == "accept", req.Message)
+	if err != nil {
+		switch {
+		case errors.Is(err, bookings.ErrForbidden):
+			return nil, apperr.Forbidden("You are not authorized to respond to this booking")
+		case errors.Is(err, bookings.ErrInvalidTransition):
+			return nil, apperr.Conflict("ALREADY_RESPONDED", "Booking has already been responded to")
+		case errors.Is(err, bookings.ErrInsufficientSeats):
+			return nil, apperr.Conflict("INSUFFICIENT_SEATS", "Not enough available seats")
+		case errors.Is(err, bookings.ErrPaymentFailed):
+			return nil, paymentError(ctx, "Failed to capture payment for booking", err)
+		}
+		return nil, bookingError(err, "Failed to update booking")
+	}
+	return toBooking(updated), nil
+}
+
+func (s *bookingServer) CancelBooking(ctx context.Context, req *pooliev1.CancelBookingRequest) (*pooliev1.Booking, error) {
+	if err := validate.CancelBooking(&models.CancelBookingRequest{Reason: req.Reason}); err != nil {
+		return nil, err
+	}
+
+	cancelled, err := s.bookings.CancelAs(ctx, requestctx.UserID(ctx), req.BookingId, req.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, bookings.ErrForbidden):
+			return nil, apperr.Forbidden("You are not authorized to cancel this booking")
+		case errors.Is(err, bookings.ErrInvalidTransition):
+			return nil, apperr.Conflict("CANNOT_CANCEL", "Only pending or confirmed bookings can be cancelled")
+		case errors.Is(err, bookings.ErrRideDeparted):
+			return nil, apperr.Conflict("RIDE_DEPARTED", "Bookings cannot be cancelled after departure")
+		}
+		return nil, bookingError(err, "Failed to cancel booking")
+	}
+	return toBooking(cancelled), nil
+}
+
+// WatchBooking polls the booking every watchInterval and sends it whenever
+// it differs from the last one sent. Polling sees changes made by every
+// replica and by the expiry sweeper alike.
+func (s *bookingServer) WatchBooking(req *pooliev1.WatchBookingRequest, stream grpc.ServerStreamingServer[pooliev1.Booking]) error {
+	ctx := stream.Context()
+	userID := requestctx.UserID(ctx)
+
+	b, err := s.bookings.GetAs(ctx, userID, req.BookingId)
+	if err != nil {
+		return bookingError(err, "Failed to get booking")
+	}
+
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+
+	var last *pooliev1.Booking
+	for {
+		current := toBooking(b)
+		if !proto.Equal(current, last) {
+			if err := stream.Send(current); err != nil {
+				return err
+			}
+			last = current
+		}
+		if bookings.IsFinal(b.Status) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		if b, err = s.bookings.Get(ctx, req.BookingId); err != nil {
+			return bookingError(err, "Failed to get booking")
+		}
+	}
+}
+
+// bookingError converts a bookings.Service error not specific to one
+// method, reporting anything unexpected as message
+func bookingError(err error, message string) error {
+	switch {
+	case errors.Is(err, bookings.ErrNotFound):
+		return apperr.NotFound("Booking not found")
+	case errors.Is(err, bookings.ErrForbidden):
+		return apperr.Forbidden("You are not authorized to view this booking")
+	}
+	return apperr.Internal(message, err)
+}
+
+// paymentError is the error for a failed payment provider call. The cause
+// is logged since it is not a server error.
+func paymentError(ctx context.Context, message string, err error) error {
+	requestctx.Logger(ctx).Error("payment failed", zap.Error(err))
+	return apperr.New(http.StatusPaymentRequired, "PAYMENT_FAILED", message).Wrap(err)
+}
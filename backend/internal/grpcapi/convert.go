@@ -0,0 +1,254 @@
+package grpcapi
+
+import (
+	"time"
+
+	pooliev1 "github.com/slowtyper/poolie/backend/api/poolie/v1"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/internal/cancellation"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The conversions below mirror the REST handlers' transforms to models, so
+// both APIs describe entities the same way
+
+func toRidePreview(r *ent.Ride) *pooliev1.RidePreview {
+	return &pooliev1.RidePreview{
+		RideId:          r.ID,
+		Type:            r.Type,
+		RideType:        r.RideType,
+		Recurrence:      toRecurrence(r.Recurrence),
+		DepartureTime:   timestamppb.New(r.DepartureTime),
+		ArrivalTime:     toOptionalTimestamp(r.ArrivalTime),
+		DurationMinutes: toOptionalInt32(r.DurationMinutes),
+		Origin:          toLocation(r.OriginCity, r.OriginAddress, r.OriginLocationPoint),
+		Destination:     toLocation(r.DestinationCity, r.DestinationAddress, r.DestinationLocationPoint),
+		Price:           toPrice(r.PriceAmount, r.PriceCurrency),
+		Driver:          toDriver(r.Edges.Driver),
+		Amenities:       toAmenities(r.Amenities),
+		AvailableSeats:  int32(r.AvailableSeats),
+	}
+}
+
+func toRide(r *ent.Ride, logger *zap.Logger) *pooliev1.Ride {
+	ride := &pooliev1.Ride{
+		RideId:          r.ID,
+		Type:            r.Type,
+		RideType:        r.RideType,
+		Recurrence:      toRecurrence(r.Recurrence),
+		DepartureTime:   timestamppb.New(r.DepartureTime),
+		ArrivalTime:     toOptionalTimestamp(r.ArrivalTime),
+		DurationMinutes: toOptionalInt32(r.DurationMinutes),
+		Origin:          toLocation(r.OriginCity, r.OriginAddress, r.OriginLocationPoint),
+		Destination:     toLocation(r.DestinationCity, r.DestinationAddress, r.DestinationLocationPoint),
+		Price:           toPrice(r.PriceAmount, r.PriceCurrency),
+		Driver:          toDriver(r.Edges.Driver),
+		Amenities:       toAmenities(r.Amenities),
+		AvailableSeats:  int32(r.AvailableSeats),
+		Stops:           toStops(r.Stops),
+		Status:          r.Status,
+		BookingPolicies: &pooliev1.BookingPolicies{
+			InstantConfirmation: r.InstantConfirmation,
+			CancellationPolicy:  r.CancellationPolicy,
+		},
+	}
+
+	// Refund schedule for cancelling a single seat
+	policy := cancellation.LookupOrDefault(r.CancellationPolicy, logger)
+	for _, t := range policy.Tiers {
+		ride.BookingPolicies.RefundSchedule = append(ride.BookingPolicies.RefundSchedule, &pooliev1.RefundTier{
+			HoursBeforeDeparture: int32(t.MinNotice.Hours()),
+			RefundPercent:        int32(t.RefundPercent),
+			RefundAmount:         toPrice(policy.PassengerRefund(r.PriceAmount, t.MinNotice), r.PriceCurrency),
+		})
+	}
+
+	if v := r.Edges.Vehicle; v != nil {
+		ride.Vehicle = &pooliev1.Vehicle{Make: v.Make, Model: v.Model, Color: v.Color}
+	}
+
+	return ride
+}
+
+func toBooking(b *ent.Booking) *pooliev1.Booking {
+	subtotal := b.SubtotalAmount
+	if subtotal == 0 {
+		subtotal = b.TotalPriceAmount
+	}
+
+	booking := &pooliev1.Booking{
+		BookingId:      b.ID,
+		RideId:         b.RideID,
+		Status:         b.Status,
+		PassengerCount: int32(b.PassengerCount),
+		TotalPrice:     toPrice(b.TotalPriceAmount, b.TotalPriceCurrency),
+		PriceBreakdown: &pooliev1.PriceBreakdown{
+			Subtotal:  toPrice(subtotal, b.TotalPriceCurrency),
+			PromoCode: b.PromoCode,
+			Discount:  toPrice(b.DiscountAmount, b.TotalPriceCurrency),
+			Credits:   toPrice(b.CreditAmount, b.TotalPriceCurrency),
+			Total:     toPrice(b.TotalPriceAmount, b.TotalPriceCurrency),
+		},
+		CreatedAt:   timestamppb.New(b.CreatedAt),
+		RespondedAt: toOptionalTimestamp(b.RespondedAt),
+	}
+
+	if b.DisplayTotalAmount != nil && b.ExchangeRateAsOf != nil {
+		booking.DisplayPrice = &pooliev1.DisplayPrice{
+			Amount:       *b.DisplayTotalAmount,
+			Currency:     b.DisplayCurrency,
+			ExchangeRate: b.ExchangeRate,
+			RateAsOf:     timestamppb.New(*b.ExchangeRateAsOf),
+		}
+	}
+
+	if b.Edges.Payment != nil {
+		booking.PaymentStatus = b.Edges.Payment.Status
+	}
+
+	if b.CancelledAt != nil {
+		booking.Cancellation = &pooliev1.BookingCancellation{
+			CancelledBy: b.CancelledBy,
+			CancelledAt: timestamppb.New(*b.CancelledAt),
+			Reason:      b.CancellationReason,
+			Refund:      toPrice(b.RefundAmount, b.TotalPriceCurrency),
+			Penalty:     toPrice(b.PenaltyAmount, b.TotalPriceCurrency),
+		}
+	}
+
+	if r := b.Edges.Ride; r != nil {
+		booking.RideDetails = &pooliev1.RideSummary{
+			RideId:          r.ID,
+			DepartureTime:   timestamppb.New(r.DepartureTime),
+			ArrivalTime:     toOptionalTimestamp(r.ArrivalTime),
+			OriginCity:      r.OriginCity,
+			DestinationCity: r.DestinationCity,
+		}
+	}
+
+	return booking
+}
+
+func toUserProfile(u *ent.User) *pooliev1.UserProfile {
+	profile := &pooliev1.UserProfile{
+		UserId:            u.ID,
+		Name:              u.Name,
+		Age:               toOptionalInt32(u.Age),
+		ExperienceLevel:   u.ExperienceLevel,
+		Rating:            u.Rating,
+		RatingCount:       int32(u.RatingCount),
+		ProfilePictureUrl: u.ProfilePictureURL,
+		MembershipType:    u.MembershipType,
+		Verification: &pooliev1.UserVerification{
+			IsVerified:     u.IsVerified,
+			VerifiedId:     u.VerifiedID,
+			ConfirmedEmail: u.ConfirmedEmail,
+			ConfirmedPhone: u.ConfirmedPhone,
+		},
+		Preferences: &pooliev1.UserPreferences{},
+		Stats: &pooliev1.UserStats{
+			PublishedRides: int32(u.PublishedRides),
+			CompletedRides: int32(u.CompletedRides),
+			NeverCancels:   u.NeverCancels,
+		},
+	}
+
+	if u.DrivingRating != nil {
+		profile.DrivingRating = *u.DrivingRating
+	}
+	if u.Bio != nil {
+		profile.Bio = *u.Bio
+	}
+
+	profile.Preferences.Chattiness, _ = u.Preferences["chattiness"].(string)
+	profile.Preferences.Music, _ = u.Preferences["music"].(string)
+	profile.Preferences.Smoking, _ = u.Preferences["smoking"].(bool)
+	profile.Preferences.Pets, _ = u.Preferences["pets"].(bool)
+
+	return profile
+}
+
+func toLocation(city, address, point string) *pooliev1.Location {
+	return &pooliev1.Location{City: city, Address: address, LocationPoint: point}
+}
+
+func toPrice(amount int64, currency string) *pooliev1.Price {
+	return &pooliev1.Price{Amount: amount, Currency: currency}
+}
+
+func toDriver(u *ent.User) *pooliev1.Driver {
+	if u == nil {
+		return nil
+	}
+	return &pooliev1.Driver{
+		UserId:            u.ID,
+		Name:              u.Name,
+		Rating:            u.Rating,
+		RatingCount:       int32(u.RatingCount),
+		ProfilePictureUrl: u.ProfilePictureURL,
+		IsVerified:        u.IsVerified,
+	}
+}
+
+func toAmenities(m map[string]interface{}) *pooliev1.Amenities {
+	amenities := &pooliev1.Amenities{}
+	amenities.SmokingAllowed, _ = m["smoking_allowed"].(bool)
+	amenities.AirConditioner, _ = m["air_conditioner"].(bool)
+	return amenities
+}
+
+func toRecurrence(m map[string]interface{}) *pooliev1.Recurrence {
+	if m == nil {
+		return nil
+	}
+	recurrence := &pooliev1.Recurrence{}
+	if days, ok := m["days_of_week"].([]interface{}); ok {
+		for _, day := range days {
+			d, _ := day.(string)
+			recurrence.DaysOfWeek = append(recurrence.DaysOfWeek, d)
+		}
+	}
+	recurrence.StartDate, _ = m["start_date"].(string)
+	recurrence.EndDate, _ = m["end_date"].(string)
+	return recurrence
+}
+
+func toStops(stops []interface{}) []*pooliev1.Stop {
+	var converted []*pooliev1.Stop
+	for _, s := range stops {
+		stopMap, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		stop := &pooliev1.Stop{Location: &pooliev1.Location{}}
+		if loc, ok := stopMap["location"].(map[string]interface{}); ok {
+			stop.Location.City, _ = loc["city"].(string)
+			stop.Location.Address, _ = loc["address"].(string)
+			stop.Location.LocationPoint, _ = loc["location_point"].(string)
+		}
+		if timeStr, ok := stopMap["time"].(string); ok {
+			if t, err := time.Parse(time.RFC3339, timeStr); err == nil {
+				stop.Time = timestamppb.New(t)
+			}
+		}
+		stop.Type, _ = stopMap["type"].(string)
+		converted = append(converted, stop)
+	}
+	return converted
+}
+
+func toOptionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func toOptionalInt32(n *int) *int32 {
+	if n == nil {
+		return nil
+	}
+	v := int32(*n)
+	return &v
+}
@@ -0,0 +1,132 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain is the domain of the ErrorInfo attached to every error
+const errorDomain = "poolie.app"
+
+// codesByStatus maps the HTTP status of an apperr.Error to a gRPC code.
+// Unlisted statuses are Internal.
+var codesByStatus = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusPaymentRequired:     codes.FailedPrecondition,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusConflict:            codes.FailedPrecondition,
+	http.StatusUnprocessableEntity: codes.InvalidArgument,
+	http.StatusTooManyRequests:     codes.ResourceExhausted,
+	http.StatusServiceUnavailable:  codes.Unavailable,
+	http.StatusGatewayTimeout:      codes.DeadlineExceeded,
+}
+
+// unaryErrors tags calls with a request ID and logger, recovers panics and
+// converts returned errors to gRPC statuses
+func unaryErrors(log *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		ctx = withRequest(ctx, log, info.FullMethod)
+		defer func() {
+			if r := recover(); r != nil {
+				err = apperr.Internal("Internal server error", fmt.Errorf("panic: %v", r))
+			}
+			if err != nil {
+				err = toStatus(ctx, info.FullMethod, err)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// streamErrors is unaryErrors for streaming calls
+func streamErrors(log *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ctx := withRequest(ss.Context(), log, info.FullMethod)
+		defer func() {
+			if r := recover(); r != nil {
+				err = apperr.Internal("Internal server error", fmt.Errorf("panic: %v", r))
+			}
+			if err != nil {
+				err = toStatus(ctx, info.FullMethod, err)
+			}
+		}()
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// withRequest returns ctx carrying the caller's x-request-id, or a new one,
+// and a logger tagged with it and the method
+func withRequest(ctx context.Context, log *zap.Logger, method string) context.Context {
+	requestID := uuid.New().String()
+	if values := metadata.ValueFromIncomingContext(ctx, "x-request-id"); len(values) > 0 && values[0] != "" {
+		requestID = values[0]
+	}
+	ctx = requestctx.WithRequestID(ctx, requestID)
+	return requestctx.WithLogger(ctx, log.With(
+		zap.String("request_id", requestID),
+		zap.String("method", method),
+	))
+}
+
+// toStatus converts an error into a gRPC status the way apperr renders it
+// over HTTP: the code is carried as the ErrorInfo reason and validation
+// failures as BadRequest field violations. Server errors are logged together
+// with their cause, which is never exposed.
+func toStatus(ctx context.Context, method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	// The caller went away; there is no one to report the cause to
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	e := apperr.From(err)
+	code, ok := codesByStatus[e.Status]
+	if !ok {
+		code = codes.Internal
+	}
+	if e.Status >= http.StatusInternalServerError {
+		requestctx.Logger(ctx).Error("request failed",
+			zap.String("grpc_method", method),
+			zap.String("code", e.Code),
+			zap.Error(err),
+		)
+	}
+
+	st := status.New(code, e.Message)
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason:   e.Code,
+		Domain:   errorDomain,
+		Metadata: map[string]string{"request_id": requestctx.RequestID(ctx)},
+	}}
+	if v, ok := e.Details.(apperr.ValidationDetails); ok {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(v.Fields))
+		for _, f := range v.Fields {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       f.Field,
+				Description: f.Message,
+			})
+		}
+		details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+	}
+	if detailed, err := st.WithDetails(details...); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
@@ -0,0 +1,261 @@
+package grpcapi_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	pooliev1 "github.com/slowtyper/poolie/backend/api/poolie/v1"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+	"github.com/slowtyper/poolie/backend/internal/grpcapi"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// client is connected to a gRPC server over the services of srv
+type client struct {
+	srv      *testutil.Server
+	rides    pooliev1.RideServiceClient
+	bookings pooliev1.BookingServiceClient
+	users    pooliev1.UserServiceClient
+}
+
+func newClient(t *testing.T) *client {
+	t.Helper()
+
+	srv := testutil.NewServer(t)
+	grpcServer := grpcapi.New(grpcapi.Services{
+		Rides:    srv.Rides,
+		Bookings: srv.Bookings,
+		Users:    srv.Users,
+	}, grpcapi.Config{Tokens: srv.Tokens, WatchInterval: 10 * time.Millisecond}, zap.NewNop())
+
+	listener := bufconn.Listen(1 << 20)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return &client{
+		srv:      srv,
+		rides:    pooliev1.NewRideServiceClient(conn),
+		bookings: pooliev1.NewBookingServiceClient(conn),
+		users:    pooliev1.NewUserServiceClient(conn),
+	}
+}
+
+// as returns a context authenticating calls as userID, or anonymous calls
+// for an empty userID
+func (c *client) as(t *testing.T, userID string) context.Context {
+	if userID == "" {
+		return t.Context()
+	}
+	return metadata.AppendToOutgoingContext(t.Context(), "authorization", "Bearer "+c.srv.Token(t, userID))
+}
+
+func TestAuth(t *testing.T) {
+	c := newClient(t)
+	driver := testutil.CreateUser(t, c.srv.DB)
+	passenger := testutil.CreateUser(t, c.srv.DB)
+	stranger := testutil.CreateUser(t, c.srv.DB)
+	r := testutil.CreateRide(t, c.srv.DB, driver)
+	b := testutil.CreateBooking(t, c.srv.DB, r, passenger)
+
+	invalid := metadata.AppendToOutgoingContext(t.Context(), "authorization", "Bearer not-a-token")
+
+	tests := []struct {
+		name string
+		call func() error
+		code codes.Code
+	}{
+		{
+			name: "public method without token",
+			call: func() error {
+				_, err := c.rides.GetRide(t.Context(), &pooliev1.GetRideRequest{RideId: r.ID})
+				return err
+			},
+			code: codes.OK,
+		},
+		{
+			name: "public method with invalid token",
+			call: func() error {
+				_, err := c.users.GetUserProfile(invalid, &pooliev1.GetUserProfileRequest{UserId: driver.ID})
+				return err
+			},
+			code: codes.OK,
+		},
+		{
+			name: "without token",
+			call: func() error {
+				_, err := c.bookings.GetBooking(t.Context(), &pooliev1.GetBookingRequest{BookingId: b.ID})
+				return err
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "invalid token",
+			call: func() error {
+				_, err := c.bookings.GetBooking(invalid, &pooliev1.GetBookingRequest{BookingId: b.ID})
+				return err
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "stream without token",
+			call: func() error {
+				stream, err := c.bookings.WatchBooking(t.Context(), &pooliev1.WatchBookingRequest{BookingId: b.ID})
+				if err != nil {
+					return err
+				}
+				_, err = stream.Recv()
+				return err
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "passenger",
+			call: func() error {
+				_, err := c.bookings.GetBooking(c.as(t, passenger.ID), &pooliev1.GetBookingRequest{BookingId: b.ID})
+				return err
+			},
+			code: codes.OK,
+		},
+		{
+			name: "driver",
+			call: func() error {
+				_, err := c.bookings.GetBooking(c.as(t, driver.ID), &pooliev1.GetBookingRequest{BookingId: b.ID})
+				return err
+			},
+			code: codes.OK,
+		},
+		{
+			name: "another user",
+			call: func() error {
+				_, err := c.bookings.GetBooking(c.as(t, stranger.ID), &pooliev1.GetBookingRequest{BookingId: b.ID})
+				return err
+			},
+			code: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := status.Code(tt.call()); code != tt.code {
+				t.Errorf("code = %s, want %s", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	c := newClient(t)
+	passenger := testutil.CreateUser(t, c.srv.DB)
+
+	_, err := c.bookings.CreateBooking(c.as(t, passenger.ID), &pooliev1.CreateBookingRequest{
+		RideId:         "ride_missing",
+		PassengerCount: 0,
+	})
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %s, want %s", st.Code(), codes.InvalidArgument)
+	}
+
+	var reason string
+	var fields []string
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			reason = d.Reason
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	if reason != "VALIDATION_FAILED" {
+		t.Errorf("reason = %q, want %q", reason, "VALIDATION_FAILED")
+	}
+	if len(fields) != 1 || fields[0] != "passenger_count" {
+		t.Errorf("field violations = %v, want [passenger_count]", fields)
+	}
+
+	_, err = c.bookings.CreateBooking(c.as(t, passenger.ID), &pooliev1.CreateBookingRequest{
+		RideId:         "ride_missing",
+		PassengerCount: 1,
+	})
+	if code := status.Code(err); code != codes.NotFound {
+		t.Errorf("code = %s, want %s", code, codes.NotFound)
+	}
+}
+
+func TestWatchBooking(t *testing.T) {
+	c := newClient(t)
+	driver := testutil.CreateUser(t, c.srv.DB)
+	passenger := testutil.CreateUser(t, c.srv.DB)
+	r := testutil.CreateRide(t, c.srv.DB, driver)
+
+	created, err := c.bookings.CreateBooking(c.as(t, passenger.ID), &pooliev1.CreateBookingRequest{
+		RideId:         r.ID,
+		PassengerCount: 1,
+	})
+	if err != nil {
+		t.Fatalf("failed to create booking: %v", err)
+	}
+
+	stream, err := c.bookings.WatchBooking(c.as(t, passenger.ID), &pooliev1.WatchBookingRequest{
+		BookingId: created.BookingId,
+	})
+	if err != nil {
+		t.Fatalf("failed to watch booking: %v", err)
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("failed to receive booking: %v", err)
+	}
+	if first.Status != bookings.StatusPending {
+		t.Errorf("first status = %q, want %q", first.Status, bookings.StatusPending)
+	}
+
+	if _, err := c.bookings.RespondToBooking(c.as(t, driver.ID), &pooliev1.RespondToBookingRequest{
+		BookingId: created.BookingId,
+		Action:    "reject",
+	}); err != nil {
+		t.Fatalf("failed to reject booking: %v", err)
+	}
+
+	// Rejected is final, so the stream ends after sending it
+	var statuses []string
+	for {
+		b, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("failed to receive booking: %v", err)
+		}
+		statuses = append(statuses, b.Status)
+	}
+	if len(statuses) == 0 || statuses[len(statuses)-1] != bookings.StatusRejected {
+		t.Errorf("statuses = %v, want to end with %q", statuses, bookings.StatusRejected)
+	}
+}
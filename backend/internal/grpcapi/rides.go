@@ -0,0 +1,174 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pooliev1 "github.com/slowtyper/poolie/backend/api/poolie/v1"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/cancellation"
+	"github.com/slowtyper/poolie/backend/internal/currency"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+	"github.com/slowtyper/poolie/backend/internal/rides"
+	"github.com/slowtyper/poolie/backend/internal/validate"
+)
+
+// rideServer implements RideService
+type rideServer struct {
+	pooliev1.UnimplementedRideServiceServer
+	rides *rides.Service
+}
+
+func (s *rideServer) SearchRides(ctx context.Context, req *pooliev1.SearchRidesRequest) (*pooliev1.SearchRidesResponse, error) {
+	date, err := validate.SearchRides(&models.SearchRidesRequest{
+		Origin:      req.Origin,
+		Destination: req.Destination,
+		Date:        req.Date,
+		Passengers:  int(req.Passengers),
+		Type:        req.Type,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := s.rides.Search(ctx, rides.SearchParams{
+		Origin:      req.Origin,
+		Destination: req.Destination,
+		Date:        date,
+		Type:        req.Type,
+	})
+	if err != nil {
+		return nil, apperr.Internal("Failed to search rides", err)
+	}
+
+	resp := &pooliev1.SearchRidesResponse{
+		TotalCount: int32(len(found)),
+		Rides:      make([]*pooliev1.RidePreview, 0, len(found)),
+	}
+	for _, r := range found {
+		switch r.Type {
+		case "carpool":
+			resp.CarpoolCount++
+		case "bus":
+			resp.BusCount++
+		}
+		resp.Rides = append(resp.Rides, toRidePreview(r))
+	}
+	return resp, nil
+}
+
+func (s *rideServer) GetRide(ctx context.Context, req *pooliev1.GetRideRequest) (*pooliev1.Ride, error) {
+	r, err := s.rides.Get(ctx, req.RideId)
+	if err != nil {
+		return nil, rideError(err, "Failed to get ride")
+	}
+	return toRide(r, requestctx.Logger(ctx)), nil
+}
+
+func (s *rideServer) CreateRide(ctx context.Context, req *pooliev1.CreateRideRequest) (*pooliev1.Ride, error) {
+	r := models.CreateRideRequest{
+		RideType:           req.RideType,
+		Origin:             fromLocation(req.Origin),
+		Destination:        fromLocation(req.Destination),
+		AvailableSeats:     int(req.AvailableSeats),
+		Description:        req.Description,
+		CancellationPolicy: req.CancellationPolicy,
+	}
+	if req.DepartureTime != nil {
+		r.DepartureTime = req.DepartureTime.AsTime()
+	}
+	if req.ArrivalTime != nil {
+		arrival := req.ArrivalTime.AsTime()
+		r.ArrivalTime = &arrival
+	}
+	if req.PricePerSeat != nil {
+		r.PricePerSeat = models.Price{Amount: req.PricePerSeat.Amount, Currency: req.PricePerSeat.Currency}
+	}
+	if req.Recurrence != nil {
+		r.Recurrence = &models.Recurrence{
+			DaysOfWeek: req.Recurrence.DaysOfWeek,
+			StartDate:  req.Recurrence.StartDate,
+			EndDate:    req.Recurrence.EndDate,
+		}
+	}
+
+	// Apply defaults before validating
+	if r.RideType == "" {
+		r.RideType = rides.TypeOneTime
+	}
+	if r.CancellationPolicy == "" {
+		r.CancellationPolicy = cancellation.Default
+	}
+	r.PricePerSeat.Currency = currency.Normalize(r.PricePerSeat.Currency)
+	if r.PricePerSeat.Currency == "" {
+		r.PricePerSeat.Currency = currency.Default
+	}
+	if err := validate.CreateRide(&r, time.Now()); err != nil {
+		return nil, err
+	}
+
+	newRide := rides.NewRide{
+		DriverID:           requestctx.UserID(ctx),
+		RideType:           r.RideType,
+		DepartureTime:      r.DepartureTime,
+		ArrivalTime:        r.ArrivalTime,
+		Origin:             rides.Place(r.Origin),
+		Destination:        rides.Place(r.Destination),
+		PriceAmount:        r.PricePerSeat.Amount,
+		PriceCurrency:      r.PricePerSeat.Currency,
+		Seats:              r.AvailableSeats,
+		Description:        r.Description,
+		CancellationPolicy: r.CancellationPolicy,
+	}
+	if r.Recurrence != nil {
+		newRide.Recurrence = &rides.Recurrence{
+			DaysOfWeek: r.Recurrence.DaysOfWeek,
+			StartDate:  r.Recurrence.StartDate,
+			EndDate:    r.Recurrence.EndDate,
+		}
+	}
+	if req.Amenities != nil {
+		newRide.Amenities = map[string]interface{}{
+			"smoking_allowed": req.Amenities.SmokingAllowed,
+			"air_conditioner": req.Amenities.AirConditioner,
+		}
+	}
+
+	created, err := s.rides.Create(ctx, newRide)
+	if err != nil {
+		return nil, err
+	}
+	return toRide(created, requestctx.Logger(ctx)), nil
+}
+
+func (s *rideServer) CompleteRide(ctx context.Context, req *pooliev1.CompleteRideRequest) (*pooliev1.Ride, error) {
+	completed, err := s.rides.Complete(ctx, requestctx.UserID(ctx), req.RideId)
+	if err != nil {
+		switch {
+		case errors.Is(err, rides.ErrForbidden):
+			return nil, apperr.Forbidden("You are not authorized to complete this ride")
+		case errors.Is(err, rides.ErrCannotComplete):
+			return nil, apperr.Conflict("CANNOT_COMPLETE", "Only active rides that have departed can be completed")
+		}
+		return nil, rideError(err, "Failed to complete ride")
+	}
+	return toRide(completed, requestctx.Logger(ctx)), nil
+}
+
+// rideError converts a rides.Service error not specific to one method,
+// reporting anything unexpected as message
+func rideError(err error, message string) error {
+	if errors.Is(err, rides.ErrNotFound) {
+		return apperr.NotFound("Ride not found")
+	}
+	return apperr.Internal(message, err)
+}
+
+func fromLocation(loc *pooliev1.Location) models.Location {
+	if loc == nil {
+		return models.Location{}
+	}
+	return models.Location{City: loc.City, Address: loc.Address, LocationPoint: loc.LocationPoint}
+}
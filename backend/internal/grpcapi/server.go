@@ -0,0 +1,55 @@
+// Package grpcapi serves the rides, bookings and users services over gRPC,
+// next to the REST API and authenticated with the same bearer tokens
+package grpcapi
+
+import (
+	"time"
+
+	pooliev1 "github.com/slowtyper/poolie/backend/api/poolie/v1"
+	"github.com/slowtyper/poolie/backend/internal/auth"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+	"github.com/slowtyper/poolie/backend/internal/rides"
+	"github.com/slowtyper/poolie/backend/internal/users"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// defaultWatchInterval is how often WatchBooking checks for changes unless
+// configured otherwise
+const defaultWatchInterval = 2 * time.Second
+
+// Services are the services exposed over gRPC
+type Services struct {
+	Rides    *rides.Service
+	Bookings *bookings.Service
+	Users    *users.Service
+}
+
+// Config tunes the gRPC server. WatchInterval defaults to two seconds.
+type Config struct {
+	Tokens        *auth.Tokens
+	WatchInterval time.Duration
+}
+
+// New creates a gRPC server exposing s. Callers pass their token as
+// "authorization: Bearer <token>" metadata.
+func New(s Services, cfg Config, log *zap.Logger) *grpc.Server {
+	if cfg.WatchInterval <= 0 {
+		cfg.WatchInterval = defaultWatchInterval
+	}
+
+	a := &authenticator{tokens: cfg.Tokens}
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryErrors(log), a.unary),
+		grpc.ChainStreamInterceptor(streamErrors(log), a.stream),
+	)
+
+	pooliev1.RegisterRideServiceServer(srv, &rideServer{rides: s.Rides})
+	pooliev1.RegisterBookingServiceServer(srv, &bookingServer{
+		bookings:      s.Bookings,
+		watchInterval: cfg.WatchInterval,
+	})
+	pooliev1.RegisterUserServiceServer(srv, &userServer{users: s.Users})
+
+	return srv
+}
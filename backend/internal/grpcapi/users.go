@@ -0,0 +1,27 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+
+	pooliev1 "github.com/slowtyper/poolie/backend/api/poolie/v1"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/users"
+)
+
+// userServer implements UserService
+type userServer struct {
+	pooliev1.UnimplementedUserServiceServer
+	users *users.Service
+}
+
+func (s *userServer) GetUserProfile(ctx context.Context, req *pooliev1.GetUserProfileRequest) (*pooliev1.UserProfile, error) {
+	u, err := s.users.Get(ctx, req.UserId)
+	if err != nil {
+		if errors.Is(err, users.ErrNotFound) {
+			return nil, apperr.NotFound("User not found")
+		}
+		return nil, apperr.Internal("Failed to get user profile", err)
+	}
+	return toUserProfile(u), nil
+}
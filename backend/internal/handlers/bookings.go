@@ -1,29 +1,28 @@
 package handlers
 
 import (
-	"context"
-	"time"
+	"errors"
 
 	"github.com/gofiber/fiber/v3"
-	"github.com/google/uuid"
 	"github.com/slowtyper/poolie/backend/ent"
-	"github.com/slowtyper/poolie/backend/ent/booking"
-	"github.com/slowtyper/poolie/backend/ent/ride"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+	"github.com/slowtyper/poolie/backend/internal/currency"
 	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/promotions"
+	"github.com/slowtyper/poolie/backend/internal/validate"
 	"go.uber.org/zap"
 )
 
 // BookingHandler handles booking-related HTTP requests
 type BookingHandler struct {
-	db     *ent.Client
-	logger *zap.Logger
+	bookings *bookings.Service
 }
 
 // NewBookingHandler creates a new BookingHandler
-func NewBookingHandler(db *ent.Client, logger *zap.Logger) *BookingHandler {
+func NewBookingHandler(bookings *bookings.Service) *BookingHandler {
 	return &BookingHandler{
-		db:     db,
-		logger: logger,
+		bookings: bookings,
 	}
 }
 
@@ -33,120 +32,49 @@ func (h *BookingHandler) CreateBooking(c fiber.Ctx) error {
 
 	// Fiber v3: Use Bind().Body() instead of BodyParser
 	if err := c.Bind().Body(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INVALID_REQUEST",
-				Message: "Invalid request body",
-			},
-		})
+		return apperr.BadRequest("INVALID_REQUEST", "Invalid request body")
 	}
 
-	// Validate required fields
-	if req.RideID == "" || req.PassengerCount < 1 {
-		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INVALID_REQUEST",
-				Message: "ride_id and passenger_count (minimum 1) are required",
-			},
-		})
+	req.DisplayCurrency = currency.Normalize(req.DisplayCurrency)
+	if err := validate.CreateBooking(&req); err != nil {
+		return err
 	}
 
 	// Get user ID from context (set by auth middleware)
 	userID := c.Locals("user_id").(string)
 
-	ctx := context.Background()
-
-	// Check if ride exists and has enough seats
-	r, err := h.db.Ride.Query().
-		Where(ride.IDEQ(req.RideID)).
-		WithDriver().
-		Only(ctx)
-
+	created, err := h.bookings.Create(c.UserContext(), bookings.NewBooking{
+		RideID:          req.RideID,
+		PassengerID:     userID,
+		PassengerCount:  req.PassengerCount,
+		Message:         req.Message,
+		PromoCode:       req.PromoCode,
+		DisplayCurrency: req.DisplayCurrency,
+	})
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
-				Error: models.ErrorDetail{
-					Code:    "NOT_FOUND",
-					Message: "Ride not found",
-				},
-			})
+		var promoErr *promotions.Error
+		switch {
+		case errors.Is(err, bookings.ErrRideNotFound):
+			return apperr.NotFound("Ride not found")
+		case errors.Is(err, bookings.ErrRideNotAvailable):
+			return apperr.Conflict("RIDE_NOT_AVAILABLE", "Ride is no longer available")
+		case errors.Is(err, bookings.ErrInsufficientSeats):
+			return apperr.Conflict("INSUFFICIENT_SEATS", "Not enough available seats")
+		case errors.Is(err, bookings.ErrPaymentFailed):
+			requestLogger(c).Error("failed to authorize payment", zap.Error(err))
+			return apperr.New(fiber.StatusPaymentRequired, "PAYMENT_FAILED", "Failed to authorize payment for booking")
+		case errors.As(err, &promoErr):
+			return apperr.BadRequest(promoErr.Code, promoErr.Message).Wrap(err)
+		case errors.Is(err, currency.ErrNoRate):
+			return conversionError(err)
+		case ent.IsValidationError(err):
+			// Schema validation failures are reported with the offending field
+			return err
 		}
-		h.logger.Error("failed to fetch ride", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INTERNAL_ERROR",
-				Message: "Failed to fetch ride details",
-			},
-		})
+		return apperr.Internal("Failed to create booking", err)
 	}
 
-	// Check if ride is active
-	if r.Status != "active" {
-		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "RIDE_NOT_AVAILABLE",
-				Message: "Ride is no longer available",
-			},
-		})
-	}
-
-	// Check available seats
-	if r.AvailableSeats < req.PassengerCount {
-		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INSUFFICIENT_SEATS",
-				Message: "Not enough available seats",
-			},
-		})
-	}
-
-	// Calculate total price
-	totalPrice := r.PriceAmount * int64(req.PassengerCount)
-
-	// Create booking
-	bookingID := "booking_" + uuid.New().String()[:8]
-
-	builder := h.db.Booking.Create().
-		SetID(bookingID).
-		SetRideID(req.RideID).
-		SetPassengerID(userID).
-		SetStatus("pending").
-		SetPassengerCount(req.PassengerCount).
-		SetTotalPriceAmount(totalPrice).
-		SetTotalPriceCurrency(r.PriceCurrency)
-
-	if req.Message != "" {
-		builder = builder.SetMessage(req.Message)
-	}
-
-	newBooking, err := builder.Save(ctx)
-	if err != nil {
-		h.logger.Error("failed to create booking", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INTERNAL_ERROR",
-				Message: "Failed to create booking",
-			},
-		})
-	}
-
-	// Fetch the created booking with relations
-	createdBooking, err := h.db.Booking.Query().
-		Where(booking.IDEQ(newBooking.ID)).
-		WithRide().
-		Only(ctx)
-
-	if err != nil {
-		h.logger.Error("failed to fetch created booking", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INTERNAL_ERROR",
-				Message: "Booking created but failed to fetch details",
-			},
-		})
-	}
-
-	response := h.transformToBookingResponse(createdBooking)
+	response := h.transformToBookingResponse(created)
 	return c.Status(fiber.StatusCreated).JSON(response)
 }
 
@@ -157,129 +85,77 @@ func (h *BookingHandler) RespondToBooking(c fiber.Ctx) error {
 	var req models.RespondToBookingRequest
 	// Fiber v3: Use Bind().Body() instead of BodyParser
 	if err := c.Bind().Body(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INVALID_REQUEST",
-				Message: "Invalid request body",
-			},
-		})
+		return apperr.BadRequest("INVALID_REQUEST", "Invalid request body")
 	}
 
-	// Validate action
-	if req.Action != "accept" && req.Action != "reject" {
-		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INVALID_ACTION",
-				Message: "action must be 'accept' or 'reject'",
-			},
-		})
+	if err := validate.RespondToBooking(&req); err != nil {
+		return err
 	}
 
 	// Get user ID from context (set by auth middleware)
 	userID := c.Locals("user_id").(string)
 
-	ctx := context.Background()
-
-	// Fetch booking with ride
-	b, err := h.db.Booking.Query().
-		Where(booking.IDEQ(bookingID)).
-		WithRide(func(q *ent.RideQuery) {
-			q.WithDriver()
-		}).
-		Only(ctx)
-
+	updated, err := h.bookings.RespondAs(c.UserContext(), userID, bookingID, req.Action == "accept", req.Message)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
-				Error: models.ErrorDetail{
-					Code:    "NOT_FOUND",
-					Message: "Booking not found",
-				},
-			})
+		switch {
+		case errors.Is(err, bookings.ErrForbidden):
+			return apperr.Forbidden("You are not authorized to respond to this booking")
+		case errors.Is(err, bookings.ErrInvalidTransition):
+			return apperr.Conflict("ALREADY_RESPONDED", "Booking has already been responded to")
+		case errors.Is(err, bookings.ErrInsufficientSeats):
+			return apperr.Conflict("INSUFFICIENT_SEATS", "Not enough available seats")
+		case errors.Is(err, bookings.ErrPaymentFailed):
+			requestLogger(c).Error("failed to capture payment", zap.Error(err))
+			return apperr.New(fiber.StatusPaymentRequired, "PAYMENT_FAILED", "Failed to capture payment for booking")
 		}
-		h.logger.Error("failed to fetch booking", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INTERNAL_ERROR",
-				Message: "Failed to fetch booking",
-			},
-		})
+		return bookingError(err, "Failed to update booking")
 	}
 
-	// Check if user is the driver of the ride
-	if b.Edges.Ride.DriverID != userID {
-		return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "FORBIDDEN",
-				Message: "You are not authorized to respond to this booking",
-			},
-		})
-	}
+	response := h.transformToBookingResponse(updated)
+	return c.JSON(response)
+}
 
-	// Check if booking is still pending
-	if b.Status != "pending" {
-		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "ALREADY_RESPONDED",
-				Message: "Booking has already been responded to",
-			},
-		})
-	}
+// CancelBooking handles POST /bookings/:bookingId/cancel
+func (h *BookingHandler) CancelBooking(c fiber.Ctx) error {
+	bookingID := c.Params("bookingId")
 
-	// Update booking status
-	newStatus := "confirmed"
-	if req.Action == "reject" {
-		newStatus = "rejected"
+	var req models.CancelBookingRequest
+	if len(c.Body()) > 0 {
+		if err := c.Bind().Body(&req); err != nil {
+			return apperr.BadRequest("INVALID_REQUEST", "Invalid request body")
+		}
 	}
-
-	respondedAt := time.Now()
-	updateBuilder := b.Update().
-		SetStatus(newStatus).
-		SetRespondedAt(respondedAt)
-
-	if req.Message != "" {
-		updateBuilder = updateBuilder.SetDriverResponseMessage(req.Message)
+	if err := validate.CancelBooking(&req); err != nil {
+		return err
 	}
 
-	updatedBooking, err := updateBuilder.Save(ctx)
-	if err != nil {
-		h.logger.Error("failed to update booking", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INTERNAL_ERROR",
-				Message: "Failed to update booking",
-			},
-		})
-	}
+	// Get user ID from context (set by auth middleware)
+	userID := c.Locals("user_id").(string)
 
-	// If accepted, update ride available seats
-	if req.Action == "accept" {
-		_, err := b.Edges.Ride.Update().
-			SetAvailableSeats(b.Edges.Ride.AvailableSeats - b.PassengerCount).
-			Save(ctx)
-		if err != nil {
-			h.logger.Error("failed to update ride seats", zap.Error(err))
+	cancelled, err := h.bookings.CancelAs(c.UserContext(), userID, bookingID, req.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, bookings.ErrForbidden):
+			return apperr.Forbidden("You are not authorized to cancel this booking")
+		case errors.Is(err, bookings.ErrInvalidTransition):
+			return apperr.Conflict("CANNOT_CANCEL", "Only pending or confirmed bookings can be cancelled")
+		case errors.Is(err, bookings.ErrRideDeparted):
+			return apperr.Conflict("RIDE_DEPARTED", "Bookings cannot be cancelled after departure")
 		}
+		return bookingError(err, "Failed to cancel booking")
 	}
 
-	// Fetch updated booking with relations
-	finalBooking, err := h.db.Booking.Query().
-		Where(booking.IDEQ(updatedBooking.ID)).
-		WithRide().
-		Only(ctx)
+	response := h.transformToBookingResponse(cancelled)
+	return c.JSON(response)
+}
 
-	if err != nil {
-		h.logger.Error("failed to fetch updated booking", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INTERNAL_ERROR",
-				Message: "Booking updated but failed to fetch details",
-			},
-		})
+// bookingError converts a bookings.Service error not specific to one
+// handler, reporting anything unexpected as message
+func bookingError(err error, message string) error {
+	if errors.Is(err, bookings.ErrNotFound) {
+		return apperr.NotFound("Booking not found")
 	}
-
-	response := h.transformToBookingResponse(finalBooking)
-	return c.JSON(response)
+	return apperr.Internal(message, err)
 }
 
 // Helper function to transform booking entity to response model
@@ -296,10 +172,51 @@ func (h *BookingHandler) transformToBookingResponse(b *ent.Booking) models.Booki
 		CreatedAt: b.CreatedAt,
 	}
 
+	subtotal := b.SubtotalAmount
+	if subtotal == 0 {
+		subtotal = b.TotalPriceAmount
+	}
+	response.PriceBreakdown = models.PriceBreakdown{
+		Subtotal:  models.Price{Amount: subtotal, Currency: b.TotalPriceCurrency},
+		PromoCode: b.PromoCode,
+		Discount:  models.Price{Amount: b.DiscountAmount, Currency: b.TotalPriceCurrency},
+		Credits:   models.Price{Amount: b.CreditAmount, Currency: b.TotalPriceCurrency},
+		Total:     models.Price{Amount: b.TotalPriceAmount, Currency: b.TotalPriceCurrency},
+	}
+
+	if b.DisplayTotalAmount != nil && b.ExchangeRateAsOf != nil {
+		response.DisplayPrice = &models.DisplayPrice{
+			Amount:       *b.DisplayTotalAmount,
+			Currency:     b.DisplayCurrency,
+			ExchangeRate: b.ExchangeRate,
+			RateAsOf:     *b.ExchangeRateAsOf,
+		}
+	}
+
 	if b.RespondedAt != nil {
 		response.RespondedAt = b.RespondedAt
 	}
 
+	if b.Edges.Payment != nil {
+		response.PaymentStatus = b.Edges.Payment.Status
+	}
+
+	if b.CancelledAt != nil {
+		response.Cancellation = &models.BookingCancellation{
+			CancelledBy: b.CancelledBy,
+			CancelledAt: *b.CancelledAt,
+			Reason:      b.CancellationReason,
+			Refund: models.Price{
+				Amount:   b.RefundAmount,
+				Currency: b.TotalPriceCurrency,
+			},
+			Penalty: models.Price{
+				Amount:   b.PenaltyAmount,
+				Currency: b.TotalPriceCurrency,
+			},
+		}
+	}
+
 	if b.Edges.Ride != nil {
 		response.RideDetails = models.RideSummary{
 			RideID:        b.Edges.Ride.ID,
@@ -0,0 +1,612 @@
+package handlers_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/credit"
+	"github.com/slowtyper/poolie/backend/ent/ledgerentry"
+	"github.com/slowtyper/poolie/backend/ent/payment"
+	"github.com/slowtyper/poolie/backend/ent/promoredemption"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+	"github.com/slowtyper/poolie/backend/internal/ledger"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/payments"
+	"github.com/slowtyper/poolie/backend/internal/promotions"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+)
+
+// book creates a booking through the API, so its payment is authorized
+func book(t *testing.T, srv *testutil.Server, r *ent.Ride, passenger *ent.User, count int) models.BookingResponse {
+	t.Helper()
+
+	resp := srv.Post(t, "/v1/bookings", passenger.ID, models.CreateBookingRequest{
+		RideID:         r.ID,
+		PassengerCount: count,
+	})
+	if resp.Status != fiber.StatusCreated {
+		t.Fatalf("failed to book ride: status %d\n%s", resp.Status, resp.Body)
+	}
+	var b models.BookingResponse
+	resp.Decode(t, &b)
+	return b
+}
+
+func TestCreateBooking(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+	passenger := testutil.CreateUser(t, srv.DB)
+	open := testutil.CreateRide(t, srv.DB, driver)
+	lastSeat := testutil.CreateRide(t, srv.DB, driver, func(r *ent.RideCreate) {
+		r.SetAvailableSeats(1)
+	})
+	cancelled := testutil.CreateRide(t, srv.DB, driver, func(r *ent.RideCreate) {
+		r.SetStatus("cancelled")
+	})
+
+	tests := []struct {
+		name   string
+		as     string
+		header map[string]string
+		req    models.CreateBookingRequest
+		status int
+		code   string
+		total  int64
+		// display is the expected display currency, if any
+		display string
+	}{
+		{
+			name:   "books seats",
+			as:     passenger.ID,
+			req:    models.CreateBookingRequest{RideID: open.ID, PassengerCount: 2, Message: "Two of us"},
+			status: fiber.StatusCreated,
+			total:  2 * open.PriceAmount,
+		},
+		{
+			name:    "display currency",
+			as:      passenger.ID,
+			req:     models.CreateBookingRequest{RideID: open.ID, PassengerCount: 1, DisplayCurrency: "usd"},
+			status:  fiber.StatusCreated,
+			total:   open.PriceAmount,
+			display: "USD",
+		},
+		{
+			name:   "last seat",
+			as:     passenger.ID,
+			req:    models.CreateBookingRequest{RideID: lastSeat.ID, PassengerCount: 1},
+			status: fiber.StatusCreated,
+			total:  lastSeat.PriceAmount,
+		},
+		{
+			name:   "not enough seats",
+			as:     passenger.ID,
+			req:    models.CreateBookingRequest{RideID: lastSeat.ID, PassengerCount: 2},
+			status: fiber.StatusConflict,
+			code:   "INSUFFICIENT_SEATS",
+		},
+		{
+			name:   "cancelled ride",
+			as:     passenger.ID,
+			req:    models.CreateBookingRequest{RideID: cancelled.ID, PassengerCount: 1},
+			status: fiber.StatusConflict,
+			code:   "RIDE_NOT_AVAILABLE",
+		},
+		{
+			name:   "unknown ride",
+			as:     passenger.ID,
+			req:    models.CreateBookingRequest{RideID: "ride_missing", PassengerCount: 1},
+			status: fiber.StatusNotFound,
+			code:   "NOT_FOUND",
+		},
+		{
+			name:   "no passengers",
+			as:     passenger.ID,
+			req:    models.CreateBookingRequest{RideID: open.ID},
+			status: fiber.StatusBadRequest,
+			code:   "VALIDATION_FAILED",
+		},
+		{
+			name:   "unknown display currency",
+			as:     passenger.ID,
+			req:    models.CreateBookingRequest{RideID: open.ID, PassengerCount: 1, DisplayCurrency: "XYZ"},
+			status: fiber.StatusBadRequest,
+			code:   "VALIDATION_FAILED",
+		},
+		{
+			name:   "unknown promo code",
+			as:     passenger.ID,
+			req:    models.CreateBookingRequest{RideID: open.ID, PassengerCount: 1, PromoCode: "NOPE"},
+			status: fiber.StatusBadRequest,
+			code:   "PROMO_NOT_FOUND",
+		},
+		{
+			name:   "anonymous",
+			req:    models.CreateBookingRequest{RideID: open.ID, PassengerCount: 1},
+			status: fiber.StatusUnauthorized,
+			code:   "UNAUTHORIZED",
+		},
+		{
+			name:   "invalid token",
+			header: map[string]string{fiber.HeaderAuthorization: "Bearer not-a-token"},
+			req:    models.CreateBookingRequest{RideID: open.ID, PassengerCount: 1},
+			status: fiber.StatusUnauthorized,
+			code:   "UNAUTHORIZED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := srv.Do(t, testutil.Request{
+				Method: fiber.MethodPost,
+				Path:   "/v1/bookings",
+				Body:   tt.req,
+				As:     tt.as,
+				Header: tt.header,
+			})
+			if resp.Status != tt.status {
+				t.Fatalf("status = %d, want %d\n%s", resp.Status, tt.status, resp.Body)
+			}
+			if tt.code != "" {
+				if code := resp.ErrorCode(); code != tt.code {
+					t.Errorf("error code = %q, want %q", code, tt.code)
+				}
+				return
+			}
+
+			var b models.BookingResponse
+			resp.Decode(t, &b)
+			if b.Status != bookings.StatusPending {
+				t.Errorf("status = %q, want %q", b.Status, bookings.StatusPending)
+			}
+			if b.TotalPrice.Amount != tt.total {
+				t.Errorf("total = %d, want %d", b.TotalPrice.Amount, tt.total)
+			}
+			if b.PaymentStatus != payments.StatusRequiresCapture {
+				t.Errorf("payment status = %q, want %q", b.PaymentStatus, payments.StatusRequiresCapture)
+			}
+			switch {
+			case tt.display == "" && b.DisplayPrice != nil:
+				t.Errorf("display price = %+v, want none", b.DisplayPrice)
+			case tt.display != "" && (b.DisplayPrice == nil || b.DisplayPrice.Currency != tt.display):
+				t.Errorf("display price = %+v, want %s", b.DisplayPrice, tt.display)
+			}
+
+			stored := srv.DB.Booking.GetX(t.Context(), b.BookingID)
+			if stored.PassengerID != passenger.ID {
+				t.Errorf("passenger = %q, want %q", stored.PassengerID, passenger.ID)
+			}
+		})
+	}
+
+	// Seats are only taken once the driver accepts
+	if seats := srv.DB.Ride.GetX(t.Context(), lastSeat.ID).AvailableSeats; seats != 1 {
+		t.Errorf("available seats = %d after booking, want 1", seats)
+	}
+}
+
+func TestRespondToBooking(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+	otherDriver := testutil.CreateUser(t, srv.DB)
+	passenger := testutil.CreateUser(t, srv.DB)
+
+	tests := []struct {
+		name string
+		as   string
+		// booking returns the booking to respond to on a fresh 3-seat ride
+		// by driver
+		booking func(t *testing.T, r *ent.Ride) string
+		req     models.RespondToBookingRequest
+		status  int
+		code    string
+		// want are the booking, payment and ride seats after a response
+		wantStatus  string
+		wantPayment string
+		wantSeats   int
+	}{
+		{
+			name: "accept",
+			as:   driver.ID,
+			booking: func(t *testing.T, r *ent.Ride) string {
+				return book(t, srv, r, passenger, 2).BookingID
+			},
+			req:         models.RespondToBookingRequest{Action: "accept", Message: "See you there"},
+			status:      fiber.StatusOK,
+			wantStatus:  bookings.StatusConfirmed,
+			wantPayment: payments.StatusSucceeded,
+			wantSeats:   1,
+		},
+		{
+			name: "reject",
+			as:   driver.ID,
+			booking: func(t *testing.T, r *ent.Ride) string {
+				return book(t, srv, r, passenger, 2).BookingID
+			},
+			req:         models.RespondToBookingRequest{Action: "reject"},
+			status:      fiber.StatusOK,
+			wantStatus:  bookings.StatusRejected,
+			wantPayment: payments.StatusCanceled,
+			wantSeats:   3,
+		},
+		{
+			name: "accept without payment",
+			as:   driver.ID,
+			booking: func(t *testing.T, r *ent.Ride) string {
+				return testutil.CreateBooking(t, srv.DB, r, passenger).ID
+			},
+			req:        models.RespondToBookingRequest{Action: "accept"},
+			status:     fiber.StatusOK,
+			wantStatus: bookings.StatusConfirmed,
+			wantSeats:  2,
+		},
+		{
+			name: "already responded",
+			as:   driver.ID,
+			booking: func(t *testing.T, r *ent.Ride) string {
+				return testutil.CreateBooking(t, srv.DB, r, passenger, func(b *ent.BookingCreate) {
+					b.SetStatus(bookings.StatusConfirmed)
+				}).ID
+			},
+			req:    models.RespondToBookingRequest{Action: "reject"},
+			status: fiber.StatusConflict,
+			code:   "ALREADY_RESPONDED",
+		},
+		{
+			name: "passenger",
+			as:   passenger.ID,
+			booking: func(t *testing.T, r *ent.Ride) string {
+				return testutil.CreateBooking(t, srv.DB, r, passenger).ID
+			},
+			req:    models.RespondToBookingRequest{Action: "accept"},
+			status: fiber.StatusForbidden,
+			code:   "FORBIDDEN",
+		},
+		{
+			name: "another driver",
+			as:   otherDriver.ID,
+			booking: func(t *testing.T, r *ent.Ride) string {
+				return testutil.CreateBooking(t, srv.DB, r, passenger).ID
+			},
+			req:    models.RespondToBookingRequest{Action: "accept"},
+			status: fiber.StatusForbidden,
+			code:   "FORBIDDEN",
+		},
+		{
+			name: "unknown action",
+			as:   driver.ID,
+			booking: func(t *testing.T, r *ent.Ride) string {
+				return testutil.CreateBooking(t, srv.DB, r, passenger).ID
+			},
+			req:    models.RespondToBookingRequest{Action: "maybe"},
+			status: fiber.StatusBadRequest,
+			code:   "VALIDATION_FAILED",
+		},
+		{
+			name: "unknown booking",
+			as:   driver.ID,
+			booking: func(t *testing.T, r *ent.Ride) string {
+				return "booking_missing"
+			},
+			req:    models.RespondToBookingRequest{Action: "accept"},
+			status: fiber.StatusNotFound,
+			code:   "NOT_FOUND",
+		},
+		{
+			name: "anonymous",
+			booking: func(t *testing.T, r *ent.Ride) string {
+				return testutil.CreateBooking(t, srv.DB, r, passenger).ID
+			},
+			req:    models.RespondToBookingRequest{Action: "accept"},
+			status: fiber.StatusUnauthorized,
+			code:   "UNAUTHORIZED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testutil.CreateRide(t, srv.DB, driver)
+			bookingID := tt.booking(t, r)
+
+			resp := srv.Post(t, "/v1/bookings/"+bookingID+"/respond", tt.as, tt.req)
+			if resp.Status != tt.status {
+				t.Fatalf("status = %d, want %d\n%s", resp.Status, tt.status, resp.Body)
+			}
+			if tt.code != "" {
+				if code := resp.ErrorCode(); code != tt.code {
+					t.Errorf("error code = %q, want %q", code, tt.code)
+				}
+				return
+			}
+
+			var b models.BookingResponse
+			resp.Decode(t, &b)
+			if b.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", b.Status, tt.wantStatus)
+			}
+			if b.RespondedAt == nil {
+				t.Error("responded_at is not set")
+			}
+
+			ctx := t.Context()
+			if seats := srv.DB.Ride.GetX(ctx, r.ID).AvailableSeats; seats != tt.wantSeats {
+				t.Errorf("available seats = %d, want %d", seats, tt.wantSeats)
+			}
+			if tt.wantPayment != "" {
+				p := srv.DB.Payment.Query().Where(payment.BookingIDEQ(bookingID)).OnlyX(ctx)
+				if p.Status != tt.wantPayment {
+					t.Errorf("payment status = %q, want %q", p.Status, tt.wantPayment)
+				}
+			}
+			if tt.req.Message != "" {
+				stored := srv.DB.Booking.GetX(ctx, bookingID)
+				if stored.DriverResponseMessage != tt.req.Message {
+					t.Errorf("driver message = %q, want %q", stored.DriverResponseMessage, tt.req.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestRespondToBookingWithoutSeats(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+	passenger := testutil.CreateUser(t, srv.DB)
+	r := testutil.CreateRide(t, srv.DB, driver)
+	b := book(t, srv, r, passenger, 2)
+
+	// Other bookings were confirmed after this one was made
+	srv.DB.Ride.UpdateOne(r).SetAvailableSeats(1).ExecX(t.Context())
+
+	resp := srv.Post(t, "/v1/bookings/"+b.BookingID+"/respond", driver.ID, models.RespondToBookingRequest{Action: "accept"})
+	if resp.Status != fiber.StatusConflict {
+		t.Fatalf("status = %d, want %d\n%s", resp.Status, fiber.StatusConflict, resp.Body)
+	}
+	if code := resp.ErrorCode(); code != "INSUFFICIENT_SEATS" {
+		t.Errorf("error code = %q, want INSUFFICIENT_SEATS", code)
+	}
+
+	ctx := t.Context()
+	if status := srv.DB.Booking.GetX(ctx, b.BookingID).Status; status != bookings.StatusPending {
+		t.Errorf("status = %q, want %q", status, bookings.StatusPending)
+	}
+	if seats := srv.DB.Ride.GetX(ctx, r.ID).AvailableSeats; seats != 1 {
+		t.Errorf("available seats = %d, want 1", seats)
+	}
+	p := srv.DB.Payment.Query().Where(payment.BookingIDEQ(b.BookingID)).OnlyX(ctx)
+	if p.Status != payments.StatusRequiresCapture {
+		t.Errorf("payment status = %q, want %q", p.Status, payments.StatusRequiresCapture)
+	}
+}
+
+func TestCreateBookingPaymentDeclined(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+	passenger := testutil.CreateUser(t, srv.DB)
+	r := testutil.CreateRide(t, srv.DB, driver)
+
+	srv.Payments.FailNext(fmt.Errorf("%w: insufficient funds", payments.ErrDeclined))
+	resp := srv.Post(t, "/v1/bookings", passenger.ID, models.CreateBookingRequest{
+		RideID:         r.ID,
+		PassengerCount: 1,
+	})
+	if resp.Status != fiber.StatusPaymentRequired {
+		t.Fatalf("status = %d, want %d\n%s", resp.Status, fiber.StatusPaymentRequired, resp.Body)
+	}
+
+	ctx := t.Context()
+	b := srv.DB.Booking.Query().WithPayment().OnlyX(ctx)
+	if b.Status != bookings.StatusFailed {
+		t.Errorf("status = %q, want %q", b.Status, bookings.StatusFailed)
+	}
+	if p := b.Edges.Payment; p == nil || p.Status != payments.StatusFailed {
+		t.Errorf("payment = %+v, want status %q", p, payments.StatusFailed)
+	}
+}
+
+func TestRespondToBookingCaptureFails(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+	passenger := testutil.CreateUser(t, srv.DB)
+	r := testutil.CreateRide(t, srv.DB, driver)
+	b := book(t, srv, r, passenger, 2)
+
+	srv.Payments.FailNext(errors.New("provider unavailable"))
+	resp := srv.Post(t, "/v1/bookings/"+b.BookingID+"/respond", driver.ID, models.RespondToBookingRequest{Action: "accept", Message: "See you"})
+	if resp.Status != fiber.StatusPaymentRequired {
+		t.Fatalf("status = %d, want %d\n%s", resp.Status, fiber.StatusPaymentRequired, resp.Body)
+	}
+
+	// The confirmation is undone so the driver can accept again
+	ctx := t.Context()
+	stored := srv.DB.Booking.GetX(ctx, b.BookingID)
+	if stored.Status != bookings.StatusPending || stored.RespondedAt != nil || stored.DriverResponseMessage != "" {
+		t.Errorf("booking = %s, responded at %v, message %q; want pending without response", stored.Status, stored.RespondedAt, stored.DriverResponseMessage)
+	}
+	if seats := srv.DB.Ride.GetX(ctx, r.ID).AvailableSeats; seats != r.AvailableSeats {
+		t.Errorf("available seats = %d, want %d", seats, r.AvailableSeats)
+	}
+
+	resp = srv.Post(t, "/v1/bookings/"+b.BookingID+"/respond", driver.ID, models.RespondToBookingRequest{Action: "accept"})
+	if resp.Status != fiber.StatusOK {
+		t.Fatalf("second response: status = %d, want %d\n%s", resp.Status, fiber.StatusOK, resp.Body)
+	}
+	p := srv.DB.Payment.Query().Where(payment.BookingIDEQ(b.BookingID)).OnlyX(ctx)
+	if p.Status != payments.StatusSucceeded {
+		t.Errorf("payment status = %q, want %q", p.Status, payments.StatusSucceeded)
+	}
+}
+
+func TestCancelBookingRefund(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+	passenger := testutil.CreateUser(t, srv.DB)
+
+	tests := []struct {
+		name string
+		// fail makes the refund fail, leaving it to the payments sweeper
+		fail         bool
+		wantRefunded int64
+	}{
+		{name: "refunded", wantRefunded: 2 * 120000},
+		{name: "provider unavailable", fail: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Passengers are refunded in full two days before departure
+			r := testutil.CreateRide(t, srv.DB, driver, func(r *ent.RideCreate) {
+				r.SetCancellationPolicy("flexible")
+			})
+			b := book(t, srv, r, passenger, 2)
+			resp := srv.Post(t, "/v1/bookings/"+b.BookingID+"/respond", driver.ID, models.RespondToBookingRequest{Action: "accept"})
+			if resp.Status != fiber.StatusOK {
+				t.Fatalf("failed to accept booking: status %d\n%s", resp.Status, resp.Body)
+			}
+
+			if tt.fail {
+				srv.Payments.FailNext(errors.New("provider unavailable"))
+			}
+			resp = srv.Post(t, "/v1/bookings/"+b.BookingID+"/cancel", passenger.ID, models.CancelBookingRequest{})
+			if resp.Status != fiber.StatusOK {
+				t.Fatalf("status = %d, want %d\n%s", resp.Status, fiber.StatusOK, resp.Body)
+			}
+
+			ctx := t.Context()
+			if status := srv.DB.Booking.GetX(ctx, b.BookingID).Status; status != bookings.StatusCancelled {
+				t.Errorf("status = %q, want %q", status, bookings.StatusCancelled)
+			}
+			p := srv.DB.Payment.Query().Where(payment.BookingIDEQ(b.BookingID)).OnlyX(ctx)
+			if p.RefundedAmount != tt.wantRefunded {
+				t.Errorf("refunded = %d, want %d", p.RefundedAmount, tt.wantRefunded)
+			}
+		})
+	}
+}
+
+func TestCancelBookingTwice(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+	passenger := testutil.CreateUser(t, srv.DB)
+	// Drivers pay a penalty for cancelling within three days of departure
+	r := testutil.CreateRide(t, srv.DB, driver, func(r *ent.RideCreate) {
+		r.SetCancellationPolicy("strict")
+	})
+	b := book(t, srv, r, passenger, 2)
+	resp := srv.Post(t, "/v1/bookings/"+b.BookingID+"/respond", driver.ID, models.RespondToBookingRequest{Action: "accept"})
+	if resp.Status != fiber.StatusOK {
+		t.Fatalf("failed to accept booking: status %d\n%s", resp.Status, resp.Body)
+	}
+
+	// Both cancellations load the booking while it is still confirmed
+	ctx := t.Context()
+	first, err := srv.Bookings.Get(ctx, b.BookingID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := srv.Bookings.Get(ctx, b.BookingID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := srv.Bookings.Cancel(ctx, first, bookings.CancelledByDriver, ""); err != nil {
+		t.Fatalf("first Cancel() error = %v", err)
+	}
+	if err := srv.Bookings.Cancel(ctx, second, bookings.CancelledByDriver, ""); !errors.Is(err, bookings.ErrInvalidTransition) {
+		t.Fatalf("second Cancel() error = %v, want %v", err, bookings.ErrInvalidTransition)
+	}
+
+	if seats := srv.DB.Ride.GetX(ctx, r.ID).AvailableSeats; seats != r.AvailableSeats {
+		t.Errorf("available seats = %d, want %d", seats, r.AvailableSeats)
+	}
+	penalties := srv.DB.LedgerEntry.Query().
+		Where(ledgerentry.BookingIDEQ(b.BookingID), ledgerentry.KindEQ(ledger.KindPenalty)).
+		CountX(ctx)
+	// A penalty posts one entry to the driver and one to the platform
+	if penalties != 2 {
+		t.Errorf("penalty entries = %d, want 2", penalties)
+	}
+}
+
+func TestCancelBookingPromotions(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+	ctx := t.Context()
+
+	// The booking costs 120,000 IDR, less 12,000 off and 20,000 in credits.
+	// Passengers get half back two days before departure on a moderate ride.
+	tests := []struct {
+		name        string
+		cancelledBy string
+		wantRefund  int64
+		wantCredits int64
+	}{
+		{name: "passenger", cancelledBy: bookings.CancelledByPassenger, wantRefund: 44000, wantCredits: 10000},
+		{name: "driver", cancelledBy: bookings.CancelledByDriver, wantRefund: 88000, wantCredits: 20000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passenger := testutil.CreateUser(t, srv.DB)
+			r := testutil.CreateRide(t, srv.DB, driver, func(r *ent.RideCreate) {
+				r.SetCancellationPolicy("moderate")
+			})
+			code := srv.DB.PromoCode.Create().
+				SetCode("CANCEL" + strings.ToUpper(tt.name)).
+				SetDiscountValue(10).
+				SaveX(ctx)
+			srv.DB.Credit.Create().
+				SetUserID(passenger.ID).
+				SetKind(promotions.CreditReferral).
+				SetAmount(20000).
+				ExecX(ctx)
+
+			resp := srv.Post(t, "/v1/bookings", passenger.ID, models.CreateBookingRequest{
+				RideID:         r.ID,
+				PassengerCount: 1,
+				PromoCode:      code.Code,
+			})
+			if resp.Status != fiber.StatusCreated {
+				t.Fatalf("failed to book ride: status %d\n%s", resp.Status, resp.Body)
+			}
+			var b models.BookingResponse
+			resp.Decode(t, &b)
+			resp = srv.Post(t, "/v1/bookings/"+b.BookingID+"/respond", driver.ID, models.RespondToBookingRequest{Action: "accept"})
+			if resp.Status != fiber.StatusOK {
+				t.Fatalf("failed to accept booking: status %d\n%s", resp.Status, resp.Body)
+			}
+
+			canceller := passenger.ID
+			if tt.cancelledBy == bookings.CancelledByDriver {
+				canceller = driver.ID
+			}
+			resp = srv.Post(t, "/v1/bookings/"+b.BookingID+"/cancel", canceller, models.CancelBookingRequest{})
+			if resp.Status != fiber.StatusOK {
+				t.Fatalf("status = %d, want %d\n%s", resp.Status, fiber.StatusOK, resp.Body)
+			}
+
+			if refund := srv.DB.Booking.GetX(ctx, b.BookingID).RefundAmount; refund != tt.wantRefund {
+				t.Errorf("refund = %d, want %d", refund, tt.wantRefund)
+			}
+			var credits int64
+			for _, c := range srv.DB.Credit.Query().Where(credit.UserIDEQ(passenger.ID)).AllX(ctx) {
+				credits += c.Amount
+			}
+			if credits != tt.wantCredits {
+				t.Errorf("credit balance = %d, want %d", credits, tt.wantCredits)
+			}
+
+			// The code can be used again
+			redemption := srv.DB.PromoRedemption.Query().Where(promoredemption.BookingIDEQ(b.BookingID)).OnlyX(ctx)
+			if redemption.ReleasedAt == nil {
+				t.Error("promo redemption was not released")
+			}
+			if used := srv.DB.PromoCode.GetX(ctx, code.ID).UsedCount; used != 0 {
+				t.Errorf("promo code used count = %d, want 0", used)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/currency"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"go.uber.org/zap"
+)
+
+// CurrencyHandler handles exchange rate administration
+type CurrencyHandler struct {
+	rates *currency.Rates
+	store *currency.Store
+}
+
+// NewCurrencyHandler creates a new CurrencyHandler. Updated rates are
+// stored through store and applied to rates.
+func NewCurrencyHandler(rates *currency.Rates, store *currency.Store) *CurrencyHandler {
+	return &CurrencyHandler{
+		rates: rates,
+		store: store,
+	}
+}
+
+// GetRates handles GET /admin/exchange-rates
+func (h *CurrencyHandler) GetRates(c fiber.Ctx) error {
+	return c.JSON(h.rates.Table())
+}
+
+// UpdateRates handles PUT /admin/exchange-rates
+func (h *CurrencyHandler) UpdateRates(c fiber.Ctx) error {
+	var table currency.RateTable
+	if err := c.Bind().Body(&table); err != nil {
+		return apperr.BadRequest("INVALID_REQUEST", "Invalid request body")
+	}
+
+	updated, err := h.store.Save(c.UserContext(), table)
+	if errors.Is(err, currency.ErrInvalidTable) {
+		return apperr.BadRequest("INVALID_RATES", err.Error())
+	}
+	if err != nil {
+		return apperr.Internal("Failed to update exchange rates", err)
+	}
+
+	requestLogger(c).Info("exchange rates updated",
+		zap.String("base", updated.Base),
+		zap.Int("currencies", len(updated.Rates)),
+		zap.Time("as_of", updated.AsOf),
+	)
+	return c.JSON(updated)
+}
+
+// toDisplayPrice converts price into the caller's display currency
+func toDisplayPrice(rates *currency.Rates, price models.Price, target string) (*models.DisplayPrice, error) {
+	conversion, err := rates.Conversion(price.Currency, target)
+	if err != nil {
+		return nil, err
+	}
+	return snapshotDisplayPrice(conversion, price.Amount)
+}
+
+// snapshotDisplayPrice applies a conversion to amount and records the rate used
+func snapshotDisplayPrice(conversion currency.Conversion, amount int64) (*models.DisplayPrice, error) {
+	converted, err := conversion.Apply(amount)
+	if err != nil {
+		return nil, err
+	}
+	return &models.DisplayPrice{
+		Amount:       converted,
+		Currency:     conversion.To,
+		ExchangeRate: conversion.RateString(),
+		RateAsOf:     conversion.AsOf,
+	}, nil
+}
+
+// conversionError is the error for a failed currency conversion
+func conversionError(err error) error {
+	if errors.Is(err, currency.ErrNoRate) {
+		return apperr.New(fiber.StatusUnprocessableEntity, "UNSUPPORTED_CURRENCY",
+			"No exchange rate is available for the requested currency").Wrap(err)
+	}
+	return apperr.Internal("Failed to convert price", err)
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/ledger"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/validate"
+)
+
+// EarningsHandler handles driver earnings HTTP requests
+type EarningsHandler struct {
+	ledger *ledger.Ledger
+}
+
+// NewEarningsHandler creates a new EarningsHandler
+func NewEarningsHandler(ledger *ledger.Ledger) *EarningsHandler {
+	return &EarningsHandler{
+		ledger: ledger,
+	}
+}
+
+// GetEarnings handles GET /me/earnings
+func (h *EarningsHandler) GetEarnings(c fiber.Ctx) error {
+	// Get user ID from context (set by auth middleware)
+	userID := c.Locals("user_id").(string)
+
+	limit := fiber.Query[int](c, "limit", 50)
+	before := c.Query("before")
+	v := validate.New()
+	v.Range("limit", limit, 1, 200)
+	if err := v.Err(); err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	balances, err := h.ledger.Balances(ctx, userID)
+	if err != nil {
+		return apperr.Internal("Failed to get earnings", err)
+	}
+
+	entries, err := h.ledger.Statement(ctx, userID, before, limit)
+	if errors.Is(err, ledger.ErrUnknownCursor) {
+		v.Check(false, "before", "must be an entry ID from this statement")
+		return v.Err()
+	}
+	if err != nil {
+		return apperr.Internal("Failed to get earnings", err)
+	}
+
+	statement := h.transformToEarningsStatement(userID, balances, entries)
+	if len(entries) == limit {
+		statement.NextCursor = entries[len(entries)-1].ID
+	}
+	return c.JSON(statement)
+}
+
+// Helper function to transform ledger data to the earnings statement
+func (h *EarningsHandler) transformToEarningsStatement(userID string, balances []ledger.Balance, entries []*ent.LedgerEntry) models.EarningsStatement {
+	statement := models.EarningsStatement{
+		UserID:   userID,
+		Balances: make([]models.Price, 0, len(balances)),
+		Entries:  make([]models.EarningsEntry, 0, len(entries)),
+	}
+
+	for _, b := range balances {
+		statement.Balances = append(statement.Balances, models.Price{
+			Amount:   b.Amount,
+			Currency: b.Currency,
+		})
+	}
+
+	for _, e := range entries {
+		statement.Entries = append(statement.Entries, models.EarningsEntry{
+			EntryID:       e.ID,
+			TransactionID: e.TransactionID,
+			Kind:          e.Kind,
+			BookingID:     e.BookingID,
+			PayoutID:      e.PayoutID,
+			Amount: models.Price{
+				Amount:   e.Amount,
+				Currency: e.Currency,
+			},
+			CreatedAt: e.CreatedAt,
+		})
+	}
+
+	return statement
+}
@@ -0,0 +1,152 @@
+package handlers_test
+
+import (
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/ledger"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+)
+
+func TestGetEarningsPages(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+
+	// IDs sort by the millisecond they were created in
+	var created []string
+	for i := range 5 {
+		e := srv.DB.LedgerEntry.Create().
+			SetTransactionID("txn_test").
+			SetKind(ledger.KindCapture).
+			SetAccount(ledger.AccountDriverPayable).
+			SetUserID(driver.ID).
+			SetAmount(int64(1000 * (i + 1))).
+			SaveX(t.Context())
+		created = append(created, e.ID)
+		time.Sleep(2 * time.Millisecond)
+	}
+	slices.Reverse(created)
+
+	var pages [][]string
+	before := ""
+	for range len(created) {
+		q := url.Values{"limit": {"2"}}
+		if before != "" {
+			q.Set("before", before)
+		}
+		resp := srv.Get(t, "/v1/me/earnings?"+q.Encode(), driver.ID)
+		if resp.Status != fiber.StatusOK {
+			t.Fatalf("status = %d, want %d\n%s", resp.Status, fiber.StatusOK, resp.Body)
+		}
+		var statement models.EarningsStatement
+		resp.Decode(t, &statement)
+
+		var page []string
+		for _, e := range statement.Entries {
+			page = append(page, e.EntryID)
+		}
+		pages = append(pages, page)
+		if before = statement.NextCursor; before == "" {
+			break
+		}
+	}
+
+	want := [][]string{created[0:2], created[2:4], created[4:5]}
+	if !slices.EqualFunc(pages, want, slices.Equal) {
+		t.Errorf("pages = %v, want %v", pages, want)
+	}
+}
+
+func TestGetEarningsMixedIDs(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+
+	// Entries created before IDs were time-sortable have random hex IDs,
+	// which sort unrelated to new IDs of the same statement
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	entries := []struct {
+		id  string
+		age time.Duration
+	}{
+		{id: "entry_ffa1b2c3", age: 5 * time.Minute},
+		{id: "entry_0a1b2c3d", age: 4 * time.Minute},
+		{age: 3 * time.Minute},
+		{id: "entry_9c8b7a6f", age: 2 * time.Minute},
+		// Created in the same instant, so ordered by ID
+		{age: time.Minute},
+		{id: "entry_00000001", age: time.Minute},
+		{},
+	}
+	var created []string
+	for i, e := range entries {
+		create := srv.DB.LedgerEntry.Create().
+			SetTransactionID("txn_test").
+			SetKind(ledger.KindCapture).
+			SetAccount(ledger.AccountDriverPayable).
+			SetUserID(driver.ID).
+			SetAmount(int64(1000 * (i + 1))).
+			SetCreatedAt(start.Add(-e.age))
+		if e.id != "" {
+			create = create.SetID(e.id)
+		}
+		created = append(created, create.SaveX(t.Context()).ID)
+	}
+	slices.Reverse(created)
+	// The entries created in the same instant come highest ID first
+	if created[1] < created[2] {
+		created[1], created[2] = created[2], created[1]
+	}
+
+	var got []string
+	before := ""
+	for range len(created) {
+		q := url.Values{"limit": {"2"}}
+		if before != "" {
+			q.Set("before", before)
+		}
+		resp := srv.Get(t, "/v1/me/earnings?"+q.Encode(), driver.ID)
+		if resp.Status != fiber.StatusOK {
+			t.Fatalf("before=%s: status = %d, want %d\n%s", before, resp.Status, fiber.StatusOK, resp.Body)
+		}
+		var statement models.EarningsStatement
+		resp.Decode(t, &statement)
+
+		for _, e := range statement.Entries {
+			got = append(got, e.EntryID)
+		}
+		if before = statement.NextCursor; before == "" {
+			break
+		}
+	}
+
+	if !slices.Equal(got, created) {
+		t.Errorf("entries = %v, want %v", got, created)
+	}
+}
+
+func TestGetEarningsInvalidCursor(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+	other := testutil.CreateUser(t, srv.DB)
+	otherEntry := srv.DB.LedgerEntry.Create().
+		SetTransactionID("txn_test").
+		SetKind(ledger.KindCapture).
+		SetAccount(ledger.AccountDriverPayable).
+		SetUserID(other.ID).
+		SetAmount(1000).
+		SaveX(t.Context())
+
+	for _, before := range []string{"entry_3fa85f64", driver.ID, otherEntry.ID} {
+		resp := srv.Get(t, "/v1/me/earnings?before="+url.QueryEscape(before), driver.ID)
+		if resp.Status != fiber.StatusBadRequest {
+			t.Errorf("before=%s: status = %d, want %d\n%s", before, resp.Status, fiber.StatusBadRequest, resp.Body)
+		}
+		if code := resp.ErrorCode(); code != "VALIDATION_FAILED" {
+			t.Errorf("before=%s: code = %q, want VALIDATION_FAILED", before, code)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/valyala/fasthttp/fasthttpadaptor"
+)
+
+// GraphQLHandler serves the GraphQL API over Fiber
+type GraphQLHandler struct {
+	srv http.Handler
+}
+
+// NewGraphQLHandler creates a new GraphQLHandler serving srv
+func NewGraphQLHandler(srv http.Handler) *GraphQLHandler {
+	return &GraphQLHandler{srv: srv}
+}
+
+// Serve handles POST /v1/graphql
+func (h *GraphQLHandler) Serve(c fiber.Ctx) error {
+	// The adaptor hands the fasthttp context to net/http handlers, which
+	// would lose the user, logger and deadline of the request context
+	ctx := c.UserContext()
+	fasthttpadaptor.NewFastHTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.srv.ServeHTTP(w, r.WithContext(ctx))
+	}))(c.Context())
+	return nil
+}
@@ -0,0 +1,279 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+)
+
+// graphQLResult is a GraphQL response with the data still encoded
+type graphQLResult struct {
+	status int
+	Data   json.RawMessage       `json:"data"`
+	Errors []models.GraphQLError `json:"errors"`
+}
+
+// graphQL runs query as userID, or anonymously for an empty userID
+func graphQL(t *testing.T, srv *testutil.Server, userID, query string, variables map[string]any) graphQLResult {
+	t.Helper()
+
+	resp := srv.Post(t, "/v1/graphql", userID, models.GraphQLRequest{Query: query, Variables: variables})
+	var result graphQLResult
+	resp.Decode(t, &result)
+	result.status = resp.Status
+	return result
+}
+
+// errorCode returns the code of the first error, or "" without errors
+func (r graphQLResult) errorCode() string {
+	if len(r.Errors) == 0 {
+		return ""
+	}
+	code, _ := r.Errors[0].Extensions["code"].(string)
+	return code
+}
+
+// countQueries counts the queries of the user and vehicle tables
+func countQueries(db *ent.Client) (users, vehicles *atomic.Int32) {
+	users, vehicles = new(atomic.Int32), new(atomic.Int32)
+	counter := func(n *atomic.Int32) ent.Interceptor {
+		return ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+			return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+				n.Add(1)
+				return next.Query(ctx, q)
+			})
+		})
+	}
+	db.User.Intercept(counter(users))
+	db.Vehicle.Intercept(counter(vehicles))
+	return users, vehicles
+}
+
+func TestGraphQLRides(t *testing.T) {
+	srv := testutil.NewServer(t)
+	var rideIDs []string
+	for i := range 3 {
+		driver := testutil.CreateUser(t, srv.DB)
+		v := testutil.CreateVehicle(t, srv.DB, driver)
+		r := testutil.CreateRide(t, srv.DB, driver, func(r *ent.RideCreate) {
+			r.SetVehicleID(v.ID).
+				SetDepartureTime(time.Now().Add(time.Duration(24+i) * time.Hour))
+		})
+		rideIDs = append(rideIDs, r.ID)
+	}
+	users, vehicles := countQueries(srv.DB)
+
+	const query = `query($after: Cursor) {
+		rides(first: 2, after: $after, orderBy: {field: DEPARTURE_TIME, direction: ASC}) {
+			totalCount
+			pageInfo { hasNextPage endCursor }
+			edges { node { id driver { id name } vehicle { id make } } }
+		}
+	}`
+	type page struct {
+		Rides struct {
+			TotalCount int `json:"totalCount"`
+			PageInfo   struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+			Edges []struct {
+				Node struct {
+					ID     string `json:"id"`
+					Driver struct {
+						ID string `json:"id"`
+					} `json:"driver"`
+					Vehicle *struct {
+						ID string `json:"id"`
+					} `json:"vehicle"`
+				} `json:"node"`
+			} `json:"edges"`
+		} `json:"rides"`
+	}
+
+	var seen []string
+	var after any
+	for {
+		result := graphQL(t, srv, "", query, map[string]any{"after": after})
+		if result.status != fiber.StatusOK || len(result.Errors) > 0 {
+			t.Fatalf("status = %d, errors = %v", result.status, result.Errors)
+		}
+		var p page
+		if err := json.Unmarshal(result.Data, &p); err != nil {
+			t.Fatal(err)
+		}
+		if p.Rides.TotalCount != len(rideIDs) {
+			t.Errorf("totalCount = %d, want %d", p.Rides.TotalCount, len(rideIDs))
+		}
+		for _, e := range p.Rides.Edges {
+			if e.Node.Driver.ID == "" || e.Node.Vehicle == nil {
+				t.Errorf("ride %s is missing its driver or vehicle", e.Node.ID)
+			}
+			seen = append(seen, e.Node.ID)
+		}
+		if !p.Rides.PageInfo.HasNextPage {
+			break
+		}
+		after = p.Rides.PageInfo.EndCursor
+	}
+
+	if strings.Join(seen, ",") != strings.Join(rideIDs, ",") {
+		t.Errorf("rides = %v, want %v", seen, rideIDs)
+	}
+	// One batch per page rather than one query per ride
+	if n := users.Load(); n != 2 {
+		t.Errorf("user queries = %d, want 2", n)
+	}
+	if n := vehicles.Load(); n != 2 {
+		t.Errorf("vehicle queries = %d, want 2", n)
+	}
+}
+
+func TestGraphQLAuthorization(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+	passenger := testutil.CreateUser(t, srv.DB)
+	other := testutil.CreateUser(t, srv.DB)
+	stranger := testutil.CreateUser(t, srv.DB)
+	r := testutil.CreateRide(t, srv.DB, driver)
+	b := testutil.CreateBooking(t, srv.DB, r, passenger)
+	testutil.CreateBooking(t, srv.DB, r, other)
+
+	tests := []struct {
+		name     string
+		as       string
+		query    string
+		want     string
+		wantCode string
+	}{
+		{
+			name:  "driver sees every booking",
+			as:    driver.ID,
+			query: `query($ride: ID!) { ride(id: $ride) { bookings { totalCount } } }`,
+			want:  `{"ride":{"bookings":{"totalCount":2}}}`,
+		},
+		{
+			name:  "passenger sees their own booking",
+			as:    passenger.ID,
+			query: `query($ride: ID!) { ride(id: $ride) { bookings { edges { node { id } } } } }`,
+			want:  `{"ride":{"bookings":{"edges":[{"node":{"id":"` + b.ID + `"}}]}}}`,
+		},
+		{
+			name:  "anonymous sees no bookings",
+			query: `query($ride: ID!) { ride(id: $ride) { bookings { totalCount } } }`,
+			want:  `{"ride":{"bookings":{"totalCount":0}}}`,
+		},
+		{
+			name:  "booking node of the driver",
+			as:    driver.ID,
+			query: `query($booking: ID!) { node(id: $booking) { id } }`,
+			want:  `{"node":{"id":"` + b.ID + `"}}`,
+		},
+		{
+			name:     "booking node of another user",
+			as:       stranger.ID,
+			query:    `query($booking: ID!) { node(id: $booking) { id } }`,
+			want:     `{"node":null}`,
+			wantCode: "FORBIDDEN",
+		},
+		{
+			name:     "anonymous booking node",
+			query:    `query($booking: ID!) { node(id: $booking) { id } }`,
+			want:     `{"node":null}`,
+			wantCode: "UNAUTHENTICATED",
+		},
+		{
+			name:  "own email",
+			as:    passenger.ID,
+			query: `{ viewer { email } }`,
+			want:  `{"viewer":{"email":"` + passenger.Email + `"}}`,
+		},
+		{
+			name:  "email of another user",
+			as:    stranger.ID,
+			query: `query($user: ID!) { user(id: $user) { name email } }`,
+			want:  `{"user":{"name":"` + passenger.Name + `","email":null}}`,
+		},
+		{
+			name:  "anonymous viewer",
+			query: `{ viewer { id } }`,
+			want:  `{"viewer":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := graphQL(t, srv, tt.as, tt.query, map[string]any{
+				"ride":    r.ID,
+				"booking": b.ID,
+				"user":    passenger.ID,
+			})
+			if result.status != fiber.StatusOK {
+				t.Fatalf("status = %d, errors = %v", result.status, result.Errors)
+			}
+			if code := result.errorCode(); code != tt.wantCode {
+				t.Errorf("error code = %q, want %q (errors = %v)", code, tt.wantCode, result.Errors)
+			}
+			if string(result.Data) != tt.want {
+				t.Errorf("data = %s, want %s", result.Data, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraphQLLimits(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+	for range 3 {
+		testutil.CreateRide(t, srv.DB, driver)
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		wantCode string
+	}{
+		{
+			name:  "default page size",
+			query: `{ rides { edges { node { id } } } }`,
+		},
+		{
+			name:     "page too large",
+			query:    `{ rides(first: 51) { edges { node { id } } } }`,
+			wantCode: "BAD_USER_INPUT",
+		},
+		{
+			name:     "negative page",
+			query:    `{ rides(last: -1) { edges { node { id } } } }`,
+			wantCode: "BAD_USER_INPUT",
+		},
+		{
+			// 50 rides with 50 rides each cost far more than the limit
+			name: "too complex",
+			query: `{ rides(first: 50) { edges { node { driver {
+				rides(first: 50) { edges { node { id originCity destinationCity } } }
+			} } } } }`,
+			wantCode: "COMPLEXITY_LIMIT_EXCEEDED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := graphQL(t, srv, "", tt.query, nil)
+			if result.status != fiber.StatusOK {
+				t.Fatalf("status = %d, errors = %v", result.status, result.Errors)
+			}
+			if code := result.errorCode(); code != tt.wantCode {
+				t.Errorf("error code = %q, want %q (errors = %v)", code, tt.wantCode, result.Errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/db"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"go.uber.org/zap"
+)
+
+// HealthHandler handles liveness and readiness probes
+type HealthHandler struct {
+	db              *sql.DB
+	expectedVersion int64
+	timeout         time.Duration
+	draining        atomic.Bool
+}
+
+// NewHealthHandler creates a new HealthHandler. The database is expected to
+// be migrated to expectedVersion and is given timeout to answer a ping.
+func NewHealthHandler(db *sql.DB, expectedVersion int64, timeout time.Duration) *HealthHandler {
+	return &HealthHandler{
+		db:              db,
+		expectedVersion: expectedVersion,
+		timeout:         timeout,
+	}
+}
+
+// Drain makes the readiness probe fail so load balancers stop sending new
+// requests before the server shuts down
+func (h *HealthHandler) Drain() {
+	h.draining.Store(true)
+}
+
+// Livez handles GET /livez. It only reports that the process is serving
+// requests and never checks dependencies, so a database outage does not get
+// healthy instances restarted.
+func (h *HealthHandler) Livez(c fiber.Ctx) error {
+	return c.JSON(models.LivenessResponse{
+		Status:    "ok",
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// Readyz handles GET /readyz
+func (h *HealthHandler) Readyz(c fiber.Ctx) error {
+	if h.draining.Load() {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(models.ReadinessResponse{
+			Status:     "shutting_down",
+			Database:   models.HealthCheck{Status: "unknown"},
+			Migrations: models.MigrationCheck{Status: "unknown", Expected: h.expectedVersion},
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), h.timeout)
+	defer cancel()
+
+	resp := models.ReadinessResponse{
+		Status:   "ok",
+		Database: models.HealthCheck{Status: "ok"},
+		Migrations: models.MigrationCheck{
+			Status:   "ok",
+			Expected: h.expectedVersion,
+		},
+	}
+
+	if err := h.db.PingContext(ctx); err != nil {
+		resp.Status = "unavailable"
+		resp.Database = models.HealthCheck{Status: "unavailable", Error: err.Error()}
+		resp.Migrations.Status = "unknown"
+	} else {
+		version, err := db.MigrationVersion(ctx, h.db)
+		resp.Migrations.Current = version
+		switch {
+		case err != nil:
+			resp.Status = "unavailable"
+			resp.Migrations.Status = "unknown"
+			resp.Migrations.Error = err.Error()
+		case h.expectedVersion == 0:
+			resp.Migrations.Status = "unchecked"
+		case version < h.expectedVersion:
+			resp.Status = "unavailable"
+			resp.Migrations.Status = "pending"
+		case version > h.expectedVersion:
+			// The database was migrated by a newer release, which must stay
+			// compatible with this one during a rolling deploy
+			resp.Migrations.Status = "ahead"
+		}
+	}
+
+	if resp.Status != "ok" {
+		requestLogger(c).Warn("readiness check failed",
+			zap.String("database", resp.Database.Status),
+			zap.String("migrations", resp.Migrations.Status),
+		)
+		return c.Status(fiber.StatusServiceUnavailable).JSON(resp)
+	}
+
+	return c.JSON(resp)
+}
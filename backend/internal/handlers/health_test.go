@@ -0,0 +1,123 @@
+package handlers_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/handlers"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+)
+
+// migrate records versions as applied in goose's version table
+func migrate(t *testing.T, sqlDB *sql.DB, versions ...int64) {
+	t.Helper()
+
+	_, err := sqlDB.ExecContext(t.Context(), `CREATE TABLE goose_db_version (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		version_id INTEGER NOT NULL,
+		is_applied BOOLEAN NOT NULL
+	)`)
+	if err != nil {
+		t.Fatalf("failed to create version table: %v", err)
+	}
+	for _, v := range versions {
+		if _, err := sqlDB.ExecContext(t.Context(), `INSERT INTO goose_db_version (version_id, is_applied) VALUES (?, TRUE)`, v); err != nil {
+			t.Fatalf("failed to record version %d: %v", v, err)
+		}
+	}
+}
+
+func TestReadyz(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected int64
+		// setup prepares the database and the handler
+		setup          func(t *testing.T, sqlDB *sql.DB, h *handlers.HealthHandler)
+		wantStatus     int
+		wantReadiness  string
+		wantDatabase   string
+		wantMigrations string
+		wantCurrent    int64
+	}{
+		{
+			name: "migrated", expected: 9,
+			setup:      func(t *testing.T, sqlDB *sql.DB, _ *handlers.HealthHandler) { migrate(t, sqlDB, 0, 8, 9) },
+			wantStatus: fiber.StatusOK, wantReadiness: "ok", wantDatabase: "ok", wantMigrations: "ok", wantCurrent: 9,
+		},
+		{
+			name: "pending", expected: 9,
+			setup:      func(t *testing.T, sqlDB *sql.DB, _ *handlers.HealthHandler) { migrate(t, sqlDB, 0, 8) },
+			wantStatus: fiber.StatusServiceUnavailable, wantReadiness: "unavailable", wantDatabase: "ok", wantMigrations: "pending", wantCurrent: 8,
+		},
+		{
+			name: "ahead", expected: 9,
+			setup:      func(t *testing.T, sqlDB *sql.DB, _ *handlers.HealthHandler) { migrate(t, sqlDB, 0, 9, 10) },
+			wantStatus: fiber.StatusOK, wantReadiness: "ok", wantDatabase: "ok", wantMigrations: "ahead", wantCurrent: 10,
+		},
+		{
+			name: "unchecked", expected: 0,
+			setup:      func(t *testing.T, sqlDB *sql.DB, _ *handlers.HealthHandler) { migrate(t, sqlDB, 0, 8) },
+			wantStatus: fiber.StatusOK, wantReadiness: "ok", wantDatabase: "ok", wantMigrations: "unchecked", wantCurrent: 8,
+		},
+		{
+			name: "never migrated", expected: 9,
+			wantStatus: fiber.StatusServiceUnavailable, wantReadiness: "unavailable", wantDatabase: "ok", wantMigrations: "unknown",
+		},
+		{
+			name: "database down", expected: 9,
+			setup: func(t *testing.T, sqlDB *sql.DB, _ *handlers.HealthHandler) {
+				migrate(t, sqlDB, 0, 9)
+				sqlDB.Close()
+			},
+			wantStatus: fiber.StatusServiceUnavailable, wantReadiness: "unavailable", wantDatabase: "unavailable", wantMigrations: "unknown",
+		},
+		{
+			name: "draining", expected: 9,
+			setup: func(t *testing.T, sqlDB *sql.DB, h *handlers.HealthHandler) {
+				migrate(t, sqlDB, 0, 9)
+				h.Drain()
+			},
+			wantStatus: fiber.StatusServiceUnavailable, wantReadiness: "shutting_down", wantDatabase: "unknown", wantMigrations: "unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, sqlDB := testutil.NewDB(t)
+			h := handlers.NewHealthHandler(sqlDB, tt.expected, time.Second)
+			if tt.setup != nil {
+				tt.setup(t, sqlDB, h)
+			}
+			app := fiber.New()
+			app.Get("/readyz", h.Readyz)
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/readyz", nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+
+			var got models.ReadinessResponse
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("invalid response: %v", err)
+			}
+			if got.Status != tt.wantReadiness || got.Database.Status != tt.wantDatabase || got.Migrations.Status != tt.wantMigrations {
+				t.Errorf("readiness, database, migrations = %s, %s, %s; want %s, %s, %s",
+					got.Status, got.Database.Status, got.Migrations.Status, tt.wantReadiness, tt.wantDatabase, tt.wantMigrations)
+			}
+			if got.Migrations.Current != tt.wantCurrent || got.Migrations.Expected != tt.expected {
+				t.Errorf("migrations current, expected = %d, %d; want %d, %d", got.Migrations.Current, got.Migrations.Expected, tt.wantCurrent, tt.expected)
+			}
+			if (got.Database.Status == "unavailable") != (got.Database.Error != "") {
+				t.Errorf("database error = %q with status %s", got.Database.Error, got.Database.Status)
+			}
+		})
+	}
+}
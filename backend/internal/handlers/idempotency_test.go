@@ -0,0 +1,114 @@
+package handlers_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/idempotencykey"
+	"github.com/slowtyper/poolie/backend/ent/ride"
+	"github.com/slowtyper/poolie/backend/internal/middleware"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+)
+
+func TestIdempotentCreateBooking(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+	passenger := testutil.CreateUser(t, srv.DB)
+	other := testutil.CreateUser(t, srv.DB)
+	r := testutil.CreateRide(t, srv.DB, driver, func(r *ent.RideCreate) {
+		r.SetAvailableSeats(6).SetTotalSeats(6)
+	})
+
+	// Steps run in order against the same keys
+	steps := []struct {
+		name     string
+		as       string
+		key      string
+		count    int
+		expire   bool // expire the key before sending
+		status   int
+		code     string
+		replayed bool
+		bookings int
+	}{
+		{name: "first request", as: passenger.ID, key: "key-1", count: 1, status: fiber.StatusCreated, bookings: 1},
+		{name: "retry", as: passenger.ID, key: "key-1", count: 1, status: fiber.StatusCreated, replayed: true, bookings: 1},
+		{name: "different body", as: passenger.ID, key: "key-1", count: 2, status: fiber.StatusConflict, code: "IDEMPOTENCY_KEY_REUSED", bookings: 1},
+		{name: "same key of another user", as: other.ID, key: "key-1", count: 1, status: fiber.StatusCreated, bookings: 2},
+		{name: "failed request", as: passenger.ID, key: "key-2", count: 7, status: fiber.StatusConflict, code: "INSUFFICIENT_SEATS", bookings: 2},
+		{name: "retry after failure", as: passenger.ID, key: "key-2", count: 1, status: fiber.StatusCreated, bookings: 3},
+		{name: "expired key", as: passenger.ID, key: "key-1", count: 1, expire: true, status: fiber.StatusCreated, bookings: 4},
+	}
+
+	var first []byte
+	for _, step := range steps {
+		if step.expire {
+			srv.DB.IdempotencyKey.Update().
+				Where(idempotencykey.UserID(step.as), idempotencykey.Key(step.key)).
+				SetExpiresAt(time.Now().Add(-time.Minute)).
+				ExecX(t.Context())
+		}
+
+		resp := srv.Do(t, testutil.Request{
+			Method: fiber.MethodPost,
+			Path:   "/v1/bookings",
+			As:     step.as,
+			Header: map[string]string{middleware.IdempotencyKeyHeader: step.key},
+			Body:   models.CreateBookingRequest{RideID: r.ID, PassengerCount: step.count},
+		})
+		if resp.Status != step.status {
+			t.Fatalf("%s: status = %d, want %d\n%s", step.name, resp.Status, step.status, resp.Body)
+		}
+		if code := resp.ErrorCode(); code != step.code {
+			t.Errorf("%s: code = %q, want %q", step.name, code, step.code)
+		}
+		replayed := resp.Header.Get(middleware.IdempotentReplayedHeader) == "true"
+		if replayed != step.replayed {
+			t.Errorf("%s: replayed = %v, want %v", step.name, replayed, step.replayed)
+		}
+		if n := srv.DB.Booking.Query().Where(booking.RideID(r.ID)).CountX(t.Context()); n != step.bookings {
+			t.Errorf("%s: bookings = %d, want %d", step.name, n, step.bookings)
+		}
+
+		switch {
+		case first == nil:
+			first = resp.Body
+		case replayed && !bytes.Equal(resp.Body, first):
+			t.Errorf("%s: replayed body differs from the first response\n%s\n%s", step.name, resp.Body, first)
+		}
+	}
+}
+
+func TestIdempotentCreateRide(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+	departure := time.Now().Add(48 * time.Hour)
+
+	for range 2 {
+		resp := srv.Do(t, testutil.Request{
+			Method: fiber.MethodPost,
+			Path:   "/v1/rides",
+			As:     driver.ID,
+			Header: map[string]string{middleware.IdempotencyKeyHeader: "publish-1"},
+			Body: models.CreateRideRequest{
+				Origin:         models.Location{City: "Jakarta", Address: "Jl. Sudirman No. 5"},
+				Destination:    models.Location{City: "Bogor", Address: "Jl. Pajajaran No. 3"},
+				DepartureTime:  departure,
+				AvailableSeats: 2,
+				PricePerSeat:   models.Price{Amount: 50000, Currency: "IDR"},
+			},
+		})
+		if resp.Status != fiber.StatusCreated {
+			t.Fatalf("status = %d, want %d\n%s", resp.Status, fiber.StatusCreated, resp.Body)
+		}
+	}
+
+	if n := srv.DB.Ride.Query().Where(ride.DriverID(driver.ID)).CountX(t.Context()); n != 1 {
+		t.Errorf("rides = %d, want 1", n)
+	}
+}
@@ -0,0 +1,13 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+	"go.uber.org/zap"
+)
+
+// requestLogger returns the logger of the current request, annotated with
+// the route that matched it
+func requestLogger(c fiber.Ctx) *zap.Logger {
+	return requestctx.Logger(c.UserContext()).With(zap.String("route", c.Route().Path))
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/payments"
+)
+
+// PaymentHandler handles payment-related HTTP requests
+type PaymentHandler struct {
+	payments *payments.Service
+}
+
+// NewPaymentHandler creates a new PaymentHandler
+func NewPaymentHandler(payments *payments.Service) *PaymentHandler {
+	return &PaymentHandler{
+		payments: payments,
+	}
+}
+
+// HandleWebhook handles POST /payments/webhook
+func (h *PaymentHandler) HandleWebhook(c fiber.Ctx) error {
+	ctx := c.UserContext()
+	err := h.payments.HandleWebhook(ctx, c.Body(), c.Get(payments.SignatureHeader))
+	if err != nil {
+		switch {
+		case errors.Is(err, payments.ErrInvalidSignature):
+			return apperr.New(fiber.StatusUnauthorized, "INVALID_SIGNATURE", "Webhook signature verification failed")
+		case errors.Is(err, payments.ErrMalformedEvent):
+			return apperr.BadRequest("INVALID_REQUEST", "Webhook event is missing required fields")
+		case errors.Is(err, payments.ErrIntentNotFound):
+			return apperr.NotFound("Payment not found")
+		}
+		return apperr.Internal("Failed to handle webhook", err)
+	}
+
+	return c.JSON(models.WebhookResponse{Received: true})
+}
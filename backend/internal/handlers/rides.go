@@ -1,28 +1,31 @@
 package handlers
 
 import (
-	"context"
+	"errors"
 	"time"
 
 	"github.com/gofiber/fiber/v3"
-	"github.com/google/uuid"
 	"github.com/slowtyper/poolie/backend/ent"
-	"github.com/slowtyper/poolie/backend/ent/ride"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/cancellation"
+	"github.com/slowtyper/poolie/backend/internal/currency"
 	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/rides"
+	"github.com/slowtyper/poolie/backend/internal/validate"
 	"go.uber.org/zap"
 )
 
 // RideHandler handles ride-related HTTP requests
 type RideHandler struct {
-	db     *ent.Client
-	logger *zap.Logger
+	rides *rides.Service
+	rates *currency.Rates
 }
 
 // NewRideHandler creates a new RideHandler
-func NewRideHandler(db *ent.Client, logger *zap.Logger) *RideHandler {
+func NewRideHandler(rides *rides.Service, rates *currency.Rates) *RideHandler {
 	return &RideHandler{
-		db:     db,
-		logger: logger,
+		rides: rides,
+		rates: rates,
 	}
 }
 
@@ -32,71 +35,32 @@ func (h *RideHandler) SearchRides(c fiber.Ctx) error {
 
 	// Fiber v3: Use Bind().Query() instead of QueryParser
 	if err := c.Bind().Query(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INVALID_REQUEST",
-				Message: "Invalid query parameters",
-			},
-		})
-	}
-
-	// Validate required fields
-	if req.Origin == "" || req.Destination == "" || req.Date == "" {
-		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "MISSING_PARAMETERS",
-				Message: "origin, destination, and date are required",
-			},
-		})
+		return apperr.BadRequest("INVALID_REQUEST", "Invalid query parameters")
 	}
 
-	// Parse date
-	searchDate, err := time.Parse("2006-01-02", req.Date)
+	req.Currency = currency.Normalize(req.Currency)
+	searchDate, err := validate.SearchRides(&req)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INVALID_DATE",
-				Message: "date must be in YYYY-MM-DD format",
-			},
-		})
+		return err
 	}
+	displayCurrency := req.Currency
 
-	// Build query
-	ctx := context.Background()
-	query := h.db.Ride.Query().
-		Where(
-			ride.StatusEQ("active"),
-			ride.OriginCityContains(req.Origin),
-			ride.DestinationCityContains(req.Destination),
-			ride.DepartureTimeGTE(searchDate),
-			ride.DepartureTimeLT(searchDate.AddDate(0, 0, 1)),
-		).
-		WithDriver().
-		WithVehicle()
-
-	// Filter by type if specified
-	if req.Type != "" && req.Type != "all" {
-		query = query.Where(ride.TypeEQ(req.Type))
-	}
-
-	// Execute query
-	rides, err := query.All(ctx)
+	found, err := h.rides.Search(c.UserContext(), rides.SearchParams{
+		Origin:      req.Origin,
+		Destination: req.Destination,
+		Date:        searchDate,
+		Type:        req.Type,
+	})
 	if err != nil {
-		h.logger.Error("failed to search rides", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INTERNAL_ERROR",
-				Message: "Failed to search rides",
-			},
-		})
+		return apperr.Internal("Failed to search rides", err)
 	}
 
 	// Transform to response format
-	ridePreviews := make([]models.RidePreview, 0, len(rides))
+	ridePreviews := make([]models.RidePreview, 0, len(found))
 	carpoolCount := 0
 	busCount := 0
 
-	for _, r := range rides {
+	for _, r := range found {
 		if r.Type == "carpool" {
 			carpoolCount++
 		} else if r.Type == "bus" {
@@ -104,11 +68,17 @@ func (h *RideHandler) SearchRides(c fiber.Ctx) error {
 		}
 
 		preview := h.transformToRidePreview(r)
+		if displayCurrency != "" {
+			preview.DisplayPrice, err = toDisplayPrice(h.rates, preview.Price, displayCurrency)
+			if err != nil {
+				return conversionError(err)
+			}
+		}
 		ridePreviews = append(ridePreviews, preview)
 	}
 
 	return c.JSON(models.SearchRidesResponse{
-		TotalCount:   len(rides),
+		TotalCount:   len(found),
 		CarpoolCount: carpoolCount,
 		BusCount:     busCount,
 		Rides:        ridePreviews,
@@ -119,32 +89,26 @@ func (h *RideHandler) SearchRides(c fiber.Ctx) error {
 func (h *RideHandler) GetRide(c fiber.Ctx) error {
 	rideID := c.Params("rideId")
 
-	ctx := context.Background()
-	r, err := h.db.Ride.Query().
-		Where(ride.IDEQ(rideID)).
-		WithDriver().
-		WithVehicle().
-		Only(ctx)
+	// Validate display currency
+	displayCurrency := currency.Normalize(c.Query("currency"))
+	v := validate.New()
+	v.Currency("currency", displayCurrency)
+	if err := v.Err(); err != nil {
+		return err
+	}
 
+	r, err := h.rides.Get(c.UserContext(), rideID)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
-				Error: models.ErrorDetail{
-					Code:    "NOT_FOUND",
-					Message: "Ride not found",
-				},
-			})
-		}
-		h.logger.Error("failed to get ride", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INTERNAL_ERROR",
-				Message: "Failed to get ride",
-			},
-		})
+		return rideError(err, "Failed to get ride")
 	}
 
-	detail := h.transformToRideDetail(r)
+	detail := h.transformToRideDetail(r, requestLogger(c))
+	if displayCurrency != "" {
+		detail.DisplayPrice, err = toDisplayPrice(h.rates, detail.Price, displayCurrency)
+		if err != nil {
+			return conversionError(err)
+		}
+	}
 	return c.JSON(detail)
 }
 
@@ -154,106 +118,99 @@ func (h *RideHandler) CreateRide(c fiber.Ctx) error {
 
 	// Fiber v3: Use Bind().Body() instead of BodyParser
 	if err := c.Bind().Body(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INVALID_REQUEST",
-				Message: "Invalid request body",
-			},
-		})
+		return apperr.BadRequest("INVALID_REQUEST", "Invalid request body")
 	}
 
-	// Get user ID from context (set by auth middleware)
-	userID := c.Locals("user_id").(string)
-
-	// Calculate duration if arrival time is provided
-	var durationMinutes *int
-	if req.ArrivalTime != nil {
-		duration := int(req.ArrivalTime.Sub(req.DepartureTime).Minutes())
-		durationMinutes = &duration
-	}
-
-	// Create ride
-	ctx := context.Background()
-	rideID := "ride_" + uuid.New().String()[:8]
-
-	builder := h.db.Ride.Create().
-		SetID(rideID).
-		SetDriverID(userID).
-		SetType("carpool").
-		SetRideType(req.RideType).
-		SetDepartureTime(req.DepartureTime).
-		SetOriginCity(req.Origin.City).
-		SetOriginAddress(req.Origin.Address).
-		SetDestinationCity(req.Destination.City).
-		SetDestinationAddress(req.Destination.Address).
-		SetPriceAmount(req.PricePerSeat.Amount).
-		SetPriceCurrency(req.PricePerSeat.Currency).
-		SetAvailableSeats(req.AvailableSeats).
-		SetTotalSeats(req.AvailableSeats)
-
-	if req.Origin.LocationPoint != "" {
-		builder = builder.SetOriginLocationPoint(req.Origin.LocationPoint)
+	// Apply defaults before validating
+	if req.RideType == "" {
+		req.RideType = rides.TypeOneTime
 	}
-
-	if req.Destination.LocationPoint != "" {
-		builder = builder.SetDestinationLocationPoint(req.Destination.LocationPoint)
+	if req.CancellationPolicy == "" {
+		req.CancellationPolicy = cancellation.Default
 	}
-
-	if req.ArrivalTime != nil {
-		builder = builder.SetArrivalTime(*req.ArrivalTime)
+	req.PricePerSeat.Currency = currency.Normalize(req.PricePerSeat.Currency)
+	if req.PricePerSeat.Currency == "" {
+		req.PricePerSeat.Currency = currency.Default
 	}
-
-	if durationMinutes != nil {
-		builder = builder.SetDurationMinutes(*durationMinutes)
+	if err := validate.CreateRide(&req, time.Now()); err != nil {
+		return err
 	}
 
-	if req.Amenities != nil {
-		builder = builder.SetAmenities(req.Amenities)
-	}
+	// Get user ID from context (set by auth middleware)
+	userID := c.Locals("user_id").(string)
 
-	if req.Description != "" {
-		builder = builder.SetDescription(req.Description)
+	newRide := rides.NewRide{
+		DriverID:      userID,
+		RideType:      req.RideType,
+		DepartureTime: req.DepartureTime,
+		ArrivalTime:   req.ArrivalTime,
+		Origin: rides.Place{
+			City:          req.Origin.City,
+			Address:       req.Origin.Address,
+			LocationPoint: req.Origin.LocationPoint,
+		},
+		Destination: rides.Place{
+			City:          req.Destination.City,
+			Address:       req.Destination.Address,
+			LocationPoint: req.Destination.LocationPoint,
+		},
+		PriceAmount:        req.PricePerSeat.Amount,
+		PriceCurrency:      req.PricePerSeat.Currency,
+		Seats:              req.AvailableSeats,
+		Amenities:          req.Amenities,
+		Description:        req.Description,
+		CancellationPolicy: req.CancellationPolicy,
 	}
-
 	if req.Recurrence != nil {
-		recurrenceData := map[string]interface{}{
-			"days_of_week": req.Recurrence.DaysOfWeek,
-			"start_date":   req.Recurrence.StartDate,
-			"end_date":     req.Recurrence.EndDate,
+		newRide.Recurrence = &rides.Recurrence{
+			DaysOfWeek: req.Recurrence.DaysOfWeek,
+			StartDate:  req.Recurrence.StartDate,
+			EndDate:    req.Recurrence.EndDate,
 		}
-		builder = builder.SetRecurrence(recurrenceData)
 	}
 
-	newRide, err := builder.Save(ctx)
+	created, err := h.rides.Create(c.UserContext(), newRide)
 	if err != nil {
-		h.logger.Error("failed to create ride", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INTERNAL_ERROR",
-				Message: "Failed to create ride",
-			},
-		})
+		// Schema validation failures are reported with the offending field
+		if ent.IsValidationError(err) {
+			return err
+		}
+		return apperr.Internal("Failed to create ride", err)
 	}
 
-	// Fetch the created ride with relations
-	createdRide, err := h.db.Ride.Query().
-		Where(ride.IDEQ(newRide.ID)).
-		WithDriver().
-		WithVehicle().
-		Only(ctx)
+	detail := h.transformToRideDetail(created, requestLogger(c))
+	return c.Status(fiber.StatusCreated).JSON(detail)
+}
+
+// CompleteRide handles POST /rides/:rideId/complete
+func (h *RideHandler) CompleteRide(c fiber.Ctx) error {
+	rideID := c.Params("rideId")
 
+	// Get user ID from context (set by auth middleware)
+	userID := c.Locals("user_id").(string)
+
+	completed, err := h.rides.Complete(c.UserContext(), userID, rideID)
 	if err != nil {
-		h.logger.Error("failed to fetch created ride", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INTERNAL_ERROR",
-				Message: "Ride created but failed to fetch details",
-			},
-		})
+		switch {
+		case errors.Is(err, rides.ErrForbidden):
+			return apperr.Forbidden("You are not authorized to complete this ride")
+		case errors.Is(err, rides.ErrCannotComplete):
+			return apperr.Conflict("CANNOT_COMPLETE", "Only active rides that have departed can be completed")
+		}
+		return rideError(err, "Failed to complete ride")
 	}
 
-	detail := h.transformToRideDetail(createdRide)
-	return c.Status(fiber.StatusCreated).JSON(detail)
+	detail := h.transformToRideDetail(completed, requestLogger(c))
+	return c.JSON(detail)
+}
+
+// rideError converts a rides.Service error not specific to one handler,
+// reporting anything unexpected as message
+func rideError(err error, message string) error {
+	if errors.Is(err, rides.ErrNotFound) {
+		return apperr.NotFound("Ride not found")
+	}
+	return apperr.Internal(message, err)
 }
 
 // Helper functions to transform entities to response models
@@ -334,7 +291,7 @@ func (h *RideHandler) transformToRidePreview(r *ent.Ride) models.RidePreview {
 	return preview
 }
 
-func (h *RideHandler) transformToRideDetail(r *ent.Ride) models.RideDetail {
+func (h *RideHandler) transformToRideDetail(r *ent.Ride, logger *zap.Logger) models.RideDetail {
 	detail := models.RideDetail{
 		RideID:    r.ID,
 		Type:      r.Type,
@@ -361,6 +318,20 @@ func (h *RideHandler) transformToRideDetail(r *ent.Ride) models.RideDetail {
 		},
 	}
 
+	// Refund schedule for cancelling a single seat
+	policy := cancellation.LookupOrDefault(r.CancellationPolicy, logger)
+	detail.BookingPolicies.RefundSchedule = make([]models.RefundTier, 0, len(policy.Tiers))
+	for _, t := range policy.Tiers {
+		detail.BookingPolicies.RefundSchedule = append(detail.BookingPolicies.RefundSchedule, models.RefundTier{
+			HoursBeforeDeparture: int(t.MinNotice.Hours()),
+			RefundPercent:        t.RefundPercent,
+			RefundAmount: models.Price{
+				Amount:   policy.PassengerRefund(r.PriceAmount, t.MinNotice),
+				Currency: r.PriceCurrency,
+			},
+		})
+	}
+
 	if r.ArrivalTime != nil {
 		detail.ArrivalTime = r.ArrivalTime
 	}
@@ -0,0 +1,184 @@
+package handlers_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+)
+
+func TestSearchRides(t *testing.T) {
+	srv := testutil.NewServer(t)
+	driver := testutil.CreateUser(t, srv.DB)
+	testutil.CreateVehicle(t, srv.DB, driver)
+	passenger := testutil.CreateUser(t, srv.DB)
+
+	// Rides depart at 10:00 UTC so the whole day is in the future
+	day := time.Now().UTC().AddDate(0, 0, 3).Truncate(24 * time.Hour)
+	departingAt := func(departure time.Time) func(*ent.RideCreate) {
+		return func(r *ent.RideCreate) {
+			r.SetDepartureTime(departure).SetArrivalTime(departure.Add(3 * time.Hour))
+		}
+	}
+	morning := testutil.CreateRide(t, srv.DB, driver, departingAt(day.Add(10*time.Hour)))
+	bus := testutil.CreateRide(t, srv.DB, driver, departingAt(day.Add(14*time.Hour)), func(r *ent.RideCreate) {
+		r.SetType("bus")
+	})
+	testutil.CreateRide(t, srv.DB, driver, departingAt(day.Add(16*time.Hour)), func(r *ent.RideCreate) {
+		r.SetStatus("cancelled")
+	})
+	testutil.CreateRide(t, srv.DB, driver, departingAt(day.Add(34*time.Hour)))
+	testutil.CreateRide(t, srv.DB, driver, departingAt(day.Add(10*time.Hour)), func(r *ent.RideCreate) {
+		r.SetDestinationCity("Semarang")
+	})
+
+	date := day.Format("2006-01-02")
+	query := func(params ...string) string {
+		q := url.Values{"origin": {"Jakarta"}, "destination": {"Bandung"}, "date": {date}}
+		for i := 0; i+1 < len(params); i += 2 {
+			if params[i+1] == "" {
+				q.Del(params[i])
+				continue
+			}
+			q.Set(params[i], params[i+1])
+		}
+		return "/v1/rides/search?" + q.Encode()
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		as         string
+		header     map[string]string
+		status     int
+		code       string
+		rides      []string
+		carpools   int
+		buses      int
+		displayUSD bool
+	}{
+		{
+			name:     "active rides on the date",
+			path:     query(),
+			status:   fiber.StatusOK,
+			rides:    []string{morning.ID, bus.ID},
+			carpools: 1,
+			buses:    1,
+		},
+		{
+			name:     "signed in",
+			path:     query(),
+			as:       passenger.ID,
+			status:   fiber.StatusOK,
+			rides:    []string{morning.ID, bus.ID},
+			carpools: 1,
+			buses:    1,
+		},
+		{
+			name:     "invalid token is ignored",
+			path:     query(),
+			header:   map[string]string{fiber.HeaderAuthorization: "Bearer not-a-token"},
+			status:   fiber.StatusOK,
+			rides:    []string{morning.ID, bus.ID},
+			carpools: 1,
+			buses:    1,
+		},
+		{
+			name:   "filtered by type",
+			path:   query("type", "bus"),
+			status: fiber.StatusOK,
+			rides:  []string{bus.ID},
+			buses:  1,
+		},
+		{
+			name:       "display currency",
+			path:       query("currency", "usd"),
+			status:     fiber.StatusOK,
+			rides:      []string{morning.ID, bus.ID},
+			carpools:   1,
+			buses:      1,
+			displayUSD: true,
+		},
+		{
+			name:   "no rides on the date",
+			path:   query("date", day.AddDate(0, 0, 2).Format("2006-01-02")),
+			status: fiber.StatusOK,
+			rides:  []string{},
+		},
+		{
+			name:   "missing date",
+			path:   query("date", ""),
+			status: fiber.StatusBadRequest,
+			code:   "VALIDATION_FAILED",
+		},
+		{
+			name:   "malformed date",
+			path:   query("date", "03/01/2030"),
+			status: fiber.StatusBadRequest,
+			code:   "VALIDATION_FAILED",
+		},
+		{
+			name:   "unknown type",
+			path:   query("type", "train"),
+			status: fiber.StatusBadRequest,
+			code:   "VALIDATION_FAILED",
+		},
+		{
+			name:   "unknown currency",
+			path:   query("currency", "XYZ"),
+			status: fiber.StatusBadRequest,
+			code:   "VALIDATION_FAILED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := srv.Do(t, testutil.Request{
+				Method: fiber.MethodGet,
+				Path:   tt.path,
+				As:     tt.as,
+				Header: tt.header,
+			})
+			if resp.Status != tt.status {
+				t.Fatalf("status = %d, want %d\n%s", resp.Status, tt.status, resp.Body)
+			}
+			if tt.code != "" {
+				if code := resp.ErrorCode(); code != tt.code {
+					t.Errorf("error code = %q, want %q", code, tt.code)
+				}
+				return
+			}
+
+			var body models.SearchRidesResponse
+			resp.Decode(t, &body)
+			if body.TotalCount != len(tt.rides) || body.CarpoolCount != tt.carpools || body.BusCount != tt.buses {
+				t.Errorf("counts = %d total, %d carpool, %d bus; want %d, %d, %d",
+					body.TotalCount, body.CarpoolCount, body.BusCount, len(tt.rides), tt.carpools, tt.buses)
+			}
+
+			found := map[string]bool{}
+			for _, r := range body.Rides {
+				found[r.RideID] = true
+				if tt.displayUSD {
+					if r.DisplayPrice == nil || r.DisplayPrice.Currency != "USD" || r.DisplayPrice.ExchangeRate == "" {
+						t.Errorf("ride %s display price = %+v, want a USD conversion", r.RideID, r.DisplayPrice)
+					}
+				} else if r.DisplayPrice != nil {
+					t.Errorf("ride %s has a display price without a display currency", r.RideID)
+				}
+			}
+			for _, id := range tt.rides {
+				if !found[id] {
+					t.Errorf("ride %s missing from results", id)
+				}
+			}
+			if len(body.Rides) != len(tt.rides) {
+				t.Errorf("got %d rides, want %d", len(body.Rides), len(tt.rides))
+			}
+		})
+	}
+}
@@ -1,26 +1,24 @@
 package handlers
 
 import (
-	"context"
+	"errors"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/slowtyper/poolie/backend/ent"
-	"github.com/slowtyper/poolie/backend/ent/user"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
 	"github.com/slowtyper/poolie/backend/internal/models"
-	"go.uber.org/zap"
+	"github.com/slowtyper/poolie/backend/internal/users"
 )
 
 // UserHandler handles user-related HTTP requests
 type UserHandler struct {
-	db     *ent.Client
-	logger *zap.Logger
+	users *users.Service
 }
 
 // NewUserHandler creates a new UserHandler
-func NewUserHandler(db *ent.Client, logger *zap.Logger) *UserHandler {
+func NewUserHandler(users *users.Service) *UserHandler {
 	return &UserHandler{
-		db:     db,
-		logger: logger,
+		users: users,
 	}
 }
 
@@ -28,27 +26,12 @@ func NewUserHandler(db *ent.Client, logger *zap.Logger) *UserHandler {
 func (h *UserHandler) GetUserProfile(c fiber.Ctx) error {
 	userID := c.Params("userId")
 
-	ctx := context.Background()
-	u, err := h.db.User.Query().
-		Where(user.IDEQ(userID)).
-		Only(ctx)
-
+	u, err := h.users.Get(c.UserContext(), userID)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
-				Error: models.ErrorDetail{
-					Code:    "NOT_FOUND",
-					Message: "User not found",
-				},
-			})
+		if errors.Is(err, users.ErrNotFound) {
+			return apperr.NotFound("User not found")
 		}
-		h.logger.Error("failed to get user profile", zap.Error(err))
-		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
-			Error: models.ErrorDetail{
-				Code:    "INTERNAL_ERROR",
-				Message: "Failed to get user profile",
-			},
-		})
+		return apperr.Internal("Failed to get user profile", err)
 	}
 
 	profile := h.transformToUserProfile(u)
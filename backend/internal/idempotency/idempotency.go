@@ -0,0 +1,192 @@
+// Package idempotency makes requests safe to retry. A client sends a unique
+// key with a request; the first request with the key runs and its response
+// is stored, and retries with the same key get that response back.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/idempotencykey"
+	"github.com/slowtyper/poolie/backend/internal/config"
+	"go.uber.org/zap"
+)
+
+// MaxKeyLength is the longest key clients may send
+const MaxKeyLength = 255
+
+// abandonAfter is how long a request may hold its key without completing.
+// Requests time out well before, so older claims belong to requests that
+// died and are taken over by retries.
+const abandonAfter = time.Minute
+
+var (
+	// ErrKeyReused is returned when a key is sent again with a different
+	// request
+	ErrKeyReused = errors.New("idempotency: key reused for a different request")
+	// ErrInProgress is returned when the request holding a key has not
+	// completed yet
+	ErrInProgress = errors.New("idempotency: request with this key is in progress")
+)
+
+// Response is a stored response, replayed to retries
+type Response struct {
+	Status      int
+	ContentType string
+	Body        []byte
+}
+
+// Service stores idempotency keys and the responses of their requests
+type Service struct {
+	db     *ent.Client
+	ttl    time.Duration
+	logger *zap.Logger
+}
+
+// NewService creates a new idempotency Service
+func NewService(db *ent.Client, cfg *config.IdempotencyConfig, logger *zap.Logger) *Service {
+	return &Service{
+		db:     db,
+		ttl:    time.Duration(cfg.KeyTTL) * time.Second,
+		logger: logger,
+	}
+}
+
+// Hash identifies a request by its method, path and body
+func Hash(method, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method + " " + path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Begin claims key for the request with hash on behalf of userID. It
+// returns the stored response when the request already completed, and nil
+// when the caller should run the request and then Complete or Release the
+// key.
+func (s *Service) Begin(ctx context.Context, userID, key, hash string) (*Response, error) {
+	now := time.Now()
+
+	// A second attempt is made after removing an expired or abandoned claim
+	for range 2 {
+		err := s.db.IdempotencyKey.Create().
+			SetUserID(userID).
+			SetKey(key).
+			SetRequestHash(hash).
+			SetExpiresAt(now.Add(s.ttl)).
+			Exec(ctx)
+		if err == nil {
+			return nil, nil
+		}
+		if !ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("failed to store idempotency key: %w", err)
+		}
+
+		existing, err := s.db.IdempotencyKey.Query().
+			Where(
+				idempotencykey.UserID(userID),
+				idempotencykey.Key(key),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query idempotency key: %w", err)
+		}
+
+		inProgress := existing.ResponseStatus == nil
+		switch {
+		case existing.ExpiresAt.Before(now),
+			inProgress && existing.CreatedAt.Before(now.Add(-abandonAfter)):
+			if _, err := s.db.IdempotencyKey.Delete().
+				Where(idempotencykey.ID(existing.ID)).
+				Exec(ctx); err != nil {
+				return nil, fmt.Errorf("failed to delete idempotency key: %w", err)
+			}
+		case existing.RequestHash != hash:
+			return nil, ErrKeyReused
+		case inProgress:
+			return nil, ErrInProgress
+		default:
+			return &Response{
+				Status:      *existing.ResponseStatus,
+				ContentType: existing.ResponseContentType,
+				Body:        existing.ResponseBody,
+			}, nil
+		}
+	}
+
+	// Another request claimed the key in between
+	return nil, ErrInProgress
+}
+
+// Complete stores the response of the request holding key
+func (s *Service) Complete(ctx context.Context, userID, key string, resp Response) error {
+	_, err := s.db.IdempotencyKey.Update().
+		Where(
+			idempotencykey.UserID(userID),
+			idempotencykey.Key(key),
+			idempotencykey.ResponseStatusIsNil(),
+		).
+		SetResponseStatus(resp.Status).
+		SetResponseContentType(resp.ContentType).
+		SetResponseBody(resp.Body).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to store response: %w", err)
+	}
+	return nil
+}
+
+// Release frees key without storing a response, so a retry runs the request
+// again
+func (s *Service) Release(ctx context.Context, userID, key string) error {
+	_, err := s.db.IdempotencyKey.Delete().
+		Where(
+			idempotencykey.UserID(userID),
+			idempotencykey.Key(key),
+			idempotencykey.ResponseStatusIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Sweep deletes expired keys
+func (s *Service) Sweep(ctx context.Context) error {
+	deleted, err := s.db.IdempotencyKey.Delete().
+		Where(idempotencykey.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+	if deleted > 0 {
+		s.logger.Info("deleted expired idempotency keys", zap.Int("count", deleted))
+	}
+	return nil
+}
+
+// RunSweeper calls Sweep every interval until ctx is cancelled
+func (s *Service) RunSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Sweep(ctx); err != nil {
+				s.logger.Error("idempotency key sweep failed", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Package ids generates the IDs of stored entities: a type prefix and a
+// ULID, e.g. ride_01jab3m2x7g5d9v1q8k4t6c0ze. The ULID holds a millisecond
+// timestamp followed by 80 random bits, in lowercase Crockford base32, so
+// IDs of one type sort in creation order and can serve as pagination
+// cursors. IDs created within the same millisecond sort randomly.
+package ids
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Prefixes of the entity types
+const (
+	User            = "user"
+	Vehicle         = "vehicle"
+	Ride            = "ride"
+	Booking         = "booking"
+	Payment         = "payment"
+	Payout          = "payout"
+	LedgerEntry     = "entry"
+	Transaction     = "txn"
+	Credit          = "credit"
+	PromoCode       = "promo"
+	PromoRedemption = "redemption"
+	IdempotencyKey  = "idempotency"
+	RateTable       = "rates"
+)
+
+// ErrInvalid is returned by Parse for strings that are not IDs of the
+// expected type
+var ErrInvalid = errors.New("ids: invalid id")
+
+const (
+	alphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+	// ulidLength is the length of an ID without its prefix: 10 characters of
+	// timestamp and 16 of randomness
+	ulidLength = 26
+	timeLength = 10
+)
+
+// New returns a new ID of the type with prefix
+func New(prefix string) string {
+	var entropy [10]byte
+	if _, err := rand.Read(entropy[:]); err != nil {
+		// crypto/rand only fails when the system is unusable
+		panic("ids: failed to read random bytes: " + err.Error())
+	}
+
+	b := make([]byte, len(prefix)+1+ulidLength)
+	n := copy(b, prefix)
+	b[n] = '_'
+	ulid := b[n+1:]
+
+	encode(ulid[:timeLength], uint64(time.Now().UnixMilli()))
+	// Five bytes are exactly eight characters
+	encode(ulid[timeLength:timeLength+8], uint64(entropy[0])<<32|uint64(binary.BigEndian.Uint32(entropy[1:5])))
+	encode(ulid[timeLength+8:], uint64(entropy[5])<<32|uint64(binary.BigEndian.Uint32(entropy[6:10])))
+	return string(b)
+}
+
+// NewFunc returns a function generating IDs with prefix, for ent field
+// defaults
+func NewFunc(prefix string) func() string {
+	return func() string { return New(prefix) }
+}
+
+// Parse checks that id is an ID of the type with prefix and returns the
+// time it was created
+func Parse(prefix, id string) (time.Time, error) {
+	ulid, ok := strings.CutPrefix(id, prefix+"_")
+	if !ok || len(ulid) != ulidLength {
+		return time.Time{}, ErrInvalid
+	}
+
+	var ms uint64
+	for i := range ulid {
+		v := strings.IndexByte(alphabet, ulid[i])
+		if v < 0 {
+			return time.Time{}, ErrInvalid
+		}
+		if i < timeLength {
+			ms = ms<<5 | uint64(v)
+		}
+	}
+	// Ten characters hold 50 bits, of which the timestamp uses 48
+	if ms >= 1<<48 {
+		return time.Time{}, ErrInvalid
+	}
+	return time.UnixMilli(int64(ms)), nil
+}
+
+// encode writes v into dst in base32, most significant character first
+func encode(dst []byte, v uint64) {
+	for i := len(dst) - 1; i >= 0; i-- {
+		dst[i] = alphabet[v&31]
+		v >>= 5
+	}
+}
@@ -0,0 +1,70 @@
+package ids_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/internal/ids"
+)
+
+func TestNew(t *testing.T) {
+	const n = 100000
+	seen := make(map[string]bool, n)
+	for range n {
+		id := ids.New(ids.Ride)
+		if seen[id] {
+			t.Fatalf("duplicate id %s", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestNewSortsByTime(t *testing.T) {
+	var created []string
+	for range 3 {
+		created = append(created, ids.New(ids.Booking))
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	sorted := append([]string(nil), created...)
+	sort.Strings(sorted)
+	for i := range created {
+		if sorted[i] != created[i] {
+			t.Fatalf("ids sort as %v, want creation order %v", sorted, created)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	before := time.Now().Truncate(time.Millisecond)
+	id := ids.New(ids.Ride)
+	after := time.Now()
+
+	tests := []struct {
+		name   string
+		prefix string
+		id     string
+		err    error
+	}{
+		{name: "valid", prefix: ids.Ride, id: id},
+		{name: "other type", prefix: ids.Booking, id: id, err: ids.ErrInvalid},
+		{name: "legacy id", prefix: ids.Ride, id: "ride_3fa85f64", err: ids.ErrInvalid},
+		{name: "invalid character", prefix: ids.Ride, id: id[:len(id)-1] + "u", err: ids.ErrInvalid},
+		{name: "timestamp overflow", prefix: ids.Ride, id: "ride_zzzzzzzzzzzzzzzzzzzzzzzzzz", err: ids.ErrInvalid},
+		{name: "empty", prefix: ids.Ride, id: "", err: ids.ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created, err := ids.Parse(tt.prefix, tt.id)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if err == nil && (created.Before(before) || created.After(after)) {
+				t.Errorf("created = %s, want between %s and %s", created, before, after)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/ledgerentry"
+	"github.com/slowtyper/poolie/backend/internal/ids"
+	"go.uber.org/zap"
+)
+
+// Ledger accounts. Passenger and driver accounts are scoped by user ID.
+const (
+	AccountPassenger     = "passenger"
+	AccountPlatformFee   = "platform_fee"
+	AccountDriverPayable = "driver_payable"
+	AccountPayouts       = "payouts"
+	AccountPromotions    = "promotions"
+)
+
+// Ledger transaction kinds
+const (
+	KindCapture        = "capture"
+	KindRefund         = "refund"
+	KindPenalty        = "penalty"
+	KindPayout         = "payout"
+	KindPayoutReversal = "payout_reversal"
+)
+
+var (
+	// ErrUnbalanced is returned when the postings of a transaction do not sum to zero
+	ErrUnbalanced = errors.New("ledger: transaction does not balance")
+	// ErrRefundExceedsCapture is returned when a refund is larger than the
+	// captured amount that has not been refunded yet
+	ErrRefundExceedsCapture = errors.New("ledger: refund exceeds captured amount")
+	// ErrUnknownCursor is returned when a statement page starts before an
+	// entry that is not in the driver's statement
+	ErrUnknownCursor = errors.New("ledger: unknown statement cursor")
+)
+
+// Posting is one side of a ledger transaction. Credits are positive and
+// debits are negative.
+type Posting struct {
+	Account string
+	UserID  string
+	Amount  int64
+}
+
+// Balance is the sum of an account's entries in a single currency
+type Balance struct {
+	Currency string `json:"currency"`
+	Amount   int64  `json:"sum"`
+}
+
+// Ledger records booking money movements as balanced double-entry
+// transactions
+type Ledger struct {
+	db            *ent.Client
+	commissionBps int64
+	logger        *zap.Logger
+}
+
+// New creates a new Ledger. The platform commission is expressed in basis
+// points of the captured amount.
+func New(db *ent.Client, commissionBps int, logger *zap.Logger) *Ledger {
+	return &Ledger{
+		db:            db,
+		commissionBps: int64(commissionBps),
+		logger:        logger,
+	}
+}
+
+// Fee returns the platform commission charged on amount
+func (l *Ledger) Fee(amount int64) int64 {
+	return amount * l.commissionBps / 10000
+}
+
+// RecordCapture records the capture of a booking payment: the passenger is
+// debited what they paid and the promotions account covers any discount or
+// credits, while the full subtotal is split between the platform fee and the
+// driver's payable balance. Recording the same capture twice is a no-op.
+func (l *Ledger) RecordCapture(ctx context.Context, client *ent.Client, bookingID string) error {
+	recorded, err := client.LedgerEntry.Query().
+		Where(
+			ledgerentry.KindEQ(KindCapture),
+			ledgerentry.BookingIDEQ(bookingID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check capture entries: %w", err)
+	}
+	if recorded {
+		return nil
+	}
+
+	b, err := client.Booking.Query().
+		Where(booking.IDEQ(bookingID)).
+		WithRide().
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch booking: %w", err)
+	}
+
+	subtotal := b.SubtotalAmount
+	if subtotal == 0 {
+		subtotal = b.TotalPriceAmount
+	}
+
+	fee := l.Fee(subtotal)
+	return l.post(ctx, client, KindCapture, b.ID, "", b.TotalPriceCurrency, []Posting{
+		{Account: AccountPassenger, UserID: b.PassengerID, Amount: -b.TotalPriceAmount},
+		{Account: AccountPromotions, Amount: -(subtotal - b.TotalPriceAmount)},
+		{Account: AccountPlatformFee, Amount: fee},
+		{Account: AccountDriverPayable, UserID: b.Edges.Ride.DriverID, Amount: subtotal - fee},
+	})
+}
+
+// RecordRefund records a refund of amount to the passenger of a captured
+// booking. Every other posting of the capture is reversed in proportion to
+// the refunded share of what the passenger paid, with rounding absorbed by
+// the driver's payable balance.
+func (l *Ledger) RecordRefund(ctx context.Context, client *ent.Client, bookingID string, amount int64) error {
+	entries, err := client.LedgerEntry.Query().
+		Where(
+			ledgerentry.BookingIDEQ(bookingID),
+			ledgerentry.KindIn(KindCapture, KindRefund),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch booking entries: %w", err)
+	}
+
+	var paid, refunded int64
+	var passengerID, currency string
+	var captured []*ent.LedgerEntry
+	for _, e := range entries {
+		switch {
+		case e.Kind == KindCapture && e.Account == AccountPassenger:
+			paid = -e.Amount
+			passengerID = e.UserID
+			currency = e.Currency
+		case e.Kind == KindCapture:
+			captured = append(captured, e)
+		case e.Kind == KindRefund && e.Account == AccountPassenger:
+			refunded += e.Amount
+		}
+	}
+
+	if amount <= 0 || amount > paid-refunded {
+		return ErrRefundExceedsCapture
+	}
+
+	postings := []Posting{{Account: AccountPassenger, UserID: passengerID, Amount: amount}}
+	remainder := -amount
+	driver := -1
+	for _, e := range captured {
+		share := -e.Amount * amount / paid
+		remainder -= share
+		if e.Account == AccountDriverPayable {
+			driver = len(postings)
+		}
+		postings = append(postings, Posting{Account: e.Account, UserID: e.UserID, Amount: share})
+	}
+	if driver < 0 {
+		return ErrUnbalanced
+	}
+	postings[driver].Amount += remainder
+
+	return l.post(ctx, client, KindRefund, bookingID, "", currency, postings)
+}
+
+// RecordPenalty records a cancellation penalty charged to a driver: the
+// amount is taken from the driver's payable balance and kept by the platform
+func (l *Ledger) RecordPenalty(ctx context.Context, client *ent.Client, bookingID, driverID string, amount int64, currency string) error {
+	return l.post(ctx, client, KindPenalty, bookingID, "", currency, []Posting{
+		{Account: AccountDriverPayable, UserID: driverID, Amount: -amount},
+		{Account: AccountPlatformFee, Amount: amount},
+	})
+}
+
+// Balances returns the payable balance owed to a driver in each currency
+func (l *Ledger) Balances(ctx context.Context, driverID string) ([]Balance, error) {
+	var balances []Balance
+	err := l.db.LedgerEntry.Query().
+		Where(
+			ledgerentry.AccountEQ(AccountDriverPayable),
+			ledgerentry.UserIDEQ(driverID),
+		).
+		GroupBy(ledgerentry.FieldCurrency).
+		Aggregate(ent.Sum(ledgerentry.FieldAmount)).
+		Scan(ctx, &balances)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute balances: %w", err)
+	}
+	return balances, nil
+}
+
+// Statement returns the most recent entries of a driver's payable account,
+// newest first. A non-empty before is the ID of an entry from a previous
+// page; only older entries are returned. Entries are ordered by creation
+// time rather than ID, because entries created before IDs were time-sortable
+// have random IDs.
+func (l *Ledger) Statement(ctx context.Context, driverID, before string, limit int) ([]*ent.LedgerEntry, error) {
+	query := l.db.LedgerEntry.Query().
+		Where(
+			ledgerentry.AccountEQ(AccountDriverPayable),
+			ledgerentry.UserIDEQ(driverID),
+		)
+	if before != "" {
+		cursor, err := l.db.LedgerEntry.Query().
+			Where(
+				ledgerentry.IDEQ(before),
+				ledgerentry.AccountEQ(AccountDriverPayable),
+				ledgerentry.UserIDEQ(driverID),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, ErrUnknownCursor
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch statement cursor: %w", err)
+		}
+		query = query.Where(ledgerentry.Or(
+			ledgerentry.CreatedAtLT(cursor.CreatedAt),
+			ledgerentry.And(
+				ledgerentry.CreatedAtEQ(cursor.CreatedAt),
+				ledgerentry.IDLT(cursor.ID),
+			),
+		))
+	}
+	entries, err := query.
+		Order(ent.Desc(ledgerentry.FieldCreatedAt), ent.Desc(ledgerentry.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch statement: %w", err)
+	}
+	return entries, nil
+}
+
+// post writes postings as a single balanced transaction
+func (l *Ledger) post(ctx context.Context, client *ent.Client, kind, bookingID, payoutID, currency string, postings []Posting) error {
+	var sum int64
+	for _, p := range postings {
+		sum += p.Amount
+	}
+	if sum != 0 {
+		return ErrUnbalanced
+	}
+
+	transactionID := ids.New(ids.Transaction)
+	builders := make([]*ent.LedgerEntryCreate, 0, len(postings))
+	for _, p := range postings {
+		if p.Amount == 0 {
+			continue
+		}
+		builder := client.LedgerEntry.Create().
+			SetTransactionID(transactionID).
+			SetKind(kind).
+			SetAccount(p.Account).
+			SetAmount(p.Amount).
+			SetCurrency(currency)
+		if p.UserID != "" {
+			builder = builder.SetUserID(p.UserID)
+		}
+		if bookingID != "" {
+			builder = builder.SetBookingID(bookingID)
+		}
+		if payoutID != "" {
+			builder = builder.SetPayoutID(payoutID)
+		}
+		builders = append(builders, builder)
+	}
+
+	if _, err := client.LedgerEntry.CreateBulk(builders...).Save(ctx); err != nil {
+		return fmt.Errorf("failed to write ledger entries: %w", err)
+	}
+
+	l.logger.Debug("ledger transaction posted",
+		zap.String("transaction_id", transactionID),
+		zap.String("kind", kind),
+	)
+	return nil
+}
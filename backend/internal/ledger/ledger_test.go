@@ -0,0 +1,139 @@
+package ledger_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/ledgerentry"
+	"github.com/slowtyper/poolie/backend/internal/ledger"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+	"go.uber.org/zap"
+)
+
+func TestFee(t *testing.T) {
+	tests := []struct {
+		name   string
+		bps    int
+		amount int64
+		want   int64
+	}{
+		{name: "ten percent", bps: 1000, amount: 120000, want: 12000},
+		{name: "rounds down", bps: 1000, amount: 12345, want: 1234},
+		{name: "below one unit", bps: 1000, amount: 9, want: 0},
+		{name: "fractional percent", bps: 1250, amount: 99, want: 12},
+		{name: "no commission", bps: 0, amount: 120000, want: 0},
+		{name: "everything", bps: 10000, amount: 120000, want: 120000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := ledger.New(nil, tt.bps, zap.NewNop())
+			if got := l.Fee(tt.amount); got != tt.want {
+				t.Errorf("Fee(%d) = %d, want %d", tt.amount, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecordCaptureAndRefund(t *testing.T) {
+	db, _ := testutil.NewDB(t)
+	l := ledger.New(db, 1000, zap.NewNop())
+	driver := testutil.CreateUser(t, db)
+	passenger := testutil.CreateUser(t, db)
+	r := testutil.CreateRide(t, db, driver)
+
+	tests := []struct {
+		name            string
+		subtotal, total int64
+		refunds         []int64
+		err             error
+		// want are the account balances of the booking after the capture
+		// and refunds
+		want map[string]int64
+	}{
+		{
+			name: "captured", subtotal: 120000, total: 120000,
+			want: map[string]int64{
+				ledger.AccountPassenger: -120000, ledger.AccountPlatformFee: 12000, ledger.AccountDriverPayable: 108000,
+			},
+		},
+		{
+			name: "discounted", subtotal: 120000, total: 90000,
+			want: map[string]int64{
+				ledger.AccountPassenger: -90000, ledger.AccountPromotions: -30000,
+				ledger.AccountPlatformFee: 12000, ledger.AccountDriverPayable: 108000,
+			},
+		},
+		{
+			name: "half refunded", subtotal: 120000, total: 90000, refunds: []int64{45000},
+			want: map[string]int64{
+				ledger.AccountPassenger: -45000, ledger.AccountPromotions: -15000,
+				ledger.AccountPlatformFee: 6000, ledger.AccountDriverPayable: 54000,
+			},
+		},
+		{
+			name: "fully refunded in parts", subtotal: 120000, total: 90000, refunds: []int64{30000, 60000},
+			want: map[string]int64{
+				ledger.AccountPassenger: 0, ledger.AccountPromotions: 0,
+				ledger.AccountPlatformFee: 0, ledger.AccountDriverPayable: 0,
+			},
+		},
+		{
+			// The fee share of 3333.2 is rounded toward zero and the driver
+			// absorbs the remaining unit
+			name: "rounding", subtotal: 100001, total: 100001, refunds: []int64{33333},
+			want: map[string]int64{
+				ledger.AccountPassenger: -66668, ledger.AccountPlatformFee: 6667, ledger.AccountDriverPayable: 60001,
+			},
+		},
+		{
+			name: "refund too large", subtotal: 120000, total: 90000, refunds: []int64{90001},
+			err: ledger.ErrRefundExceedsCapture,
+			want: map[string]int64{
+				ledger.AccountPassenger: -90000, ledger.AccountPromotions: -30000,
+				ledger.AccountPlatformFee: 12000, ledger.AccountDriverPayable: 108000,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			b := testutil.CreateBooking(t, db, r, passenger, func(b *ent.BookingCreate) {
+				b.SetSubtotalAmount(tt.subtotal).SetTotalPriceAmount(tt.total)
+			})
+
+			// Captures are recorded once however often they are reported
+			for range 2 {
+				if err := l.RecordCapture(ctx, db, b.ID); err != nil {
+					t.Fatalf("RecordCapture() error = %v", err)
+				}
+			}
+			var err error
+			for _, amount := range tt.refunds {
+				if err = l.RecordRefund(ctx, db, b.ID, amount); err != nil {
+					break
+				}
+			}
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("RecordRefund() error = %v, want %v", err, tt.err)
+			}
+
+			got := make(map[string]int64)
+			var sum int64
+			for _, e := range db.LedgerEntry.Query().Where(ledgerentry.BookingIDEQ(b.ID)).AllX(ctx) {
+				got[e.Account] += e.Amount
+				sum += e.Amount
+			}
+			if sum != 0 {
+				t.Errorf("entries sum to %d, want 0", sum)
+			}
+			for account, want := range tt.want {
+				if got[account] != want {
+					t.Errorf("%s balance = %d, want %d", account, got[account], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/ledgerentry"
+	"github.com/slowtyper/poolie/backend/ent/payout"
+	"github.com/slowtyper/poolie/backend/ent/user"
+	"github.com/slowtyper/poolie/backend/internal/config"
+	"github.com/slowtyper/poolie/backend/internal/db"
+	"go.uber.org/zap"
+)
+
+// Payout statuses
+const (
+	PayoutPending = "pending"
+	PayoutPaid    = "paid"
+	PayoutFailed  = "failed"
+)
+
+// payoutRetryAfter is how long a payout may stay pending before a batch
+// assumes the one that created it died and sends it again
+const payoutRetryAfter = 10 * time.Minute
+
+// PayoutRequest describes a transfer of a driver's payable balance
+type PayoutRequest struct {
+	Reference string
+	DriverID  string
+	Amount    int64
+	Currency  string
+}
+
+// ErrPayoutDeclined is returned by payout providers that refused a transfer
+// and will not make it
+var ErrPayoutDeclined = errors.New("ledger: payout declined")
+
+// PayoutProvider is implemented by services that transfer money to drivers.
+// Payouts whose outcome was not recorded are retried with the same
+// Reference, so Pay must be idempotent.
+type PayoutProvider interface {
+	// Name identifies the provider in stored payouts
+	Name() string
+	// Pay transfers the requested amount and returns the provider reference.
+	// Requests with the Reference of an earlier payout return its result
+	// without paying again. ErrPayoutDeclined means nothing was paid; after
+	// any other error the transfer may still have been made.
+	Pay(ctx context.Context, req PayoutRequest) (string, error)
+}
+
+// NewPayoutProvider creates the payout provider selected in the configuration
+func NewPayoutProvider(cfg *config.LedgerConfig, logger *zap.Logger) (PayoutProvider, error) {
+	switch cfg.PayoutProvider {
+	case "fake":
+		return NewFakePayoutProvider(logger), nil
+	default:
+		return nil, fmt.Errorf("unknown payout provider %q", cfg.PayoutProvider)
+	}
+}
+
+// FakePayoutProvider is a PayoutProvider for local development and testing
+// that logs every payout and succeeds unless a failure was injected with
+// FailNext
+type FakePayoutProvider struct {
+	mu         sync.Mutex
+	references map[string]string
+	fail       error
+	logger     *zap.Logger
+}
+
+// NewFakePayoutProvider creates a FakePayoutProvider logging to logger
+func NewFakePayoutProvider(logger *zap.Logger) *FakePayoutProvider {
+	return &FakePayoutProvider{
+		references: make(map[string]string),
+		logger:     logger,
+	}
+}
+
+// FailNext makes the next payout fail with err without paying anything, for
+// testing how failures are handled
+func (p *FakePayoutProvider) FailNext(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.fail = err
+}
+
+// Transfers returns the number of payouts made
+func (p *FakePayoutProvider) Transfers() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.references)
+}
+
+// Name returns the provider name
+func (p *FakePayoutProvider) Name() string {
+	return "fake"
+}
+
+// Pay logs the payout and returns a generated reference, or the reference
+// of the earlier payout with the same Reference
+func (p *FakePayoutProvider) Pay(ctx context.Context, req PayoutRequest) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.fail; err != nil {
+		p.fail = nil
+		return "", err
+	}
+	if reference, ok := p.references[req.Reference]; ok {
+		return reference, nil
+	}
+
+	reference := "po_fake_" + uuid.New().String()
+	p.references[req.Reference] = reference
+	p.logger.Info("fake payout sent",
+		zap.String("reference", req.Reference),
+		zap.String("driver_id", req.DriverID),
+		zap.Int64("amount", req.Amount),
+		zap.String("currency", req.Currency),
+	)
+	return reference, nil
+}
+
+// PayoutJob settles driver payable balances through a PayoutProvider
+type PayoutJob struct {
+	ledger   *Ledger
+	provider PayoutProvider
+	minimum  int64
+	logger   *zap.Logger
+}
+
+// NewPayoutJob creates a PayoutJob that pays out balances of at least minimum
+func NewPayoutJob(ledger *Ledger, provider PayoutProvider, minimum int64, logger *zap.Logger) *PayoutJob {
+	return &PayoutJob{
+		ledger:   ledger,
+		provider: provider,
+		minimum:  minimum,
+		logger:   logger,
+	}
+}
+
+// RunBatch retries payouts left pending for longer than payoutRetryAfter,
+// then pays out every driver balance that reached the minimum. Batches may
+// run concurrently, e.g. one per server replica: each payout locks the
+// driver and pays the balance read under the lock.
+func (j *PayoutJob) RunBatch(ctx context.Context) error {
+	pending, err := j.ledger.db.Payout.Query().
+		Where(
+			payout.StatusEQ(PayoutPending),
+			payout.CreatedAtLT(time.Now().Add(-payoutRetryAfter)),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query pending payouts: %w", err)
+	}
+
+	paid := 0
+	for _, p := range pending {
+		ok, err := j.send(ctx, p)
+		if err != nil {
+			j.logger.Error("payout retry failed",
+				zap.String("payout_id", p.ID),
+				zap.String("driver_id", p.DriverID),
+				zap.Error(err),
+			)
+			continue
+		}
+		if ok {
+			paid++
+		}
+	}
+
+	var balances []struct {
+		UserID   string `json:"user_id"`
+		Currency string `json:"currency"`
+		Sum      int64  `json:"sum"`
+	}
+	err = j.ledger.db.LedgerEntry.Query().
+		Where(ledgerentry.AccountEQ(AccountDriverPayable)).
+		GroupBy(ledgerentry.FieldUserID, ledgerentry.FieldCurrency).
+		Aggregate(ent.Sum(ledgerentry.FieldAmount)).
+		Scan(ctx, &balances)
+	if err != nil {
+		return fmt.Errorf("failed to compute payable balances: %w", err)
+	}
+
+	for _, b := range balances {
+		if b.Sum < j.minimum || b.Sum <= 0 {
+			continue
+		}
+		ok, err := j.payout(ctx, b.UserID, b.Currency)
+		if err != nil {
+			j.logger.Error("payout failed",
+				zap.String("driver_id", b.UserID),
+				zap.Error(err),
+			)
+			continue
+		}
+		if ok {
+			paid++
+		}
+	}
+
+	j.logger.Info("payout batch completed", zap.Int("payouts", paid))
+	return nil
+}
+
+// Run calls RunBatch every interval until ctx is cancelled
+func (j *PayoutJob) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.RunBatch(ctx); err != nil {
+				j.logger.Error("payout batch failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// payout moves the driver's payable balance out of their account before
+// calling the provider, so the same balance cannot be paid twice. The
+// balance is read while holding a lock on the driver, and payout reports
+// false without paying if it fell below the minimum because a concurrent
+// batch paid it first.
+func (j *PayoutJob) payout(ctx context.Context, driverID, currency string) (bool, error) {
+	tx, err := j.ledger.db.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if _, err := tx.User.Query().Where(user.IDEQ(driverID), db.ForUpdate).OnlyID(ctx); err != nil {
+		_ = tx.Rollback()
+		return false, fmt.Errorf("failed to lock driver: %w", err)
+	}
+
+	var balances []Balance
+	err = tx.LedgerEntry.Query().
+		Where(
+			ledgerentry.AccountEQ(AccountDriverPayable),
+			ledgerentry.UserIDEQ(driverID),
+			ledgerentry.CurrencyEQ(currency),
+		).
+		GroupBy(ledgerentry.FieldCurrency).
+		Aggregate(ent.Sum(ledgerentry.FieldAmount)).
+		Scan(ctx, &balances)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, fmt.Errorf("failed to compute payable balance: %w", err)
+	}
+	if len(balances) == 0 || balances[0].Amount < j.minimum || balances[0].Amount <= 0 {
+		_ = tx.Rollback()
+		return false, nil
+	}
+	amount := balances[0].Amount
+
+	p, err := tx.Payout.Create().
+		SetDriverID(driverID).
+		SetAmount(amount).
+		SetCurrency(currency).
+		SetStatus(PayoutPending).
+		SetProvider(j.provider.Name()).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, fmt.Errorf("failed to create payout: %w", err)
+	}
+
+	err = j.ledger.post(ctx, tx.Client(), KindPayout, "", p.ID, currency, []Posting{
+		{Account: AccountDriverPayable, UserID: driverID, Amount: -amount},
+		{Account: AccountPayouts, UserID: driverID, Amount: amount},
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit payout: %w", err)
+	}
+
+	return j.send(ctx, p)
+}
+
+// send asks the provider to make a pending payout and records the outcome.
+// A declined payout is reversed back into the payable account. After any
+// other error the transfer may have gone through, so the payout stays
+// pending and is retried by a later batch with the same reference.
+func (j *PayoutJob) send(ctx context.Context, p *ent.Payout) (bool, error) {
+	reference, payErr := j.provider.Pay(ctx, PayoutRequest{
+		Reference: p.ID,
+		DriverID:  p.DriverID,
+		Amount:    p.Amount,
+		Currency:  p.Currency,
+	})
+	if payErr == nil {
+		updated, err := j.ledger.db.Payout.Update().
+			Where(payout.IDEQ(p.ID), payout.StatusEQ(PayoutPending)).
+			SetStatus(PayoutPaid).
+			SetProviderReference(reference).
+			Save(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to mark payout paid: %w", err)
+		}
+		return updated > 0, nil
+	}
+	if !errors.Is(payErr, ErrPayoutDeclined) {
+		return false, fmt.Errorf("payout provider failed: %w", payErr)
+	}
+
+	tx, err := j.ledger.db.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// The status is checked so that a payout retried concurrently is only
+	// reversed once
+	updated, err := tx.Payout.Update().
+		Where(payout.IDEQ(p.ID), payout.StatusEQ(PayoutPending)).
+		SetStatus(PayoutFailed).
+		SetFailureReason(payErr.Error()).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, fmt.Errorf("failed to mark payout failed: %w", err)
+	}
+	if updated == 0 {
+		_ = tx.Rollback()
+		return false, fmt.Errorf("payout provider failed: %w", payErr)
+	}
+
+	err = j.ledger.post(ctx, tx.Client(), KindPayoutReversal, "", p.ID, p.Currency, []Posting{
+		{Account: AccountDriverPayable, UserID: p.DriverID, Amount: p.Amount},
+		{Account: AccountPayouts, UserID: p.DriverID, Amount: -p.Amount},
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit payout reversal: %w", err)
+	}
+
+	return false, fmt.Errorf("payout provider failed: %w", payErr)
+}
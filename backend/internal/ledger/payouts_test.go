@@ -0,0 +1,208 @@
+package ledger_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/payout"
+	"github.com/slowtyper/poolie/backend/internal/ledger"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+	"go.uber.org/zap"
+)
+
+// countingProvider is a PayoutProvider that counts its transfers
+type countingProvider struct {
+	paid atomic.Int64
+}
+
+func (p *countingProvider) Name() string {
+	return "counting"
+}
+
+func (p *countingProvider) Pay(ctx context.Context, req ledger.PayoutRequest) (string, error) {
+	p.paid.Add(1)
+	return "po_" + req.Reference, nil
+}
+
+func TestRunBatchConcurrently(t *testing.T) {
+	db, sqlDB := testutil.NewDB(t)
+	provider := &countingProvider{}
+	l := ledger.New(db, 1000, zap.NewNop())
+	job := ledger.NewPayoutJob(l, provider, 50000, zap.NewNop())
+	ctx := t.Context()
+
+	driver := testutil.CreateUser(t, db)
+	db.LedgerEntry.Create().
+		SetTransactionID("txn_test").
+		SetKind(ledger.KindCapture).
+		SetAccount(ledger.AccountDriverPayable).
+		SetUserID(driver.ID).
+		SetAmount(108000).
+		ExecX(ctx)
+
+	// Both batches read the balances before either pays them out, like
+	// batches of two replicas running at the same time. Transactions then
+	// take turns on the only connection, since SQLite has no row locks.
+	sqlDB.SetMaxOpenConns(1)
+	var reads sync.WaitGroup
+	reads.Add(2)
+	var queries atomic.Int64
+	db.LedgerEntry.Intercept(ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			v, err := next.Query(ctx, q)
+			if queries.Add(1) <= 2 {
+				reads.Done()
+				reads.Wait()
+			}
+			return v, err
+		})
+	}))
+
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Go(func() {
+			if err := job.RunBatch(ctx); err != nil {
+				t.Errorf("RunBatch() error = %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	payouts := db.Payout.Query().Where(payout.DriverIDEQ(driver.ID)).AllX(ctx)
+	if len(payouts) != 1 {
+		t.Fatalf("%d payouts, want 1", len(payouts))
+	}
+	if payouts[0].Amount != 108000 || payouts[0].Status != ledger.PayoutPaid {
+		t.Errorf("payout = %d %s, want 108000 %s", payouts[0].Amount, payouts[0].Status, ledger.PayoutPaid)
+	}
+	if paid := provider.paid.Load(); paid != 1 {
+		t.Errorf("provider paid %d times, want 1", paid)
+	}
+	balances, err := l.Balances(ctx, driver.ID)
+	if err != nil {
+		t.Fatalf("Balances() error = %v", err)
+	}
+	if len(balances) != 1 || balances[0].Amount != 0 {
+		t.Errorf("balances = %+v, want 0", balances)
+	}
+}
+
+func TestRunBatchRetriesPending(t *testing.T) {
+	db, _ := testutil.NewDB(t)
+	provider := ledger.NewFakePayoutProvider(zap.NewNop())
+	l := ledger.New(db, 1000, zap.NewNop())
+	// Balances never reach the minimum, so only pending payouts are sent
+	job := ledger.NewPayoutJob(l, provider, 1_000_000, zap.NewNop())
+	long := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		createdAt time.Time
+		// before runs against the provider before the batch
+		before     func(t *testing.T, p *ent.Payout)
+		wantStatus string
+		// wantTransfers counts the transfers made for the payout, which must
+		// not be paid twice
+		wantTransfers int
+		wantBalance   int64
+	}{
+		{
+			name:      "never sent",
+			createdAt: long, wantStatus: ledger.PayoutPaid, wantTransfers: 1,
+		},
+		{
+			name:      "sent but not recorded",
+			createdAt: long,
+			before: func(t *testing.T, p *ent.Payout) {
+				_, err := provider.Pay(t.Context(), ledger.PayoutRequest{Reference: p.ID, DriverID: p.DriverID, Amount: p.Amount, Currency: p.Currency})
+				if err != nil {
+					t.Fatalf("Pay() error = %v", err)
+				}
+			},
+			wantStatus: ledger.PayoutPaid, wantTransfers: 1,
+		},
+		{
+			name:      "in progress",
+			createdAt: time.Now(), wantStatus: ledger.PayoutPending,
+		},
+		{
+			name:      "declined",
+			createdAt: long,
+			before: func(t *testing.T, p *ent.Payout) {
+				provider.FailNext(fmt.Errorf("%w: account closed", ledger.ErrPayoutDeclined))
+			},
+			wantStatus: ledger.PayoutFailed, wantBalance: 108000,
+		},
+		{
+			name:      "provider unavailable",
+			createdAt: long,
+			before: func(t *testing.T, p *ent.Payout) {
+				provider.FailNext(errors.New("timeout"))
+			},
+			wantStatus: ledger.PayoutPending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			driver := testutil.CreateUser(t, db)
+
+			// The batch that created the payout moved the balance out of the
+			// payable account and stopped before recording the transfer
+			p := db.Payout.Create().
+				SetDriverID(driver.ID).
+				SetAmount(108000).
+				SetCurrency("IDR").
+				SetStatus(ledger.PayoutPending).
+				SetProvider(provider.Name()).
+				SetCreatedAt(tt.createdAt).
+				SaveX(ctx)
+			for _, e := range []struct {
+				kind, account string
+				amount        int64
+			}{
+				{ledger.KindCapture, ledger.AccountDriverPayable, 108000},
+				{ledger.KindPayout, ledger.AccountDriverPayable, -108000},
+				{ledger.KindPayout, ledger.AccountPayouts, 108000},
+			} {
+				db.LedgerEntry.Create().
+					SetTransactionID("txn_" + e.kind).
+					SetKind(e.kind).
+					SetAccount(e.account).
+					SetUserID(driver.ID).
+					SetAmount(e.amount).
+					ExecX(ctx)
+			}
+
+			transfers := provider.Transfers()
+			if tt.before != nil {
+				tt.before(t, p)
+			}
+			if err := job.RunBatch(ctx); err != nil {
+				t.Fatalf("RunBatch() error = %v", err)
+			}
+
+			p = db.Payout.GetX(ctx, p.ID)
+			if p.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", p.Status, tt.wantStatus)
+			}
+			if n := provider.Transfers() - transfers; n != tt.wantTransfers {
+				t.Errorf("%d transfers, want %d", n, tt.wantTransfers)
+			}
+			balances, err := l.Balances(ctx, driver.ID)
+			if err != nil {
+				t.Fatalf("Balances() error = %v", err)
+			}
+			if len(balances) != 1 || balances[0].Amount != tt.wantBalance {
+				t.Errorf("balances = %+v, want %d", balances, tt.wantBalance)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "poolie"
+
+// HTTP metrics
+var (
+	HTTPRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by method, route template and status code.",
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by method, route template and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+)
+
+// Business metrics
+var (
+	BookingsCreated = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "bookings_created_total",
+		Help:      "Bookings requested by passengers.",
+	})
+
+	BookingsConfirmed = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "bookings_confirmed_total",
+		Help:      "Bookings accepted by drivers.",
+	})
+
+	BookingsRejected = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "bookings_rejected_total",
+		Help:      "Bookings rejected by drivers.",
+	})
+
+	RidesPublished = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rides_published_total",
+		Help:      "Rides published by drivers.",
+	})
+
+	SearchResults = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "ride_search_results",
+		Help:      "Number of rides returned per search.",
+		Buckets:   []float64{0, 1, 2, 5, 10, 20, 50, 100, 200},
+	})
+)
+
+// NewRegistry creates a registry holding the service's metrics, Go runtime
+// and process metrics, and the connection pool stats of db
+func NewRegistry(db *sql.DB) *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewDBStatsCollector(db, namespace),
+		HTTPRequests,
+		HTTPRequestDuration,
+		BookingsCreated,
+		BookingsConfirmed,
+		BookingsRejected,
+		RidesPublished,
+		SearchResults,
+	)
+	return registry
+}
+
+// Handler serves the metrics in registry in the Prometheus exposition format
+func Handler(registry *prometheus.Registry) http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
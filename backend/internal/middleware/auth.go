@@ -1,62 +1,74 @@
 package middleware
 
 import (
-	"strings"
+	"crypto/subtle"
 
 	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/auth"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+	"go.uber.org/zap"
 )
 
-// AuthMiddleware validates JWT tokens
-// For now, this is a placeholder implementation
-// In production, you would validate actual JWT tokens
-func AuthMiddleware() fiber.Handler {
+// AuthMiddleware requires a valid bearer token and authenticates the request
+// as the user it was issued to
+func AuthMiddleware(tokens *auth.Tokens) fiber.Handler {
 	return func(c fiber.Ctx) error {
 		// Get the Authorization header
 		authHeader := c.Get("Authorization")
-
-		// For development, we'll accept any Bearer token
-		// In production, validate the JWT properly
 		if authHeader == "" {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": fiber.Map{
-					"code":    "UNAUTHORIZED",
-					"message": "Missing authorization header",
-				},
-			})
+			return apperr.Unauthorized("Missing authorization header")
 		}
 
 		// Extract the token from "Bearer <token>"
-		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": fiber.Map{
-					"code":    "UNAUTHORIZED",
-					"message": "Invalid authorization header format",
-				},
-			})
+		token, ok := auth.BearerToken(authHeader)
+		if !ok {
+			return apperr.Unauthorized("Invalid authorization header format")
 		}
 
-		// TODO: Validate JWT token and extract user ID
-		// For now, set a mock user ID in the context
-		c.Locals("user_id", "user_789")
+		userID, err := tokens.Parse(token)
+		if err != nil {
+			return apperr.Unauthorized("Invalid or expired token").Wrap(err)
+		}
+		setUserID(c, userID)
 
 		return c.Next()
 	}
 }
 
-// OptionalAuth allows requests with or without authentication
-func OptionalAuth() fiber.Handler {
+// OptionalAuth authenticates requests carrying a valid bearer token and lets
+// the others through anonymously
+func OptionalAuth(tokens *auth.Tokens) fiber.Handler {
 	return func(c fiber.Ctx) error {
-		authHeader := c.Get("Authorization")
-
-		if authHeader != "" {
-			parts := strings.Split(authHeader, " ")
-			if len(parts) == 2 && parts[0] == "Bearer" {
-				// TODO: Validate JWT token and extract user ID
-				c.Locals("user_id", "user_789")
+		if token, ok := auth.BearerToken(c.Get("Authorization")); ok {
+			if userID, err := tokens.Parse(token); err == nil {
+				setUserID(c, userID)
 			}
 		}
 
 		return c.Next()
 	}
 }
+
+// setUserID records the authenticated user on the Fiber context and on the
+// request context and logger passed to handlers
+func setUserID(c fiber.Ctx, userID string) {
+	c.Locals("user_id", userID)
+
+	ctx := requestctx.WithUserID(c.UserContext(), userID)
+	ctx = requestctx.WithLogger(ctx, requestctx.Logger(ctx).With(zap.String("user_id", userID)))
+	c.SetUserContext(ctx)
+}
+
+// AdminAuth only lets through requests carrying the configured admin token
+// in the X-Admin-Token header. Every request is rejected when token is empty.
+func AdminAuth(token string) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		provided := c.Get("X-Admin-Token")
+		if token == "" || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			return apperr.Forbidden("Admin access required")
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+)
+
+// RequestContext gives every request a context with a deadline of timeout,
+// which handlers pass to their database queries.
+// Responses to requests whose work was cut short by the deadline are
+// replaced with a 504 that wraps the handler's error, so it is still logged.
+func RequestContext(timeout time.Duration) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		ctx, cancel := context.WithTimeout(c.UserContext(), timeout)
+		defer cancel()
+
+		c.SetUserContext(ctx)
+
+		err := c.Next()
+
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) &&
+			(err != nil || c.Response().StatusCode() >= fiber.StatusInternalServerError) {
+			cause := err
+			if cause == nil {
+				cause = ctx.Err()
+			}
+			return apperr.New(fiber.StatusGatewayTimeout, "REQUEST_TIMEOUT", "Request timed out").Wrap(cause)
+		}
+
+		return err
+	}
+}
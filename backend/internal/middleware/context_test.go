@@ -0,0 +1,99 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/middleware"
+)
+
+var errQuery = errors.New("query interrupted")
+
+func TestRequestContext(t *testing.T) {
+	notFound := apperr.NotFound("Ride not found")
+
+	tests := []struct {
+		name    string
+		handler fiber.Handler
+		// wantErr is the error the error handler receives, matched with
+		// errors.Is
+		wantErr    error
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			handler:    func(c fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) },
+			wantStatus: fiber.StatusOK,
+		},
+		{
+			name:       "error before the deadline",
+			handler:    func(c fiber.Ctx) error { return notFound },
+			wantErr:    notFound,
+			wantStatus: fiber.StatusNotFound,
+		},
+		{
+			name: "deadline exceeded",
+			handler: func(c fiber.Ctx) error {
+				<-c.UserContext().Done()
+				return c.UserContext().Err()
+			},
+			wantErr:    context.DeadlineExceeded,
+			wantStatus: fiber.StatusGatewayTimeout,
+		},
+		{
+			name: "error after the deadline",
+			handler: func(c fiber.Ctx) error {
+				<-c.UserContext().Done()
+				return fmt.Errorf("failed to search rides: %w", errQuery)
+			},
+			wantErr:    errQuery,
+			wantStatus: fiber.StatusGatewayTimeout,
+		},
+		{
+			name: "server error response after the deadline",
+			handler: func(c fiber.Ctx) error {
+				<-c.UserContext().Done()
+				return c.SendStatus(fiber.StatusInternalServerError)
+			},
+			wantErr:    context.DeadlineExceeded,
+			wantStatus: fiber.StatusGatewayTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handled error
+			app := fiber.New(fiber.Config{
+				ErrorHandler: func(c fiber.Ctx, err error) error {
+					handled = err
+					return apperr.Handler()(c, err)
+				},
+			})
+			app.Use(middleware.RequestContext(10 * time.Millisecond))
+			app.Get("/", tt.handler)
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantErr == nil {
+				if handled != nil {
+					t.Errorf("error = %v, want none", handled)
+				}
+				return
+			}
+			if !errors.Is(handled, tt.wantErr) {
+				t.Errorf("error = %v, want %v", handled, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/idempotency"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+	"go.uber.org/zap"
+)
+
+const (
+	// IdempotencyKeyHeader carries the client's key for a request
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses replayed from a previous
+	// request with the same key
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// Idempotency replays the stored response to requests retried with the same
+// Idempotency-Key header, per user, so it must run after AuthMiddleware.
+// Only successful responses are stored; after an error the key is released
+// and a retry runs the request again. Requests without the header are let
+// through.
+func Idempotency(keys *idempotency.Service) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		key := c.Get(IdempotencyKeyHeader)
+		userID, _ := c.Locals("user_id").(string)
+		if key == "" || userID == "" {
+			return c.Next()
+		}
+		if len(key) > idempotency.MaxKeyLength {
+			return apperr.BadRequest("INVALID_IDEMPOTENCY_KEY", "Idempotency-Key must be at most 255 characters")
+		}
+
+		ctx := c.UserContext()
+		stored, err := keys.Begin(ctx, userID, key, idempotency.Hash(c.Method(), c.Path(), c.Body()))
+		switch {
+		case errors.Is(err, idempotency.ErrKeyReused):
+			return apperr.Conflict("IDEMPOTENCY_KEY_REUSED", "Idempotency-Key was already used for a different request")
+		case errors.Is(err, idempotency.ErrInProgress):
+			return apperr.Conflict("IDEMPOTENCY_KEY_IN_USE", "A request with this Idempotency-Key is still in progress")
+		case err != nil:
+			return apperr.Internal("Failed to check idempotency key", err)
+		case stored != nil:
+			c.Set(IdempotentReplayedHeader, "true")
+			c.Set(fiber.HeaderContentType, stored.ContentType)
+			return c.Status(stored.Status).Send(stored.Body)
+		}
+
+		err = c.Next()
+
+		// The key is settled even if the request ran out of time
+		ctx = context.WithoutCancel(ctx)
+		resp := c.Response()
+		if err != nil || resp.StatusCode() >= fiber.StatusMultipleChoices {
+			if releaseErr := keys.Release(ctx, userID, key); releaseErr != nil {
+				requestctx.Logger(ctx).Error("failed to release idempotency key", zap.Error(releaseErr))
+			}
+			return err
+		}
+
+		if err := keys.Complete(ctx, userID, key, idempotency.Response{
+			Status:      resp.StatusCode(),
+			ContentType: string(resp.Header.ContentType()),
+			Body:        bytes.Clone(resp.Body()),
+		}); err != nil {
+			requestctx.Logger(ctx).Error("failed to store idempotent response", zap.Error(err))
+		}
+		return nil
+	}
+}
@@ -4,35 +4,41 @@ import (
 	"time"
 
 	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
 	"go.uber.org/zap"
 )
 
-// Logger returns a Fiber middleware that logs HTTP requests
-func Logger(logger *zap.Logger) fiber.Handler {
+// Logger returns a Fiber middleware that logs HTTP requests with the
+// request-scoped logger, so it must run after RequestID
+func Logger() fiber.Handler {
 	return func(c fiber.Ctx) error {
 		start := time.Now()
 
 		// Process request
 		err := c.Next()
 
+		// Errors are turned into responses by the app's error handler later,
+		// which also logs server errors with their cause
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = apperr.Status(err)
+		}
+
+		// The user is only known once auth middleware has run
+		logger := requestctx.Logger(c.UserContext())
+
 		// Log request details
 		logger.Info("http request",
 			zap.String("method", c.Method()),
 			zap.String("path", c.Path()),
-			zap.Int("status", c.Response().StatusCode()),
+			zap.String("route", c.Route().Path),
+			zap.Int("status", status),
 			zap.Duration("latency", time.Since(start)),
 			zap.String("ip", c.IP()),
 			zap.String("user_agent", c.Get("User-Agent")),
 		)
 
-		if err != nil {
-			logger.Error("request error",
-				zap.String("method", c.Method()),
-				zap.String("path", c.Path()),
-				zap.Error(err),
-			)
-		}
-
 		return err
 	}
 }
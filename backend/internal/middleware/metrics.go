@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/metrics"
+)
+
+// Metrics records the count and latency of every request, labelled by the
+// route template rather than the raw path to keep label cardinality bounded
+func Metrics() fiber.Handler {
+	return func(c fiber.Ctx) error {
+		start := time.Now()
+
+		err := c.Next()
+
+		// Errors are turned into responses by the app's error handler later
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = apperr.Status(err)
+		}
+
+		labels := []string{c.Method(), c.Route().Path, strconv.Itoa(status)}
+		metrics.HTTPRequests.WithLabelValues(labels...).Inc()
+		metrics.HTTPRequestDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/ratelimit"
+	"go.uber.org/zap"
+)
+
+// RateLimit meters requests against policy, keyed by the authenticated user
+// when there is one and by client IP otherwise, so it must run after any
+// auth middleware on the route. Requests are let through when the store is
+// unavailable.
+func RateLimit(store ratelimit.Store, policy ratelimit.Policy, logger *zap.Logger) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		key := "ratelimit:" + policy.Name + ":ip:" + c.IP()
+		if userID, ok := c.Locals("user_id").(string); ok && userID != "" {
+			key = "ratelimit:" + policy.Name + ":user:" + userID
+		}
+
+		result, err := store.Take(c.UserContext(), key, policy, time.Now())
+		if err != nil {
+			logger.Error("rate limit store unavailable",
+				zap.String("policy", policy.Name),
+				zap.Error(err),
+			)
+			return c.Next()
+		}
+
+		c.Set("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Set("X-RateLimit-Reset", strconv.FormatInt(result.Reset.Unix(), 10))
+
+		if !result.Allowed {
+			c.Set("Retry-After", strconv.Itoa(int(math.Ceil(result.RetryAfter.Seconds()))))
+			return apperr.New(fiber.StatusTooManyRequests, "RATE_LIMITED", "Too many requests, please retry later")
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+	"go.uber.org/zap"
+)
+
+// RequestIDHeader carries the request ID in requests and responses
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds incoming request IDs so clients cannot bloat logs
+const maxRequestIDLength = 128
+
+// RequestID assigns every request an ID, reusing a well-formed incoming
+// X-Request-ID so requests can be followed across services. The ID is echoed
+// in the response and attached to the request context together with a
+// logger that records it; the error handler adds it to error responses.
+func RequestID(logger *zap.Logger) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		requestID := c.Get(RequestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = uuid.New().String()
+		}
+
+		c.Set(RequestIDHeader, requestID)
+		c.Locals("request_id", requestID)
+
+		ctx := requestctx.WithRequestID(c.UserContext(), requestID)
+		ctx = requestctx.WithLogger(ctx, logger.With(zap.String("request_id", requestID)))
+		c.SetUserContext(ctx)
+
+		return c.Next()
+	}
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r < 0x21 || r > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/requestctx"
+	"github.com/slowtyper/poolie/backend/internal/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Tracing starts a server span for every request, continuing any trace
+// passed in W3C trace-context headers, and adds the trace ID to the
+// request-scoped logger. It must run after RequestID.
+func Tracing() fiber.Handler {
+	tracer := tracing.Tracer()
+
+	return func(c fiber.Ctx) error {
+		headers := propagation.MapCarrier{}
+		for _, key := range otel.GetTextMapPropagator().Fields() {
+			if value := c.Get(key); value != "" {
+				headers[key] = value
+			}
+		}
+		ctx := otel.GetTextMapPropagator().Extract(c.UserContext(), headers)
+
+		ctx, span := tracer.Start(ctx, c.Method(),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(c.Method()),
+				semconv.URLPath(c.Path()),
+				semconv.ClientAddress(c.IP()),
+				semconv.UserAgentOriginal(c.Get(fiber.HeaderUserAgent)),
+			),
+		)
+		defer span.End()
+
+		if sc := span.SpanContext(); sc.IsValid() {
+			ctx = requestctx.WithLogger(ctx, requestctx.Logger(ctx).With(
+				zap.String("trace_id", sc.TraceID().String()),
+				zap.String("span_id", sc.SpanID().String()),
+			))
+		}
+		c.SetUserContext(ctx)
+
+		err := c.Next()
+
+		// The route template is only known once routing has run
+		route := c.Route().Path
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = apperr.Status(err)
+		}
+		span.SetName(c.Method() + " " + route)
+		span.SetAttributes(
+			semconv.HTTPRoute(route),
+			semconv.HTTPResponseStatusCode(status),
+		)
+		// Client errors are expected outcomes, not span failures
+		if status >= fiber.StatusInternalServerError {
+			if err != nil {
+				span.RecordError(err)
+			}
+			span.SetStatus(codes.Error, "")
+		}
+
+		return err
+	}
+}
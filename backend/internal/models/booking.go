@@ -7,6 +7,8 @@ type CreateBookingRequest struct {
 	RideID         string `json:"ride_id"`
 	PassengerCount int    `json:"passenger_count"`
 	Message        string `json:"message,omitempty"`
+	PromoCode      string `json:"promo_code,omitempty"`
+	DisplayCurrency string `json:"display_currency,omitempty"`
 }
 
 // BookingResponse represents a booking response
@@ -16,9 +18,31 @@ type BookingResponse struct {
 	Status         string       `json:"status"`
 	PassengerCount int          `json:"passenger_count"`
 	TotalPrice     Price        `json:"total_price"`
+	DisplayPrice   *DisplayPrice `json:"display_price,omitempty"`
+	PriceBreakdown PriceBreakdown `json:"price_breakdown"`
+	PaymentStatus  string       `json:"payment_status,omitempty"`
 	CreatedAt      time.Time    `json:"created_at"`
 	RespondedAt    *time.Time   `json:"responded_at,omitempty"`
 	RideDetails    RideSummary  `json:"ride_details"`
+	Cancellation   *BookingCancellation `json:"cancellation,omitempty"`
+}
+
+// BookingCancellation represents the outcome of a cancelled booking
+type BookingCancellation struct {
+	CancelledBy string    `json:"cancelled_by"`
+	CancelledAt time.Time `json:"cancelled_at"`
+	Reason      string    `json:"reason,omitempty"`
+	Refund      Price     `json:"refund"`
+	Penalty     Price     `json:"penalty"`
+}
+
+// PriceBreakdown represents how a booking's total price was computed
+type PriceBreakdown struct {
+	Subtotal  Price  `json:"subtotal"`
+	PromoCode string `json:"promo_code,omitempty"`
+	Discount  Price  `json:"discount"`
+	Credits   Price  `json:"credits"`
+	Total     Price  `json:"total"`
 }
 
 // RideSummary represents a summary of ride information in booking
@@ -39,3 +63,8 @@ type RespondToBookingRequest struct {
 	Action  string `json:"action"`
 	Message string `json:"message,omitempty"`
 }
+
+// CancelBookingRequest represents a request to cancel a booking
+type CancelBookingRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// EarningsStatement represents a driver's payable balance and recent ledger entries
+type EarningsStatement struct {
+	UserID   string          `json:"user_id"`
+	Balances []Price         `json:"balances"`
+	Entries  []EarningsEntry `json:"entries"`
+	// NextCursor is passed as before to fetch the next page, and empty on
+	// the last page
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// EarningsEntry represents a single movement on a driver's payable account
+type EarningsEntry struct {
+	EntryID       string    `json:"entry_id"`
+	TransactionID string    `json:"transaction_id"`
+	Kind          string    `json:"kind"`
+	BookingID     string    `json:"booking_id,omitempty"`
+	PayoutID      string    `json:"payout_id,omitempty"`
+	Amount        Price     `json:"amount"`
+	CreatedAt     time.Time `json:"created_at"`
+}
@@ -0,0 +1,23 @@
+package models
+
+// GraphQLRequest represents a GraphQL operation sent to POST /v1/graphql
+type GraphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// GraphQLError is an error of a GraphQL response
+type GraphQLError struct {
+	Message    string         `json:"message"`
+	Path       []any          `json:"path,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+// GraphQLResponse represents the result of a GraphQL operation. Errors of
+// single fields are reported alongside the rest of the data, which is null
+// when the operation could not run at all.
+type GraphQLResponse struct {
+	Data   any            `json:"data"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
@@ -0,0 +1,28 @@
+package models
+
+// LivenessResponse represents the response of the liveness probe
+type LivenessResponse struct {
+	Status    string `json:"status"`
+	Timestamp string `json:"timestamp"`
+}
+
+// HealthCheck is the outcome of a single readiness check
+type HealthCheck struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// MigrationCheck reports the database's migration version
+type MigrationCheck struct {
+	Status   string `json:"status"`
+	Current  int64  `json:"current"`
+	Expected int64  `json:"expected"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ReadinessResponse represents the response of the readiness probe
+type ReadinessResponse struct {
+	Status     string         `json:"status"`
+	Database   HealthCheck    `json:"database"`
+	Migrations MigrationCheck `json:"migrations"`
+}
@@ -0,0 +1,6 @@
+package models
+
+// WebhookResponse acknowledges a processed payment webhook
+type WebhookResponse struct {
+	Received bool `json:"received"`
+}
@@ -9,9 +9,10 @@ type ErrorResponse struct {
 
 // ErrorDetail contains error information
 type ErrorDetail struct {
-	Code    string      `json:"code"`
-	Message string      `json:"message"`
-	Details interface{} `json:"details,omitempty"`
+	Code      string      `json:"code"`
+	Message   string      `json:"message"`
+	Details   interface{} `json:"details,omitempty"`
+	RequestID string      `json:"request_id,omitempty"`
 }
 
 // Location represents a location with city, address, and point
@@ -27,6 +28,14 @@ type Price struct {
 	Currency string `json:"currency"`
 }
 
+// DisplayPrice represents a price converted to a caller-requested currency
+type DisplayPrice struct {
+	Amount       int64     `json:"amount"`
+	Currency     string    `json:"currency"`
+	ExchangeRate string    `json:"exchange_rate"`
+	RateAsOf     time.Time `json:"rate_as_of"`
+}
+
 // Driver represents driver information in ride responses
 type Driver struct {
 	UserID            string  `json:"user_id"`
@@ -66,6 +75,15 @@ type Stop struct {
 
 // BookingPolicies represents ride booking policies
 type BookingPolicies struct {
-	InstantConfirmation bool   `json:"instant_confirmation"`
-	CancellationPolicy  string `json:"cancellation_policy"`
+	InstantConfirmation bool         `json:"instant_confirmation"`
+	CancellationPolicy  string       `json:"cancellation_policy"`
+	RefundSchedule      []RefundTier `json:"refund_schedule"`
+}
+
+// RefundTier represents the refund a passenger receives when cancelling at
+// least the given number of hours before departure
+type RefundTier struct {
+	HoursBeforeDeparture int   `json:"hours_before_departure"`
+	RefundPercent        int   `json:"refund_percent"`
+	RefundAmount         Price `json:"refund_amount"`
 }
@@ -4,11 +4,12 @@ import "time"
 
 // SearchRidesRequest represents a ride search request
 type SearchRidesRequest struct {
-	Origin      string `query:"origin"`
-	Destination string `query:"destination"`
-	Date        string `query:"date"`
+	Origin      string `query:"origin" required:"true"`
+	Destination string `query:"destination" required:"true"`
+	Date        string `query:"date" required:"true"`
 	Passengers  int    `query:"passengers"`
 	Type        string `query:"type"`
+	Currency    string `query:"currency"`
 }
 
 // SearchRidesResponse represents the response for ride search
@@ -31,6 +32,7 @@ type RidePreview struct {
 	Origin          Location       `json:"origin"`
 	Destination     Location       `json:"destination"`
 	Price           Price          `json:"price"`
+	DisplayPrice    *DisplayPrice  `json:"display_price,omitempty"`
 	Driver          Driver         `json:"driver"`
 	Amenities       Amenities      `json:"amenities"`
 	AvailableSeats  int            `json:"available_seats"`
@@ -48,6 +50,7 @@ type RideDetail struct {
 	Origin          Location        `json:"origin"`
 	Destination     Location        `json:"destination"`
 	Price           Price           `json:"price"`
+	DisplayPrice    *DisplayPrice   `json:"display_price,omitempty"`
 	Driver          Driver          `json:"driver"`
 	Amenities       Amenities       `json:"amenities"`
 	AvailableSeats  int             `json:"available_seats"`
@@ -58,7 +61,7 @@ type RideDetail struct {
 
 // CreateRideRequest represents a request to create a new ride
 type CreateRideRequest struct {
-	RideType      string                 `json:"ride_type"`
+	RideType      string                 `json:"ride_type,omitempty"`
 	Recurrence    *Recurrence            `json:"recurrence,omitempty"`
 	Origin        Location               `json:"origin"`
 	Destination   Location               `json:"destination"`
@@ -66,7 +69,8 @@ type CreateRideRequest struct {
 	ArrivalTime   *time.Time             `json:"arrival_time,omitempty"`
 	AvailableSeats int                   `json:"available_seats"`
 	PricePerSeat  Price                  `json:"price_per_seat"`
-	Vehicle       Vehicle                `json:"vehicle"`
+	Vehicle       Vehicle                `json:"vehicle,omitempty"`
 	Amenities     map[string]interface{} `json:"amenities,omitempty"`
 	Description   string                 `json:"description,omitempty"`
+	CancellationPolicy string            `json:"cancellation_policy,omitempty"`
 }
@@ -0,0 +1,48 @@
+package openapi
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"html/template"
+	"sync"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+//go:embed docs.html
+var docsHTML string
+
+var docsTemplate = template.Must(template.New("docs").Parse(docsHTML))
+
+// Handler serves the spec's document as JSON. The document is built from
+// the app's routes on the first request, once every route is registered.
+func Handler(spec *Spec) fiber.Handler {
+	var (
+		once sync.Once
+		body []byte
+		err  error
+	)
+	return func(c fiber.Ctx) error {
+		once.Do(func() {
+			body, err = json.Marshal(spec.Build(c.App().GetRoutes(true)))
+		})
+		if err != nil {
+			return err
+		}
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		return c.Send(body)
+	}
+}
+
+// UI serves a Redoc page rendering the document at specURL
+func UI(specURL string) fiber.Handler {
+	var page bytes.Buffer
+	if err := docsTemplate.Execute(&page, struct{ SpecURL string }{specURL}); err != nil {
+		panic(err)
+	}
+	return func(c fiber.Ctx) error {
+		c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+		return c.Send(page.Bytes())
+	}
+}
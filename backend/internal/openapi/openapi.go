@@ -0,0 +1,308 @@
+// Package openapi builds an OpenAPI 3.1 document from the API's routes and
+// the Go types they accept and return
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// Version is the OpenAPI version documents are written in
+const Version = "3.1.0"
+
+// Security is how a route authenticates its caller
+type Security string
+
+const (
+	// Public routes need no credentials
+	Public Security = ""
+	// Bearer routes need a bearer token
+	Bearer Security = "bearer"
+	// OptionalBearer routes accept a bearer token but do not require one
+	OptionalBearer Security = "optional_bearer"
+	// Admin routes need the admin token
+	Admin Security = "admin"
+	// Webhook routes are authenticated by a request signature
+	Webhook Security = "webhook"
+)
+
+// Route documents one registered route. Path uses Fiber's syntax, e.g.
+// /v1/rides/:rideId. Query is a struct whose query-tagged fields are the
+// route's query parameters, with required:"true" marking mandatory ones,
+// and Header likewise documents header-tagged header parameters; Body is the request body type, which clients may leave out when
+// OptionalBody is set, and Responses map status codes to the response body
+// type, or nil for responses without a JSON body.
+type Route struct {
+	Method       string
+	Path         string
+	Summary      string
+	Description  string
+	Tag          string
+	Security     Security
+	Query        any
+	Header       any
+	Body         any
+	OptionalBody bool
+	Responses    map[int]any
+}
+
+// Spec is the documentation of an API's routes
+type Spec struct {
+	Info   Info
+	Routes []Route
+	// Error is the body of error responses, documented as every
+	// operation's default response
+	Error any
+}
+
+// Document is an OpenAPI document
+type Document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       Info                             `json:"info"`
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components Components                       `json:"components"`
+}
+
+// Info describes the API
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Operation is a single method on a path
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []*Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+// Parameter is a path, query or header parameter
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required,omitempty"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody is an operation's request body
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response is a response to an operation
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a body
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the schemas and security schemes operations refer to
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes"`
+}
+
+// SecurityScheme describes a way of authenticating
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	Name         string `json:"name,omitempty"`
+	In           string `json:"in,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+var securitySchemes = map[string]*SecurityScheme{
+	"bearerAuth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+	"adminToken": {Type: "apiKey", Name: "X-Admin-Token", In: "header"},
+	"webhookSignature": {
+		Type: "apiKey", Name: "X-Payment-Signature", In: "header",
+		Description: "HMAC-SHA256 of the raw body, keyed with the webhook secret",
+	},
+}
+
+var pathParam = regexp.MustCompile(`:(\w+)\??`)
+
+// Path converts a Fiber path to OpenAPI syntax, e.g. /rides/:rideId to
+// /rides/{rideId}
+func Path(fiberPath string) string {
+	return pathParam.ReplaceAllString(fiberPath, "{$1}")
+}
+
+// Key identifies a route by method and Fiber path
+func Key(method, path string) string {
+	return method + " " + path
+}
+
+// Build returns the document for the registered routes, as returned by
+// fiber.App.GetRoutes(true). Registered routes without an entry in the spec
+// are left out; see Undocumented.
+func (spec *Spec) Build(registered []fiber.Route) *Document {
+	byKey := make(map[string]Route, len(spec.Routes))
+	for _, r := range spec.Routes {
+		byKey[Key(r.Method, r.Path)] = r
+	}
+
+	s := newSchemas()
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    spec.Info,
+		Paths:   map[string]map[string]*Operation{},
+	}
+	for _, fr := range apiRoutes(registered) {
+		r, ok := byKey[Key(fr.Method, fr.Path)]
+		if !ok {
+			continue
+		}
+		op := s.operation(r, fr.Params)
+		if spec.Error != nil {
+			op.Responses["default"] = &Response{
+				Description: "Error",
+				Content:     jsonContent(s.of(reflect.TypeOf(spec.Error))),
+			}
+		}
+
+		path := Path(r.Path)
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = map[string]*Operation{}
+		}
+		doc.Paths[path][strings.ToLower(r.Method)] = op
+	}
+
+	doc.Components = Components{
+		Schemas:         s.components,
+		SecuritySchemes: securitySchemes,
+	}
+	return doc
+}
+
+// Undocumented returns the registered routes missing from the spec
+func (spec *Spec) Undocumented(registered []fiber.Route) []string {
+	keys := make(map[string]bool, len(spec.Routes))
+	for _, r := range spec.Routes {
+		keys[Key(r.Method, r.Path)] = true
+	}
+	var missing []string
+	for _, fr := range apiRoutes(registered) {
+		if !keys[Key(fr.Method, fr.Path)] {
+			missing = append(missing, Key(fr.Method, fr.Path))
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// Unregistered returns the routes in the spec that are not registered
+func (spec *Spec) Unregistered(registered []fiber.Route) []string {
+	keys := map[string]bool{}
+	for _, fr := range apiRoutes(registered) {
+		keys[Key(fr.Method, fr.Path)] = true
+	}
+	var stale []string
+	for _, r := range spec.Routes {
+		if !keys[Key(r.Method, r.Path)] {
+			stale = append(stale, Key(r.Method, r.Path))
+		}
+	}
+	sort.Strings(stale)
+	return stale
+}
+
+// apiRoutes drops the HEAD routes Fiber adds for every GET route
+func apiRoutes(registered []fiber.Route) []fiber.Route {
+	routes := make([]fiber.Route, 0, len(registered))
+	seen := map[string]bool{}
+	for _, r := range registered {
+		key := Key(r.Method, r.Path)
+		if r.Method == fiber.MethodHead || seen[key] {
+			continue
+		}
+		seen[key] = true
+		routes = append(routes, r)
+	}
+	return routes
+}
+
+func (s *schemas) operation(r Route, params []string) *Operation {
+	op := &Operation{
+		OperationID: operationID(r),
+		Summary:     r.Summary,
+		Description: r.Description,
+		Responses:   map[string]*Response{},
+	}
+	if r.Tag != "" {
+		op.Tags = []string{r.Tag}
+	}
+
+	for _, p := range params {
+		op.Parameters = append(op.Parameters, &Parameter{
+			Name: p, In: "path", Required: true, Schema: &Schema{Type: "string"},
+		})
+	}
+	if r.Query != nil {
+		op.Parameters = append(op.Parameters, s.params(reflect.TypeOf(r.Query), "query")...)
+	}
+	if r.Header != nil {
+		op.Parameters = append(op.Parameters, s.params(reflect.TypeOf(r.Header), "header")...)
+	}
+
+	if r.Body != nil {
+		op.RequestBody = &RequestBody{
+			Required: !r.OptionalBody,
+			Content:  jsonContent(s.of(reflect.TypeOf(r.Body))),
+		}
+	}
+
+	for status, body := range r.Responses {
+		resp := &Response{Description: http.StatusText(status)}
+		if body != nil {
+			resp.Content = jsonContent(s.of(reflect.TypeOf(body)))
+		}
+		op.Responses[strconv.Itoa(status)] = resp
+	}
+
+	switch r.Security {
+	case Bearer:
+		op.Security = []map[string][]string{{"bearerAuth": {}}}
+	case OptionalBearer:
+		op.Security = []map[string][]string{{}, {"bearerAuth": {}}}
+	case Admin:
+		op.Security = []map[string][]string{{"adminToken": {}}}
+	case Webhook:
+		op.Security = []map[string][]string{{"webhookSignature": {}}}
+	}
+	return op
+}
+
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{fiber.MIMEApplicationJSON: {Schema: schema}}
+}
+
+// operationID derives a stable ID such as getV1RidesRideId
+func operationID(r Route) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(r.Method))
+	for _, part := range strings.FieldsFunc(r.Path, func(c rune) bool {
+		return c == '/' || c == ':' || c == '-' || c == '.' || c == '?'
+	}) {
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
@@ -0,0 +1,169 @@
+package openapi
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Schema is a JSON Schema (draft 2020-12) as used by OpenAPI 3.1
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 any                `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemas builds schemas from Go types, collecting named struct types as
+// reusable components
+type schemas struct {
+	components map[string]*Schema
+	// names maps component names to the type they were built from, so two
+	// types with the same name are caught rather than silently merged
+	names map[string]reflect.Type
+}
+
+func newSchemas() *schemas {
+	return &schemas{
+		components: map[string]*Schema{},
+		names:      map[string]reflect.Type{},
+	}
+}
+
+// of returns the schema of the JSON encoding of t. Fields are named by their
+// json tag, and those without omitempty are required because the encoder
+// always writes them.
+func (s *schemas) of(t reflect.Type) *Schema {
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t.Kind() == reflect.Pointer:
+		return nullable(s.of(t.Elem()))
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		// nil slices encode as null
+		return &Schema{Type: []string{"array", "null"}, Items: s.of(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.of(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.object(t)
+		}
+		return s.ref(t)
+	default:
+		// interface{} and anything else accepts any value
+		return &Schema{}
+	}
+}
+
+// ref returns a reference to the component schema of the named struct t
+func (s *schemas) ref(t reflect.Type) *Schema {
+	name := t.Name()
+	if existing, ok := s.names[name]; ok && existing != t {
+		panic("openapi: schema name " + name + " is used by " + existing.String() + " and " + t.String())
+	}
+	if _, ok := s.names[name]; !ok {
+		s.names[name] = t
+		// Reserve the name before recursing so self-references terminate
+		s.components[name] = nil
+		s.components[name] = s.object(t)
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// object builds the schema of a struct's fields
+func (s *schemas) object(t reflect.Type) *Schema {
+	obj := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+		name, omitempty, ok := jsonName(f)
+		if !ok {
+			continue
+		}
+		obj.Properties[name] = s.of(f.Type)
+		if !omitempty {
+			obj.Required = append(obj.Required, name)
+		}
+	}
+	sort.Strings(obj.Required)
+	return obj
+}
+
+// params returns the parameters of a struct whose fields are tagged with
+// their location, query or header
+func (s *schemas) params(t reflect.Type, in string) []*Parameter {
+	var params []*Parameter
+	for _, f := range reflect.VisibleFields(t) {
+		name := strings.Split(f.Tag.Get(in), ",")[0]
+		if !f.IsExported() || name == "" || name == "-" {
+			continue
+		}
+		params = append(params, &Parameter{
+			Name:     name,
+			In:       in,
+			Required: f.Tag.Get("required") == "true",
+			Schema:   s.of(f.Type),
+		})
+	}
+	return params
+}
+
+// jsonName returns the JSON name of a struct field and whether it is omitted
+// when empty. ok is false for fields that are never encoded.
+func jsonName(f reflect.StructField) (name string, omitempty bool, ok bool) {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false, false
+	}
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if name == "" {
+		name = f.Name
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" || opt == "omitzero" {
+			omitempty = true
+		}
+	}
+	return name, omitempty, true
+}
+
+// nullable allows null in addition to what schema accepts
+func nullable(schema *Schema) *Schema {
+	if types, ok := schema.Type.([]string); ok {
+		for _, t := range types {
+			if t == "null" {
+				return schema
+			}
+		}
+	}
+	if t, ok := schema.Type.(string); ok && schema.Ref == "" {
+		copied := *schema
+		copied.Type = []string{t, "null"}
+		return &copied
+	}
+	return &Schema{AnyOf: []*Schema{schema, {Type: "null"}}}
+}
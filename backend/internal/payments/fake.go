@@ -0,0 +1,186 @@
+package payments
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// FakeProvider is an in-process PaymentProvider for local development and
+// testing. Intents live in memory and every operation succeeds unless the
+// intent is in the wrong state or a failure was injected with FailNext.
+type FakeProvider struct {
+	mu         sync.Mutex
+	intents    map[string]*Intent
+	references map[string]string
+	refunds    map[string]Intent
+	fail       error
+	secret     []byte
+}
+
+// NewFakeProvider creates a FakeProvider that signs webhooks with secret
+func NewFakeProvider(secret string) *FakeProvider {
+	return &FakeProvider{
+		intents:    make(map[string]*Intent),
+		references: make(map[string]string),
+		refunds:    make(map[string]Intent),
+		secret:     []byte(secret),
+	}
+}
+
+// FailNext makes the next operation fail with err without changing any
+// intent, for testing how failures are handled
+func (p *FakeProvider) FailNext(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.fail = err
+}
+
+// Intent returns a copy of an intent, or nil if it does not exist
+func (p *FakeProvider) Intent(intentID string) *Intent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	intent, ok := p.intents[intentID]
+	if !ok {
+		return nil
+	}
+	copied := *intent
+	return &copied
+}
+
+// injected returns and clears the failure set with FailNext. p.mu must be
+// held.
+func (p *FakeProvider) injected() error {
+	err := p.fail
+	p.fail = nil
+	return err
+}
+
+// Name returns the provider name
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+// CreateIntent authorizes the requested amount
+func (p *FakeProvider) CreateIntent(ctx context.Context, req IntentRequest) (*Intent, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.injected(); err != nil {
+		return nil, err
+	}
+	if id, ok := p.references[req.Reference]; ok && req.Reference != "" {
+		copied := *p.intents[id]
+		return &copied, nil
+	}
+
+	intent := &Intent{
+		ID:       "pi_fake_" + uuid.New().String(),
+		Status:   StatusRequiresCapture,
+		Amount:   req.Amount,
+		Currency: req.Currency,
+	}
+	p.intents[intent.ID] = intent
+	p.references[req.Reference] = intent.ID
+
+	copied := *intent
+	return &copied, nil
+}
+
+// Capture collects an authorized intent
+func (p *FakeProvider) Capture(ctx context.Context, intentID string) (*Intent, error) {
+	return p.transition(intentID, StatusSucceeded)
+}
+
+// Void releases an authorized intent
+func (p *FakeProvider) Void(ctx context.Context, intentID string) (*Intent, error) {
+	return p.transition(intentID, StatusCanceled)
+}
+
+// Refund returns amount of a captured intent, once per key
+func (p *FakeProvider) Refund(ctx context.Context, intentID string, amount int64, key string) (*Intent, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.injected(); err != nil {
+		return nil, err
+	}
+	if refunded, ok := p.refunds[key]; ok {
+		return &refunded, nil
+	}
+
+	intent, ok := p.intents[intentID]
+	if !ok {
+		return nil, ErrIntentNotFound
+	}
+	if intent.Status != StatusSucceeded {
+		return nil, ErrInvalidTransition
+	}
+	if amount <= 0 || intent.Refunded+amount > intent.Amount {
+		return nil, ErrRefundTooLarge
+	}
+
+	intent.Refunded += amount
+	p.refunds[key] = *intent
+	copied := *intent
+	return &copied, nil
+}
+
+// ParseWebhook verifies the hex-encoded HMAC-SHA256 signature of payload
+func (p *FakeProvider) ParseWebhook(payload []byte, signature string) (*WebhookEvent, error) {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, p.mac(payload)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedEvent, err)
+	}
+	return &event, nil
+}
+
+// Sign returns the signature the fake provider expects for payload. It is
+// useful for crafting webhook calls by hand during local development.
+func (p *FakeProvider) Sign(payload []byte) string {
+	return hex.EncodeToString(p.mac(payload))
+}
+
+func (p *FakeProvider) mac(payload []byte) []byte {
+	m := hmac.New(sha256.New, p.secret)
+	m.Write(payload)
+	return m.Sum(nil)
+}
+
+func (p *FakeProvider) transition(intentID, status string) (*Intent, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.injected(); err != nil {
+		return nil, err
+	}
+
+	intent, ok := p.intents[intentID]
+	if !ok {
+		return nil, ErrIntentNotFound
+	}
+
+	if intent.Status == status {
+		copied := *intent
+		return &copied, nil
+	}
+	if intent.Status != StatusRequiresCapture {
+		return nil, ErrInvalidTransition
+	}
+
+	intent.Status = status
+	copied := *intent
+	return &copied, nil
+}
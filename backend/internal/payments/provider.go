@@ -0,0 +1,106 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/slowtyper/poolie/backend/internal/config"
+)
+
+// Payment intent statuses, shared by providers and the payments table
+const (
+	// StatusAuthorizing is only used in the payments table, for payments
+	// whose intent has not been created yet
+	StatusAuthorizing     = "authorizing"
+	StatusRequiresCapture = "requires_capture"
+	StatusSucceeded       = "succeeded"
+	StatusRefunded        = "refunded"
+	StatusCanceled        = "canceled"
+	StatusFailed          = "failed"
+)
+
+// Webhook event types understood by the payments service
+const (
+	EventIntentSucceeded = "payment_intent.succeeded"
+	EventIntentCanceled  = "payment_intent.canceled"
+	EventIntentFailed    = "payment_intent.payment_failed"
+)
+
+// SignatureHeader is the HTTP header carrying the webhook signature
+const SignatureHeader = "X-Payment-Signature"
+
+var (
+	// ErrInvalidSignature is returned when a webhook payload fails verification
+	ErrInvalidSignature = errors.New("payments: invalid webhook signature")
+	// ErrMalformedEvent is returned for webhook payloads that cannot be decoded
+	// or are missing required fields
+	ErrMalformedEvent = errors.New("payments: malformed webhook event")
+	// ErrIntentNotFound is returned when a provider does not know an intent
+	ErrIntentNotFound = errors.New("payments: intent not found")
+	// ErrRefundTooLarge is returned when a refund exceeds the captured amount
+	ErrRefundTooLarge = errors.New("payments: refund exceeds captured amount")
+	// ErrInvalidTransition is returned when an intent cannot move to the requested state
+	ErrInvalidTransition = errors.New("payments: invalid intent state transition")
+	// ErrDeclined is returned by providers that refused to authorize funds.
+	// After any other error the intent may still have been created.
+	ErrDeclined = errors.New("payments: declined by provider")
+)
+
+// IntentRequest describes the funds to authorize for a booking
+type IntentRequest struct {
+	Reference string
+	Amount    int64
+	Currency  string
+}
+
+// Intent is a provider-side authorization of funds
+type Intent struct {
+	ID       string
+	Status   string
+	Amount   int64
+	Refunded int64
+	Currency string
+}
+
+// WebhookEvent is a verified asynchronous notification from a provider
+type WebhookEvent struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	IntentID string `json:"intent_id"`
+}
+
+// PaymentProvider is implemented by payment gateways. Funds are authorized
+// when a booking is created and held in escrow until they are captured on
+// confirmation or voided on rejection or expiry. Operations whose outcome
+// was not recorded are retried, so they must be idempotent.
+type PaymentProvider interface {
+	// Name identifies the provider in stored payments and events
+	Name() string
+	// CreateIntent authorizes funds without capturing them. Requests with
+	// the Reference of an existing intent return that intent. Payments the
+	// provider refuses fail with ErrDeclined.
+	CreateIntent(ctx context.Context, req IntentRequest) (*Intent, error)
+	// Capture collects previously authorized funds. Capturing a captured
+	// intent returns it unchanged.
+	Capture(ctx context.Context, intentID string) (*Intent, error)
+	// Void releases previously authorized funds. Voiding a voided intent
+	// returns it unchanged.
+	Void(ctx context.Context, intentID string) (*Intent, error)
+	// Refund returns part or all of a captured intent to the payer.
+	// Requests with the key of an earlier refund return its result without
+	// refunding again.
+	Refund(ctx context.Context, intentID string, amount int64, key string) (*Intent, error)
+	// ParseWebhook verifies the signature of a webhook payload and decodes it
+	ParseWebhook(payload []byte, signature string) (*WebhookEvent, error)
+}
+
+// NewProvider creates the payment provider selected in the configuration
+func NewProvider(cfg *config.PaymentsConfig) (PaymentProvider, error) {
+	switch cfg.Provider {
+	case "fake":
+		return NewFakeProvider(cfg.WebhookSecret), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", cfg.Provider)
+	}
+}
@@ -0,0 +1,580 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/payment"
+	"github.com/slowtyper/poolie/backend/ent/paymentevent"
+	"github.com/slowtyper/poolie/backend/internal/ledger"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrNoPayment is returned for bookings that have no payment attached,
+	// such as bookings created before payments were introduced
+	ErrNoPayment = errors.New("payments: booking has no payment")
+
+	errDuplicateEvent = errors.New("payments: duplicate webhook event")
+)
+
+// retryAfter is how long a payment operation may stay unfinished before the
+// sweeper assumes the request running it died and finishes it
+const retryAfter = time.Minute
+
+// Service moves booking funds through the configured PaymentProvider and
+// keeps the payments table in sync with the provider. Provider calls are
+// made outside database transactions: the payment is recorded first and
+// updated once the provider answered, and operations interrupted in between
+// are finished by the sweeper.
+type Service struct {
+	db       *ent.Client
+	provider PaymentProvider
+	ledger   *ledger.Ledger
+	onExpire []ExpireFunc
+	logger   *zap.Logger
+}
+
+// ExpireFunc is called within the transaction that expires a pending booking
+type ExpireFunc func(ctx context.Context, client *ent.Client, bookingID string) error
+
+// NewService creates a new payments Service
+func NewService(db *ent.Client, provider PaymentProvider, ledger *ledger.Ledger, logger *zap.Logger) *Service {
+	return &Service{
+		db:       db,
+		provider: provider,
+		ledger:   ledger,
+		logger:   logger,
+	}
+}
+
+// OnExpire registers fn to run whenever the sweeper expires a pending booking
+func (s *Service) OnExpire(fn ExpireFunc) {
+	s.onExpire = append(s.onExpire, fn)
+}
+
+// Prepare records the payment of a newly created booking, to be authorized
+// with Authorize once the booking is committed. Nothing is sent to the
+// provider, so client may be the transaction creating the booking.
+func (s *Service) Prepare(ctx context.Context, client *ent.Client, b *ent.Booking) (*ent.Payment, error) {
+	p, err := client.Payment.Create().
+		SetBookingID(b.ID).
+		SetProvider(s.provider.Name()).
+		SetAmount(b.TotalPriceAmount).
+		SetCurrency(b.TotalPriceCurrency).
+		SetStatus(StatusAuthorizing).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save payment: %w", err)
+	}
+	return p, nil
+}
+
+// Authorize creates the payment intent of a prepared payment, holding the
+// funds in escrow. A payment the provider declines is marked failed. After
+// any other error the provider may have created the intent, so the payment
+// stays authorizing until the sweeper authorizes it again by its Reference
+// and voids it if the booking was not taken. Like the other methods calling
+// the provider, it must not run within a transaction.
+func (s *Service) Authorize(ctx context.Context, bookingID string) (*ent.Payment, error) {
+	p, err := s.paymentForBooking(ctx, s.db, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Status != StatusAuthorizing {
+		return p, nil
+	}
+
+	intent, err := s.provider.CreateIntent(ctx, IntentRequest{
+		Reference: bookingID,
+		Amount:    p.Amount,
+		Currency:  p.Currency,
+	})
+	if errors.Is(err, ErrDeclined) {
+		_, updateErr := s.db.Payment.Update().
+			Where(
+				payment.IDEQ(p.ID),
+				payment.StatusEQ(StatusAuthorizing),
+			).
+			SetStatus(StatusFailed).
+			Save(ctx)
+		if updateErr != nil {
+			s.logger.Error("failed to mark payment failed",
+				zap.String("payment_id", p.ID),
+				zap.Error(updateErr),
+			)
+		}
+		return nil, fmt.Errorf("failed to create payment intent: %w", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment intent: %w", err)
+	}
+
+	// If this fails the sweeper authorizes the payment again, and the
+	// provider returns the intent it already created for the booking
+	_, err = s.db.Payment.Update().
+		Where(
+			payment.IDEQ(p.ID),
+			payment.StatusEQ(StatusAuthorizing),
+		).
+		SetProviderIntentID(intent.ID).
+		SetStatus(intent.Status).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update payment: %w", err)
+	}
+
+	return s.paymentForBooking(ctx, s.db, bookingID)
+}
+
+// Capture collects the funds held for a booking and records the capture in
+// the ledger
+func (s *Service) Capture(ctx context.Context, bookingID string) (*ent.Payment, error) {
+	p, err := s.paymentForBooking(ctx, s.db, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch p.Status {
+	case StatusSucceeded:
+		return p, nil
+	case StatusRequiresCapture:
+	default:
+		return nil, ErrInvalidTransition
+	}
+
+	intent, err := s.provider.Capture(ctx, p.ProviderIntentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to capture payment: %w", err)
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	if _, err := s.settle(ctx, tx.Client(), p, intent.Status); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit payment capture: %w", err)
+	}
+
+	return s.paymentForBooking(ctx, s.db, bookingID)
+}
+
+// Void releases the funds held for a booking
+func (s *Service) Void(ctx context.Context, bookingID string) (*ent.Payment, error) {
+	p, err := s.paymentForBooking(ctx, s.db, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch p.Status {
+	case StatusCanceled:
+		return p, nil
+	case StatusRequiresCapture:
+	default:
+		return nil, ErrInvalidTransition
+	}
+
+	intent, err := s.provider.Void(ctx, p.ProviderIntentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to void payment: %w", err)
+	}
+
+	if _, err := s.settle(ctx, s.db, p, intent.Status); err != nil {
+		return nil, err
+	}
+
+	return s.paymentForBooking(ctx, s.db, bookingID)
+}
+
+// Refund returns amount of the funds captured for a booking to the
+// passenger and records the refund in the ledger. The provider refunds
+// once per key, and the key only changes once a refund is recorded, so a
+// refund retried after it failed to be recorded is not paid twice.
+func (s *Service) Refund(ctx context.Context, bookingID string, amount int64) (*ent.Payment, error) {
+	p, err := s.paymentForBooking(ctx, s.db, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Status != StatusSucceeded {
+		return nil, ErrInvalidTransition
+	}
+	if amount <= 0 || p.RefundedAmount+amount > p.Amount {
+		return nil, ErrRefundTooLarge
+	}
+
+	key := fmt.Sprintf("%s_refund_%d", p.ID, p.RefundedAmount)
+	intent, err := s.provider.Refund(ctx, p.ProviderIntentID, amount, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refund payment: %w", err)
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// A concurrent retry of the same refund may have recorded it already
+	update := tx.Payment.Update().
+		Where(
+			payment.IDEQ(p.ID),
+			payment.RefundedAmountEQ(p.RefundedAmount),
+		).
+		SetRefundedAmount(intent.Refunded)
+	if intent.Refunded >= p.Amount {
+		update = update.SetStatus(StatusRefunded)
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to update payment: %w", err)
+	}
+	if updated > 0 {
+		if err := s.ledger.RecordRefund(ctx, tx.Client(), bookingID, amount); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit payment refund: %w", err)
+	}
+
+	return s.paymentForBooking(ctx, s.db, bookingID)
+}
+
+// HandleWebhook verifies and applies an asynchronous provider callback.
+// Events are recorded by ID so redeliveries are acknowledged without being
+// applied twice.
+func (s *Service) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	event, err := s.provider.ParseWebhook(payload, signature)
+	if err != nil {
+		return err
+	}
+	if event.ID == "" || event.IntentID == "" {
+		return ErrMalformedEvent
+	}
+
+	processed, err := s.db.PaymentEvent.Query().
+		Where(paymentevent.IDEQ(event.ID)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check payment event: %w", err)
+	}
+	if processed {
+		s.logger.Info("ignoring duplicate payment event", zap.String("event_id", event.ID))
+		return nil
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if err := s.applyEvent(ctx, tx.Client(), event); err != nil {
+		_ = tx.Rollback()
+		// A concurrent delivery of the same event won the race
+		if errors.Is(err, errDuplicateEvent) {
+			return nil
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit payment event: %w", err)
+	}
+
+	return nil
+}
+
+// Sweep expires bookings that stayed pending longer than expiry and
+// finishes payment operations that were interrupted: authorizations and
+// captures that never reached the provider or were never recorded,
+// payments still held for bookings that will never be confirmed, and
+// refunds owed for cancelled bookings.
+func (s *Service) Sweep(ctx context.Context, expiry time.Duration) error {
+	stale, err := s.db.Booking.Query().
+		Where(
+			booking.StatusEQ("pending"),
+			booking.CreatedAtLT(time.Now().Add(-expiry)),
+		).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query pending bookings: %w", err)
+	}
+
+	expired := 0
+	for _, id := range stale {
+		ok, err := s.expire(ctx, id)
+		if err != nil {
+			s.logger.Error("failed to expire booking",
+				zap.String("booking_id", id),
+				zap.Error(err),
+			)
+			continue
+		}
+		if ok {
+			expired++
+		}
+	}
+	if expired > 0 {
+		s.logger.Info("expired pending bookings", zap.Int("count", expired))
+	}
+
+	// Operations younger than retryAfter may still be running
+	before := time.Now().Add(-retryAfter)
+
+	authorizing, err := s.db.Payment.Query().
+		Where(
+			payment.StatusEQ(StatusAuthorizing),
+			payment.CreatedAtLT(before),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query authorizing payments: %w", err)
+	}
+	s.retry(authorizing, "authorize", func(p *ent.Payment) error {
+		_, err := s.Authorize(ctx, p.BookingID)
+		return err
+	})
+
+	uncaptured, err := s.db.Payment.Query().
+		Where(
+			payment.StatusEQ(StatusRequiresCapture),
+			payment.HasBookingWith(
+				booking.StatusIn("confirmed", "completed"),
+				booking.RespondedAtLT(before),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query uncaptured payments: %w", err)
+	}
+	s.retry(uncaptured, "capture", func(p *ent.Payment) error {
+		_, err := s.Capture(ctx, p.BookingID)
+		return err
+	})
+
+	held, err := s.db.Payment.Query().
+		Where(
+			payment.StatusEQ(StatusRequiresCapture),
+			payment.HasBookingWith(booking.StatusIn("rejected", "cancelled", "expired", "failed")),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query held payments: %w", err)
+	}
+	s.retry(held, "void", func(p *ent.Payment) error {
+		_, err := s.Void(ctx, p.BookingID)
+		return err
+	})
+
+	refundable, err := s.db.Payment.Query().
+		Where(
+			payment.StatusEQ(StatusSucceeded),
+			payment.HasBookingWith(
+				booking.StatusEQ("cancelled"),
+				booking.RefundAmountGT(0),
+				booking.CancelledAtLT(before),
+			),
+		).
+		WithBooking().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query refundable payments: %w", err)
+	}
+	var unrefunded []*ent.Payment
+	for _, p := range refundable {
+		if p.RefundedAmount < p.Edges.Booking.RefundAmount {
+			unrefunded = append(unrefunded, p)
+		}
+	}
+	s.retry(unrefunded, "refund", func(p *ent.Payment) error {
+		_, err := s.Refund(ctx, p.BookingID, p.Edges.Booking.RefundAmount-p.RefundedAmount)
+		return err
+	})
+
+	return nil
+}
+
+// retry runs op on each payment, logging failures
+func (s *Service) retry(ps []*ent.Payment, name string, op func(*ent.Payment) error) {
+	for _, p := range ps {
+		if err := op(p); err != nil {
+			s.logger.Error("failed to retry payment operation",
+				zap.String("operation", name),
+				zap.String("payment_id", p.ID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// RunSweeper calls Sweep every interval until ctx is cancelled
+func (s *Service) RunSweeper(ctx context.Context, interval, expiry time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Sweep(ctx, expiry); err != nil {
+				s.logger.Error("payment sweep failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// expire moves a booking from pending to expired unless the driver
+// responded in the meantime, and runs the expiry hooks
+func (s *Service) expire(ctx context.Context, bookingID string) (bool, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	updated, err := tx.Booking.Update().
+		Where(
+			booking.IDEQ(bookingID),
+			booking.StatusEQ("pending"),
+		).
+		SetStatus("expired").
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, fmt.Errorf("failed to expire booking: %w", err)
+	}
+	if updated == 0 {
+		_ = tx.Rollback()
+		return false, nil
+	}
+
+	for _, fn := range s.onExpire {
+		if err := fn(ctx, tx.Client(), bookingID); err != nil {
+			_ = tx.Rollback()
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit booking expiry: %w", err)
+	}
+	return true, nil
+}
+
+func (s *Service) paymentForBooking(ctx context.Context, client *ent.Client, bookingID string) (*ent.Payment, error) {
+	p, err := client.Payment.Query().
+		Where(payment.BookingIDEQ(bookingID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNoPayment
+		}
+		return nil, fmt.Errorf("failed to fetch payment: %w", err)
+	}
+	return p, nil
+}
+
+func (s *Service) applyEvent(ctx context.Context, client *ent.Client, event *WebhookEvent) error {
+	p, err := client.Payment.Query().
+		Where(
+			payment.ProviderEQ(s.provider.Name()),
+			payment.ProviderIntentIDEQ(event.IntentID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrIntentNotFound
+		}
+		return fmt.Errorf("failed to fetch payment: %w", err)
+	}
+
+	var status string
+	switch event.Type {
+	case EventIntentSucceeded:
+		status = StatusSucceeded
+	case EventIntentCanceled:
+		status = StatusCanceled
+	case EventIntentFailed:
+		status = StatusFailed
+	default:
+		s.logger.Info("ignoring unhandled payment event",
+			zap.String("event_id", event.ID),
+			zap.String("type", event.Type),
+		)
+	}
+
+	if status != "" && p.Status != status {
+		if p.Status != StatusRequiresCapture {
+			s.logger.Warn("payment event conflicts with recorded status",
+				zap.String("event_id", event.ID),
+				zap.String("payment_id", p.ID),
+				zap.String("status", p.Status),
+				zap.String("event_status", status),
+			)
+		} else if _, err := s.settle(ctx, client, p, status); err != nil {
+			return err
+		}
+	}
+
+	_, err = client.PaymentEvent.Create().
+		SetID(event.ID).
+		SetProvider(s.provider.Name()).
+		SetType(event.Type).
+		SetProviderIntentID(event.IntentID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return errDuplicateEvent
+		}
+		return fmt.Errorf("failed to record payment event: %w", err)
+	}
+
+	return nil
+}
+
+// settle moves a payment held for capture to status with client and
+// records captures in the ledger. It reports false when the payment was no
+// longer held, e.g. because a webhook or the sweeper settled it first.
+func (s *Service) settle(ctx context.Context, client *ent.Client, p *ent.Payment, status string) (bool, error) {
+	update := client.Payment.Update().
+		Where(
+			payment.IDEQ(p.ID),
+			payment.StatusEQ(StatusRequiresCapture),
+		).
+		SetStatus(status)
+	now := time.Now()
+	switch status {
+	case StatusSucceeded:
+		update = update.SetCapturedAt(now)
+	case StatusCanceled:
+		update = update.SetVoidedAt(now)
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to update payment: %w", err)
+	}
+	if updated == 0 {
+		return false, nil
+	}
+
+	if status == StatusSucceeded {
+		if err := s.ledger.RecordCapture(ctx, client, p.BookingID); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
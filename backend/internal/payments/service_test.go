@@ -0,0 +1,337 @@
+package payments_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/ledgerentry"
+	"github.com/slowtyper/poolie/backend/ent/payment"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+	"github.com/slowtyper/poolie/backend/internal/ledger"
+	"github.com/slowtyper/poolie/backend/internal/payments"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+	"go.uber.org/zap"
+)
+
+func TestSweepRetries(t *testing.T) {
+	db, _ := testutil.NewDB(t)
+	provider := payments.NewFakeProvider("secret")
+	svc := payments.NewService(db, provider, ledger.New(db, 1000, zap.NewNop()), zap.NewNop())
+	driver := testutil.CreateUser(t, db)
+	passenger := testutil.CreateUser(t, db)
+	r := testutil.CreateRide(t, db, driver)
+	long := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name string
+		// setup creates a booking and its payment in the state an
+		// interrupted request left them in
+		setup      func(t *testing.T) *ent.Booking
+		wantStatus string
+		wantIntent bool
+		wantRefund int64
+	}{
+		{
+			name: "authorization never sent",
+			setup: func(t *testing.T) *ent.Booking {
+				b := testutil.CreateBooking(t, db, r, passenger)
+				prepare(t, db, b, long)
+				return b
+			},
+			wantStatus: payments.StatusRequiresCapture,
+			wantIntent: true,
+		},
+		{
+			name: "authorization in progress",
+			setup: func(t *testing.T) *ent.Booking {
+				b := testutil.CreateBooking(t, db, r, passenger)
+				prepare(t, db, b, time.Now())
+				return b
+			},
+			wantStatus: payments.StatusAuthorizing,
+		},
+		{
+			name: "capture never sent",
+			setup: func(t *testing.T) *ent.Booking {
+				b := testutil.CreateBooking(t, db, r, passenger, func(b *ent.BookingCreate) {
+					b.SetStatus(bookings.StatusConfirmed).SetRespondedAt(long)
+				})
+				prepare(t, db, b, long)
+				authorize(t, svc, b)
+				return b
+			},
+			wantStatus: payments.StatusSucceeded,
+			wantIntent: true,
+		},
+		{
+			name: "void never sent",
+			setup: func(t *testing.T) *ent.Booking {
+				b := testutil.CreateBooking(t, db, r, passenger, func(b *ent.BookingCreate) {
+					b.SetStatus(bookings.StatusRejected).SetRespondedAt(long)
+				})
+				prepare(t, db, b, long)
+				authorize(t, svc, b)
+				return b
+			},
+			wantStatus: payments.StatusCanceled,
+			wantIntent: true,
+		},
+		{
+			name: "refund never sent",
+			setup: func(t *testing.T) *ent.Booking {
+				b := testutil.CreateBooking(t, db, r, passenger, func(b *ent.BookingCreate) {
+					b.SetStatus(bookings.StatusConfirmed).SetRespondedAt(long)
+				})
+				prepare(t, db, b, long)
+				authorize(t, svc, b)
+				if _, err := svc.Capture(t.Context(), b.ID); err != nil {
+					t.Fatalf("failed to capture payment: %v", err)
+				}
+				return db.Booking.UpdateOne(b).
+					SetStatus(bookings.StatusCancelled).
+					SetCancelledAt(long).
+					SetRefundAmount(r.PriceAmount / 2).
+					SaveX(t.Context())
+			},
+			wantStatus: payments.StatusSucceeded,
+			wantIntent: true,
+			wantRefund: r.PriceAmount / 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := tt.setup(t)
+
+			// A second sweep finds nothing left to do
+			for range 2 {
+				if err := svc.Sweep(t.Context(), 24*time.Hour); err != nil {
+					t.Fatalf("Sweep() error = %v", err)
+				}
+			}
+
+			p := db.Payment.Query().Where(payment.BookingIDEQ(b.ID)).OnlyX(t.Context())
+			if p.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", p.Status, tt.wantStatus)
+			}
+			if (p.ProviderIntentID != "") != tt.wantIntent {
+				t.Errorf("intent = %q, want set %v", p.ProviderIntentID, tt.wantIntent)
+			}
+			if p.RefundedAmount != tt.wantRefund {
+				t.Errorf("refunded = %d, want %d", p.RefundedAmount, tt.wantRefund)
+			}
+			if tt.wantIntent {
+				intent := provider.Intent(p.ProviderIntentID)
+				if intent == nil || intent.Status != p.Status || intent.Refunded != p.RefundedAmount {
+					t.Errorf("provider intent = %+v, want status %q refunded %d", intent, p.Status, p.RefundedAmount)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthorizeReusesIntent(t *testing.T) {
+	db, _ := testutil.NewDB(t)
+	provider := payments.NewFakeProvider("secret")
+	svc := payments.NewService(db, provider, ledger.New(db, 1000, zap.NewNop()), zap.NewNop())
+	r := testutil.CreateRide(t, db, testutil.CreateUser(t, db))
+	b := testutil.CreateBooking(t, db, r, testutil.CreateUser(t, db))
+	prepare(t, db, b, time.Now())
+
+	// The provider created the intent but the response was lost
+	ctx := t.Context()
+	intent, err := provider.CreateIntent(ctx, payments.IntentRequest{Reference: b.ID, Amount: b.TotalPriceAmount, Currency: b.TotalPriceCurrency})
+	if err != nil {
+		t.Fatalf("CreateIntent() error = %v", err)
+	}
+
+	p, err := svc.Authorize(ctx, b.ID)
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	if p.ProviderIntentID != intent.ID {
+		t.Errorf("intent = %q, want %q", p.ProviderIntentID, intent.ID)
+	}
+}
+
+func TestAuthorizeFails(t *testing.T) {
+	db, _ := testutil.NewDB(t)
+	provider := payments.NewFakeProvider("secret")
+	svc := payments.NewService(db, provider, ledger.New(db, 1000, zap.NewNop()), zap.NewNop())
+	r := testutil.CreateRide(t, db, testutil.CreateUser(t, db))
+	passenger := testutil.CreateUser(t, db)
+
+	tests := []struct {
+		name string
+		err  error
+		// created makes the provider create the intent before failing, like
+		// a request that timed out after the funds were held
+		created bool
+		// wantStatus is the payment status after Authorize, and wantSwept
+		// after the sweeper resolved it for the failed booking
+		wantStatus string
+		wantSwept  string
+	}{
+		{
+			name:       "declined",
+			err:        fmt.Errorf("%w: insufficient funds", payments.ErrDeclined),
+			wantStatus: payments.StatusFailed,
+			wantSwept:  payments.StatusFailed,
+		},
+		{
+			name:       "provider unavailable",
+			err:        errors.New("connection refused"),
+			wantStatus: payments.StatusAuthorizing,
+			wantSwept:  payments.StatusCanceled,
+		},
+		{
+			name:       "timed out after the hold",
+			err:        errors.New("timeout"),
+			created:    true,
+			wantStatus: payments.StatusAuthorizing,
+			wantSwept:  payments.StatusCanceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			b := testutil.CreateBooking(t, db, r, passenger)
+			prepare(t, db, b, time.Now().Add(-time.Hour))
+
+			var held *payments.Intent
+			if tt.created {
+				var err error
+				held, err = provider.CreateIntent(ctx, payments.IntentRequest{Reference: b.ID, Amount: b.TotalPriceAmount, Currency: b.TotalPriceCurrency})
+				if err != nil {
+					t.Fatalf("CreateIntent() error = %v", err)
+				}
+			}
+			provider.FailNext(tt.err)
+			if _, err := svc.Authorize(ctx, b.ID); !errors.Is(err, tt.err) {
+				t.Fatalf("Authorize() error = %v, want %v", err, tt.err)
+			}
+			p := db.Payment.Query().Where(payment.BookingIDEQ(b.ID)).OnlyX(ctx)
+			if p.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", p.Status, tt.wantStatus)
+			}
+
+			// The booking fails along with its authorization
+			db.Booking.UpdateOne(b).SetStatus(bookings.StatusFailed).ExecX(ctx)
+			if err := svc.Sweep(ctx, 24*time.Hour); err != nil {
+				t.Fatalf("Sweep() error = %v", err)
+			}
+			p = db.Payment.Query().Where(payment.BookingIDEQ(b.ID)).OnlyX(ctx)
+			if p.Status != tt.wantSwept {
+				t.Errorf("status after sweep = %q, want %q", p.Status, tt.wantSwept)
+			}
+			if held != nil && p.ProviderIntentID != held.ID {
+				t.Errorf("intent = %q, want the held intent %q", p.ProviderIntentID, held.ID)
+			}
+			if p.ProviderIntentID != "" {
+				if intent := provider.Intent(p.ProviderIntentID); intent.Status != payments.StatusCanceled {
+					t.Errorf("provider intent status = %q, want %q", intent.Status, payments.StatusCanceled)
+				}
+			}
+		})
+	}
+}
+
+func TestRefund(t *testing.T) {
+	db, _ := testutil.NewDB(t)
+	provider := payments.NewFakeProvider("secret")
+	svc := payments.NewService(db, provider, ledger.New(db, 1000, zap.NewNop()), zap.NewNop())
+	driver := testutil.CreateUser(t, db)
+	passenger := testutil.CreateUser(t, db)
+	r := testutil.CreateRide(t, db, driver)
+
+	tests := []struct {
+		name string
+		// refunds are made one after the other; only the last may fail
+		refunds      []int64
+		err          error
+		wantStatus   string
+		wantRefunded int64
+	}{
+		{name: "partial", refunds: []int64{60000}, wantStatus: payments.StatusSucceeded, wantRefunded: 60000},
+		{name: "full", refunds: []int64{120000}, wantStatus: payments.StatusRefunded, wantRefunded: 120000},
+		{name: "in parts", refunds: []int64{30000, 90000}, wantStatus: payments.StatusRefunded, wantRefunded: 120000},
+		{name: "more than paid", refunds: []int64{120001}, err: payments.ErrRefundTooLarge, wantStatus: payments.StatusSucceeded},
+		{name: "more than left", refunds: []int64{100000, 30000}, err: payments.ErrRefundTooLarge, wantStatus: payments.StatusSucceeded, wantRefunded: 100000},
+		{name: "nothing", refunds: []int64{0}, err: payments.ErrRefundTooLarge, wantStatus: payments.StatusSucceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			b := testutil.CreateBooking(t, db, r, passenger, func(b *ent.BookingCreate) {
+				b.SetStatus(bookings.StatusConfirmed)
+			})
+			prepare(t, db, b, time.Now())
+			authorize(t, svc, b)
+			if _, err := svc.Capture(ctx, b.ID); err != nil {
+				t.Fatalf("Capture() error = %v", err)
+			}
+
+			var err error
+			for _, amount := range tt.refunds {
+				_, err = svc.Refund(ctx, b.ID, amount)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Refund() error = %v, want %v", err, tt.err)
+			}
+
+			p := db.Payment.Query().Where(payment.BookingIDEQ(b.ID)).OnlyX(ctx)
+			if p.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", p.Status, tt.wantStatus)
+			}
+			if p.RefundedAmount != tt.wantRefunded {
+				t.Errorf("refunded = %d, want %d", p.RefundedAmount, tt.wantRefunded)
+			}
+			if intent := provider.Intent(p.ProviderIntentID); intent.Refunded != tt.wantRefunded {
+				t.Errorf("provider refunded = %d, want %d", intent.Refunded, tt.wantRefunded)
+			}
+
+			entries := db.LedgerEntry.Query().
+				Where(
+					ledgerentry.BookingIDEQ(b.ID),
+					ledgerentry.KindEQ(ledger.KindRefund),
+					ledgerentry.AccountEQ(ledger.AccountPassenger),
+				).
+				AllX(ctx)
+			var passengerRefunds int64
+			for _, e := range entries {
+				passengerRefunds += e.Amount
+			}
+			if passengerRefunds != tt.wantRefunded {
+				t.Errorf("ledger refunds = %d, want %d", passengerRefunds, tt.wantRefunded)
+			}
+		})
+	}
+}
+
+// prepare records the payment of b like Service.Prepare, as if it was
+// created at createdAt
+func prepare(t *testing.T, db *ent.Client, b *ent.Booking, createdAt time.Time) {
+	t.Helper()
+
+	db.Payment.Create().
+		SetBookingID(b.ID).
+		SetProvider(payments.NewFakeProvider("").Name()).
+		SetAmount(b.TotalPriceAmount).
+		SetCurrency(b.TotalPriceCurrency).
+		SetStatus(payments.StatusAuthorizing).
+		SetCreatedAt(createdAt).
+		ExecX(t.Context())
+}
+
+func authorize(t *testing.T, svc *payments.Service, b *ent.Booking) {
+	t.Helper()
+
+	if _, err := svc.Authorize(t.Context(), b.ID); err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+}
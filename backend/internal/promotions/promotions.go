@@ -0,0 +1,441 @@
+package promotions
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/credit"
+	"github.com/slowtyper/poolie/backend/ent/predicate"
+	"github.com/slowtyper/poolie/backend/ent/promocode"
+	"github.com/slowtyper/poolie/backend/ent/promoredemption"
+	"github.com/slowtyper/poolie/backend/ent/user"
+	"github.com/slowtyper/poolie/backend/internal/config"
+	"github.com/slowtyper/poolie/backend/internal/db"
+	"go.uber.org/zap"
+)
+
+// Discount types
+const (
+	DiscountPercentage = "percentage"
+	DiscountFixed      = "fixed"
+)
+
+// Credit kinds
+const (
+	CreditReferral = "referral"
+	CreditBooking  = "booking"
+	CreditRelease  = "release"
+)
+
+// Error is returned when a promo code cannot be applied to a booking
+type Error struct {
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return "promotions: " + e.Message
+}
+
+// Reasons a promo or referral code cannot be applied to a booking
+var (
+	ErrCodeNotFound       = &Error{Code: "PROMO_NOT_FOUND", Message: "Promo code not found"}
+	ErrCodeNotActive      = &Error{Code: "PROMO_NOT_ACTIVE", Message: "Promo code is not valid at this time"}
+	ErrCodeExhausted      = &Error{Code: "PROMO_EXHAUSTED", Message: "Promo code has reached its usage limit"}
+	ErrCodeUserLimit      = &Error{Code: "PROMO_USER_LIMIT", Message: "You have already used this promo code the maximum number of times"}
+	ErrMinSpend           = &Error{Code: "PROMO_MIN_SPEND", Message: "Booking total is below the promo code's minimum spend"}
+	ErrCurrencyMismatch   = &Error{Code: "PROMO_NOT_APPLICABLE", Message: "Promo code is not valid for this ride's currency"}
+	ErrRouteRestricted    = &Error{Code: "PROMO_NOT_APPLICABLE", Message: "Promo code is not valid for this ride"}
+	ErrReferralIneligible = &Error{Code: "REFERRAL_NOT_ELIGIBLE", Message: "Referral codes can only be used by new riders who were not referred yet"}
+	ErrCreditsSpent       = &Error{Code: "CREDITS_SPENT", Message: "Your credits were used by another booking"}
+)
+
+// Quote is the price of a booking after promotions and credits
+type Quote struct {
+	Subtotal    int64
+	Currency    string
+	PromoCode   string
+	PromoCodeID string
+	Discount    int64
+	Credits     int64
+	Total       int64
+	ReferrerID  string
+}
+
+// Service prices bookings with promo codes and credits and grants
+// referral credits
+type Service struct {
+	db               *ent.Client
+	referralCredit   int64
+	referralCurrency string
+	logger           *zap.Logger
+}
+
+// NewService creates a new promotions Service
+func NewService(db *ent.Client, cfg *config.PromotionsConfig, logger *zap.Logger) *Service {
+	return &Service{
+		db:               db,
+		referralCredit:   cfg.ReferralCreditAmount,
+		referralCurrency: cfg.ReferralCreditCurrency,
+		logger:           logger,
+	}
+}
+
+// NormalizeCode returns the canonical form of a promo or referral code
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Quote prices a booking of subtotal on ride r for userID. The code may be
+// a promo code or another user's referral code and may be empty. Available
+// credits in the ride's currency are applied after the discount. Nothing is
+// reserved until the quote is passed to Redeem.
+func (s *Service) Quote(ctx context.Context, client *ent.Client, userID string, r *ent.Ride, subtotal int64, code string) (*Quote, error) {
+	q := &Quote{
+		Subtotal: subtotal,
+		Currency: r.PriceCurrency,
+	}
+
+	if code = NormalizeCode(code); code != "" {
+		if err := s.applyCode(ctx, client, q, userID, r, code); err != nil {
+			return nil, err
+		}
+	}
+
+	balance, err := s.CreditBalance(ctx, client, userID, r.PriceCurrency)
+	if err != nil {
+		return nil, err
+	}
+	if balance > 0 {
+		q.Credits = min(balance, subtotal-q.Discount)
+	}
+
+	q.Total = subtotal - q.Discount - q.Credits
+	return q, nil
+}
+
+// Redeem reserves the promo code usage and spends the credits of q for a
+// booking. client must be a transaction: the user is locked until it ends,
+// and the per-user limit and credit balance are checked again under the
+// lock, so concurrent bookings by the same user cannot both use the last
+// allowed redemption or the same credits. The global usage cap is enforced
+// atomically. A quote can therefore still fail with ErrCodeExhausted,
+// ErrCodeUserLimit or ErrCreditsSpent.
+func (s *Service) Redeem(ctx context.Context, client *ent.Client, userID, bookingID string, q *Quote) error {
+	if q.PromoCodeID == "" && q.ReferrerID == "" && q.Credits == 0 {
+		return nil
+	}
+
+	if _, err := client.User.Query().Where(user.IDEQ(userID), db.ForUpdate).OnlyID(ctx); err != nil {
+		return fmt.Errorf("failed to lock user: %w", err)
+	}
+
+	if q.PromoCodeID != "" {
+		p, err := client.PromoCode.Get(ctx, q.PromoCodeID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch promo code: %w", err)
+		}
+		if err := checkUserLimit(ctx, client, p, userID); err != nil {
+			return err
+		}
+
+		updated, err := client.PromoCode.Update().
+			Where(
+				promocode.IDEQ(q.PromoCodeID),
+				promocode.Or(promocode.MaxUsesIsNil(), usesBelowMax()),
+			).
+			AddUsedCount(1).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to reserve promo code: %w", err)
+		}
+		if updated == 0 {
+			return ErrCodeExhausted
+		}
+
+		_, err = client.PromoRedemption.Create().
+			SetPromoCodeID(q.PromoCodeID).
+			SetUserID(userID).
+			SetBookingID(bookingID).
+			SetDiscountAmount(q.Discount).
+			SetCurrency(q.Currency).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to record promo redemption: %w", err)
+		}
+	}
+
+	if q.ReferrerID != "" {
+		_, err := client.User.UpdateOneID(userID).
+			SetReferredBy(q.ReferrerID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to record referral: %w", err)
+		}
+	}
+
+	if q.Credits > 0 {
+		balance, err := s.CreditBalance(ctx, client, userID, q.Currency)
+		if err != nil {
+			return err
+		}
+		if balance < q.Credits {
+			return ErrCreditsSpent
+		}
+
+		_, err = client.Credit.Create().
+			SetUserID(userID).
+			SetKind(CreditBooking).
+			SetAmount(-q.Credits).
+			SetCurrency(q.Currency).
+			SetBookingID(bookingID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to spend credits: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Release returns the promo code usage and credits of a booking that was
+// never taken, such as a rejected or expired booking. Releasing the same
+// booking twice is a no-op.
+func (s *Service) Release(ctx context.Context, client *ent.Client, bookingID string) error {
+	return s.Refund(ctx, client, bookingID, math.MaxInt64)
+}
+
+// Refund returns the promo code usage of a cancelled booking and up to
+// credits of the credits it still has spent, so that a partly refunded
+// booking only gives back its share of them. Credits returned earlier are
+// not returned again.
+func (s *Service) Refund(ctx context.Context, client *ent.Client, bookingID string, credits int64) error {
+	redemption, err := client.PromoRedemption.Query().
+		Where(
+			promoredemption.BookingIDEQ(bookingID),
+			promoredemption.ReleasedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to fetch promo redemption: %w", err)
+	}
+	if redemption != nil {
+		if _, err := client.PromoRedemption.UpdateOne(redemption).SetReleasedAt(time.Now()).Save(ctx); err != nil {
+			return fmt.Errorf("failed to release promo redemption: %w", err)
+		}
+		if _, err := client.PromoCode.UpdateOneID(redemption.PromoCodeID).AddUsedCount(-1).Save(ctx); err != nil {
+			return fmt.Errorf("failed to release promo code: %w", err)
+		}
+	}
+
+	spentCredits, err := client.Credit.Query().
+		Where(credit.BookingIDEQ(bookingID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch booking credits: %w", err)
+	}
+
+	var spent int64
+	for _, c := range spentCredits {
+		spent -= c.Amount
+	}
+	if amount := min(spent, credits); amount > 0 {
+		_, err := client.Credit.Create().
+			SetUserID(spentCredits[0].UserID).
+			SetKind(CreditRelease).
+			SetAmount(amount).
+			SetCurrency(spentCredits[0].Currency).
+			SetBookingID(bookingID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to release credits: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GrantReferralCredit credits the referrer of userID once userID has
+// completed their first ride. Each referred user earns their referrer a
+// credit at most once.
+func (s *Service) GrantReferralCredit(ctx context.Context, client *ent.Client, userID string) error {
+	if s.referralCredit <= 0 {
+		return nil
+	}
+
+	u, err := client.User.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch user: %w", err)
+	}
+	if u.ReferredBy == "" {
+		return nil
+	}
+
+	granted, err := client.Credit.Query().
+		Where(
+			credit.KindEQ(CreditReferral),
+			credit.SourceUserIDEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check referral credits: %w", err)
+	}
+	if granted {
+		return nil
+	}
+
+	_, err = client.Credit.Create().
+		SetUserID(u.ReferredBy).
+		SetKind(CreditReferral).
+		SetAmount(s.referralCredit).
+		SetCurrency(s.referralCurrency).
+		SetSourceUserID(userID).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to grant referral credit: %w", err)
+	}
+
+	s.logger.Info("referral credit granted",
+		zap.String("referrer_id", u.ReferredBy),
+		zap.String("referred_id", userID),
+	)
+	return nil
+}
+
+// CreditBalance returns a user's unspent credits in currency
+func (s *Service) CreditBalance(ctx context.Context, client *ent.Client, userID, currency string) (int64, error) {
+	var v []struct {
+		Sum sql.NullInt64 `json:"sum"`
+	}
+	err := client.Credit.Query().
+		Where(
+			credit.UserIDEQ(userID),
+			credit.CurrencyEQ(currency),
+		).
+		Aggregate(ent.Sum(credit.FieldAmount)).
+		Scan(ctx, &v)
+	if err != nil {
+		return 0, fmt.Errorf("failed to compute credit balance: %w", err)
+	}
+
+	// SUM over no rows is NULL
+	if len(v) == 0 || !v[0].Sum.Valid {
+		return 0, nil
+	}
+	return v[0].Sum.Int64, nil
+}
+
+func (s *Service) applyCode(ctx context.Context, client *ent.Client, q *Quote, userID string, r *ent.Ride, code string) error {
+	p, err := client.PromoCode.Query().
+		Where(promocode.CodeEQ(code)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return s.applyReferral(ctx, client, q, userID, code)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fetch promo code: %w", err)
+	}
+
+	now := time.Now()
+	if !p.Active ||
+		(p.ValidFrom != nil && now.Before(*p.ValidFrom)) ||
+		(p.ValidUntil != nil && !now.Before(*p.ValidUntil)) {
+		return ErrCodeNotActive
+	}
+	if p.MaxUses != nil && p.UsedCount >= *p.MaxUses {
+		return ErrCodeExhausted
+	}
+
+	if p.OriginCity != "" && !strings.EqualFold(p.OriginCity, r.OriginCity) ||
+		p.DestinationCity != "" && !strings.EqualFold(p.DestinationCity, r.DestinationCity) ||
+		p.RideType != "" && p.RideType != r.Type {
+		return ErrRouteRestricted
+	}
+
+	amountsInCurrency := p.DiscountType == DiscountFixed || p.MinSpend > 0 || p.MaxDiscount != nil
+	if amountsInCurrency && p.Currency != q.Currency {
+		return ErrCurrencyMismatch
+	}
+	if q.Subtotal < p.MinSpend {
+		return ErrMinSpend
+	}
+
+	if err := checkUserLimit(ctx, client, p, userID); err != nil {
+		return err
+	}
+
+	var discount int64
+	switch p.DiscountType {
+	case DiscountFixed:
+		discount = p.DiscountValue
+	default:
+		discount = q.Subtotal * p.DiscountValue / 100
+	}
+	if p.MaxDiscount != nil {
+		discount = min(discount, *p.MaxDiscount)
+	}
+
+	q.PromoCode = p.Code
+	q.PromoCodeID = p.ID
+	q.Discount = min(discount, q.Subtotal)
+	return nil
+}
+
+func (s *Service) applyReferral(ctx context.Context, client *ent.Client, q *Quote, userID, code string) error {
+	referrer, err := client.User.Query().
+		Where(user.ReferralCodeEQ(code)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return ErrCodeNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fetch referrer: %w", err)
+	}
+
+	u, err := client.User.Get(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch user: %w", err)
+	}
+	if referrer.ID == u.ID || u.ReferredBy != "" || u.CompletedRides > 0 {
+		return ErrReferralIneligible
+	}
+
+	q.PromoCode = code
+	q.ReferrerID = referrer.ID
+	return nil
+}
+
+// checkUserLimit returns ErrCodeUserLimit if userID holds as many
+// redemptions of p as it allows per user
+func checkUserLimit(ctx context.Context, client *ent.Client, p *ent.PromoCode, userID string) error {
+	if p.MaxUsesPerUser == nil {
+		return nil
+	}
+
+	used, err := client.PromoRedemption.Query().
+		Where(
+			promoredemption.PromoCodeIDEQ(p.ID),
+			promoredemption.UserIDEQ(userID),
+			promoredemption.ReleasedAtIsNil(),
+		).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count promo redemptions: %w", err)
+	}
+	if used >= *p.MaxUsesPerUser {
+		return ErrCodeUserLimit
+	}
+	return nil
+}
+
+// usesBelowMax matches promo codes whose used_count is below max_uses
+func usesBelowMax() predicate.PromoCode {
+	return predicate.PromoCode(func(s *sql.Selector) {
+		s.Where(sql.ColumnsLT(s.C(promocode.FieldUsedCount), s.C(promocode.FieldMaxUses)))
+	})
+}
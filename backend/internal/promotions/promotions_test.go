@@ -0,0 +1,260 @@
+package promotions_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/internal/config"
+	"github.com/slowtyper/poolie/backend/internal/promotions"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+	"go.uber.org/zap"
+)
+
+func newService(db *ent.Client) *promotions.Service {
+	return promotions.NewService(db, &config.PromotionsConfig{
+		ReferralCreditAmount:   25000,
+		ReferralCreditCurrency: "IDR",
+	}, zap.NewNop())
+}
+
+func TestQuote(t *testing.T) {
+	db, _ := testutil.NewDB(t)
+	svc := newService(db)
+	driver := testutil.CreateUser(t, db)
+	r := testutil.CreateRide(t, db, driver)
+	subtotal := r.PriceAmount
+	yesterday := time.Now().Add(-24 * time.Hour)
+
+	tests := []struct {
+		name string
+		code string
+		// promo configures the promo code created as code, if any
+		promo   func(p *ent.PromoCodeCreate)
+		credits int64
+		// redeemed is how often the passenger already used the code
+		redeemed      int
+		err           error
+		wantDiscount  int64
+		wantCredits   int64
+		wantTotal     int64
+		wantPromoCode string
+	}{
+		{name: "no code", wantTotal: 120000},
+		{
+			name: "percentage", code: "TENOFF",
+			promo:        func(p *ent.PromoCodeCreate) { p.SetDiscountValue(10) },
+			wantDiscount: 12000, wantTotal: 108000, wantPromoCode: "TENOFF",
+		},
+		{
+			name: "percentage rounds down", code: "THIRD",
+			promo:        func(p *ent.PromoCodeCreate) { p.SetDiscountValue(33) },
+			wantDiscount: 39600, wantTotal: 80400, wantPromoCode: "THIRD",
+		},
+		{
+			name: "percentage capped", code: "CAPPED",
+			promo:        func(p *ent.PromoCodeCreate) { p.SetDiscountValue(50).SetMaxDiscount(5000) },
+			wantDiscount: 5000, wantTotal: 115000, wantPromoCode: "CAPPED",
+		},
+		{
+			name: "fixed", code: "FIXED",
+			promo:        func(p *ent.PromoCodeCreate) { p.SetDiscountType(promotions.DiscountFixed).SetDiscountValue(20000) },
+			wantDiscount: 20000, wantTotal: 100000, wantPromoCode: "FIXED",
+		},
+		{
+			name: "fixed above subtotal", code: "FREE",
+			promo:        func(p *ent.PromoCodeCreate) { p.SetDiscountType(promotions.DiscountFixed).SetDiscountValue(500000) },
+			wantDiscount: 120000, wantTotal: 0, wantPromoCode: "FREE",
+		},
+		{
+			name: "code is normalized", code: " lower ",
+			promo:        func(p *ent.PromoCodeCreate) { p.SetCode("LOWER").SetDiscountValue(10) },
+			wantDiscount: 12000, wantTotal: 108000, wantPromoCode: "LOWER",
+		},
+		{
+			name: "credits after discount", code: "TENCREDIT", credits: 25000,
+			promo:        func(p *ent.PromoCodeCreate) { p.SetDiscountValue(10) },
+			wantDiscount: 12000, wantCredits: 25000, wantTotal: 83000, wantPromoCode: "TENCREDIT",
+		},
+		{
+			name: "credits capped at total", code: "FIXEDCREDIT", credits: 200000,
+			promo:        func(p *ent.PromoCodeCreate) { p.SetDiscountType(promotions.DiscountFixed).SetDiscountValue(20000) },
+			wantDiscount: 20000, wantCredits: 100000, wantTotal: 0, wantPromoCode: "FIXEDCREDIT",
+		},
+		{name: "unknown code", code: "NOPE", err: promotions.ErrCodeNotFound},
+		{
+			name: "below min spend", code: "MINSPEND",
+			promo: func(p *ent.PromoCodeCreate) { p.SetDiscountValue(10).SetMinSpend(150000) },
+			err:   promotions.ErrMinSpend,
+		},
+		{
+			name: "global cap reached", code: "GONE",
+			promo: func(p *ent.PromoCodeCreate) { p.SetDiscountValue(10).SetMaxUses(3).SetUsedCount(3) },
+			err:   promotions.ErrCodeExhausted,
+		},
+		{
+			name: "below global cap", code: "LASTONE",
+			promo:        func(p *ent.PromoCodeCreate) { p.SetDiscountValue(10).SetMaxUses(3).SetUsedCount(2) },
+			wantDiscount: 12000, wantTotal: 108000, wantPromoCode: "LASTONE",
+		},
+		{
+			name: "per-user cap reached", code: "TWICE", redeemed: 2,
+			promo: func(p *ent.PromoCodeCreate) { p.SetDiscountValue(10).SetMaxUsesPerUser(2) },
+			err:   promotions.ErrCodeUserLimit,
+		},
+		{
+			name: "below per-user cap", code: "TWICEMORE", redeemed: 1,
+			promo:        func(p *ent.PromoCodeCreate) { p.SetDiscountValue(10).SetMaxUsesPerUser(2) },
+			wantDiscount: 12000, wantTotal: 108000, wantPromoCode: "TWICEMORE",
+		},
+		{
+			name: "inactive", code: "OFF",
+			promo: func(p *ent.PromoCodeCreate) { p.SetDiscountValue(10).SetActive(false) },
+			err:   promotions.ErrCodeNotActive,
+		},
+		{
+			name: "expired", code: "OLD",
+			promo: func(p *ent.PromoCodeCreate) { p.SetDiscountValue(10).SetValidUntil(yesterday) },
+			err:   promotions.ErrCodeNotActive,
+		},
+		{
+			name: "other currency", code: "DOLLARS",
+			promo: func(p *ent.PromoCodeCreate) {
+				p.SetDiscountType(promotions.DiscountFixed).SetDiscountValue(500).SetCurrency("USD")
+			},
+			err: promotions.ErrCurrencyMismatch,
+		},
+		{
+			name: "other route", code: "SURABAYA",
+			promo: func(p *ent.PromoCodeCreate) { p.SetDiscountValue(10).SetOriginCity("Surabaya") },
+			err:   promotions.ErrRouteRestricted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			passenger := testutil.CreateUser(t, db)
+			if tt.promo != nil {
+				create := db.PromoCode.Create().SetCode(tt.code)
+				tt.promo(create)
+				p := create.SaveX(ctx)
+				for range tt.redeemed {
+					b := testutil.CreateBooking(t, db, r, passenger)
+					db.PromoRedemption.Create().
+						SetPromoCodeID(p.ID).
+						SetUserID(passenger.ID).
+						SetBookingID(b.ID).
+						SetDiscountAmount(1000).
+						ExecX(ctx)
+				}
+			}
+			if tt.credits > 0 {
+				db.Credit.Create().
+					SetUserID(passenger.ID).
+					SetKind(promotions.CreditReferral).
+					SetAmount(tt.credits).
+					ExecX(ctx)
+			}
+
+			q, err := svc.Quote(ctx, db, passenger.ID, r, subtotal, tt.code)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Quote() error = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+
+			if q.Discount != tt.wantDiscount || q.Credits != tt.wantCredits || q.Total != tt.wantTotal {
+				t.Errorf("discount, credits, total = %d, %d, %d; want %d, %d, %d",
+					q.Discount, q.Credits, q.Total, tt.wantDiscount, tt.wantCredits, tt.wantTotal)
+			}
+			if q.PromoCode != tt.wantPromoCode {
+				t.Errorf("promo code = %q, want %q", q.PromoCode, tt.wantPromoCode)
+			}
+		})
+	}
+}
+
+func TestRedeemStaleQuote(t *testing.T) {
+	db, _ := testutil.NewDB(t)
+	svc := newService(db)
+	driver := testutil.CreateUser(t, db)
+	r := testutil.CreateRide(t, db, driver)
+	subtotal := r.PriceAmount
+
+	tests := []struct {
+		name string
+		// setup prepares what passenger can use and returns the code to
+		// quote with
+		setup func(t *testing.T, passenger *ent.User) string
+		want  error
+	}{
+		{
+			name: "per-user limit",
+			setup: func(t *testing.T, passenger *ent.User) string {
+				db.PromoCode.Create().
+					SetCode("ONCE").
+					SetDiscountValue(10).
+					SetMaxUsesPerUser(1).
+					ExecX(t.Context())
+				return "ONCE"
+			},
+			want: promotions.ErrCodeUserLimit,
+		},
+		{
+			name: "credits",
+			setup: func(t *testing.T, passenger *ent.User) string {
+				db.Credit.Create().
+					SetUserID(passenger.ID).
+					SetKind(promotions.CreditReferral).
+					SetAmount(25000).
+					ExecX(t.Context())
+				return ""
+			},
+			want: promotions.ErrCreditsSpent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			passenger := testutil.CreateUser(t, db)
+			code := tt.setup(t, passenger)
+
+			// Both bookings are quoted before either redeems, like two
+			// bookings made at the same time
+			var quotes []*promotions.Quote
+			for range 2 {
+				q, err := svc.Quote(ctx, db, passenger.ID, r, subtotal, code)
+				if err != nil {
+					t.Fatalf("Quote() error = %v", err)
+				}
+				quotes = append(quotes, q)
+			}
+
+			for i, q := range quotes {
+				b := testutil.CreateBooking(t, db, r, passenger)
+				tx, err := db.Tx(ctx)
+				if err != nil {
+					t.Fatalf("failed to start transaction: %v", err)
+				}
+				err = svc.Redeem(ctx, tx.Client(), passenger.ID, b.ID, q)
+				if err != nil {
+					_ = tx.Rollback()
+				} else if err := tx.Commit(); err != nil {
+					t.Fatalf("failed to commit: %v", err)
+				}
+
+				var want error
+				if i > 0 {
+					want = tt.want
+				}
+				if !errors.Is(err, want) {
+					t.Errorf("Redeem() #%d error = %v, want %v", i+1, err, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often idle buckets are dropped from a MemoryStore
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	full   time.Time
+}
+
+// MemoryStore keeps token buckets in process memory. Limits are enforced
+// per instance, so it is only suitable for single-instance deployments.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// NewMemoryStore creates an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*bucket)}
+}
+
+// Take meters one request against the bucket for key
+func (s *MemoryStore) Take(ctx context.Context, key string, policy Policy, now time.Time) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	tokens := float64(policy.Limit)
+	if b, ok := s.buckets[key]; ok {
+		tokens = refill(policy, b.tokens, b.last, now)
+	}
+
+	allowed := tokens >= 1
+	if allowed {
+		tokens--
+	}
+
+	r := result(policy, allowed, tokens, now)
+	s.buckets[key] = &bucket{tokens: tokens, last: now, full: r.Reset}
+	return r, nil
+}
+
+// sweep drops buckets that have refilled completely, as they are
+// indistinguishable from new ones
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, b := range s.buckets {
+		if !now.Before(b.full) {
+			delete(s.buckets, key)
+		}
+	}
+}
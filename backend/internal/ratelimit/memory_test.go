@@ -0,0 +1,121 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/internal/ratelimit"
+)
+
+// step meters one request at an offset from the start of a test
+type step struct {
+	at            time.Duration
+	key           string
+	wantAllowed   bool
+	wantRemaining int
+	// wantRetryAfter is checked for rejected requests
+	wantRetryAfter time.Duration
+	// wantReset, if set, is when the bucket is expected to be full again
+	wantReset time.Duration
+}
+
+func TestMemoryStoreTake(t *testing.T) {
+	// One token every 15 minutes
+	policy := ratelimit.Policy{Name: "test", Limit: 4, Window: time.Hour}
+
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "burst up to the limit",
+			steps: []step{
+				{wantAllowed: true, wantRemaining: 3, wantReset: 15 * time.Minute},
+				{wantAllowed: true, wantRemaining: 2, wantReset: 30 * time.Minute},
+				{wantAllowed: true, wantRemaining: 1},
+				{wantAllowed: true, wantRemaining: 0, wantReset: time.Hour},
+				{wantAllowed: false, wantRemaining: 0, wantRetryAfter: 15 * time.Minute, wantReset: time.Hour},
+			},
+		},
+		{
+			name: "refills one token per interval",
+			steps: []step{
+				{wantAllowed: true, wantRemaining: 3},
+				{wantAllowed: true, wantRemaining: 2},
+				{wantAllowed: true, wantRemaining: 1},
+				{wantAllowed: true, wantRemaining: 0},
+				{at: 15 * time.Minute, wantAllowed: true, wantRemaining: 0},
+				{at: 15 * time.Minute, wantAllowed: false, wantRetryAfter: 15 * time.Minute},
+			},
+		},
+		{
+			name: "partial refill",
+			steps: []step{
+				{wantAllowed: true, wantRemaining: 3},
+				{wantAllowed: true, wantRemaining: 2},
+				{wantAllowed: true, wantRemaining: 1},
+				{wantAllowed: true, wantRemaining: 0},
+				{at: 5 * time.Minute, wantAllowed: false, wantRetryAfter: 10 * time.Minute},
+				{at: 20 * time.Minute, wantAllowed: true, wantRemaining: 0},
+			},
+		},
+		{
+			name: "refill stops at the limit",
+			steps: []step{
+				{wantAllowed: true, wantRemaining: 3},
+				{wantAllowed: true, wantRemaining: 2},
+				{at: 10 * time.Hour, wantAllowed: true, wantRemaining: 3},
+			},
+		},
+		{
+			name: "clock going backwards",
+			steps: []step{
+				{at: time.Hour, wantAllowed: true, wantRemaining: 3},
+				{at: time.Hour, wantAllowed: true, wantRemaining: 2},
+				{at: 0, wantAllowed: true, wantRemaining: 1},
+			},
+		},
+		{
+			name: "keys are independent",
+			steps: []step{
+				{key: "a", wantAllowed: true, wantRemaining: 3},
+				{key: "a", wantAllowed: true, wantRemaining: 2},
+				{key: "b", wantAllowed: true, wantRemaining: 3},
+				{key: "a", wantAllowed: true, wantRemaining: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := ratelimit.NewMemoryStore()
+			start := time.Date(2025, 11, 1, 9, 0, 0, 0, time.UTC)
+
+			for i, s := range tt.steps {
+				now := start.Add(s.at)
+				r, err := store.Take(t.Context(), "user:"+s.key, policy, now)
+				if err != nil {
+					t.Fatalf("step %d: Take() error = %v", i, err)
+				}
+				if r.Allowed != s.wantAllowed || r.Remaining != s.wantRemaining {
+					t.Errorf("step %d: allowed, remaining = %v, %d; want %v, %d", i, r.Allowed, r.Remaining, s.wantAllowed, s.wantRemaining)
+				}
+				if !s.wantAllowed && !approx(r.RetryAfter, s.wantRetryAfter) {
+					t.Errorf("step %d: retry after = %s, want %s", i, r.RetryAfter, s.wantRetryAfter)
+				}
+				if s.wantReset != 0 && !approx(r.Reset.Sub(start), s.wantReset) {
+					t.Errorf("step %d: reset = %s after start, want %s", i, r.Reset.Sub(start), s.wantReset)
+				}
+				if r.Limit != policy.Limit {
+					t.Errorf("step %d: limit = %d, want %d", i, r.Limit, policy.Limit)
+				}
+			}
+		})
+	}
+}
+
+// approx reports whether durations computed with floating point tokens are
+// equal to the millisecond
+func approx(got, want time.Duration) bool {
+	return (got - want).Abs() < time.Millisecond
+}
@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/internal/config"
+)
+
+// Policy limits a client to Limit requests per Window. Requests are metered
+// with a token bucket holding Limit tokens that refills continuously over
+// Window, so short bursts are allowed while the long-run rate stays capped.
+type Policy struct {
+	Name   string
+	Limit  int
+	Window time.Duration
+}
+
+// Result is the outcome of metering one request
+type Result struct {
+	Allowed bool
+	Limit   int
+	// Remaining is the number of requests that can be made right away
+	Remaining int
+	// Reset is when the bucket will be full again
+	Reset time.Time
+	// RetryAfter is how long a rejected client must wait for the next token
+	RetryAfter time.Duration
+}
+
+// Store keeps token buckets and meters requests against them. Take must be
+// atomic so that concurrent requests for the same key never share a token.
+type Store interface {
+	Take(ctx context.Context, key string, policy Policy, now time.Time) (Result, error)
+}
+
+// NewStore creates the rate limit store selected in the configuration
+func NewStore(cfg *config.RateLimitConfig) (Store, error) {
+	switch cfg.Store {
+	case "memory":
+		return NewMemoryStore(), nil
+	case "redis":
+		return NewRedisStore(cfg.RedisAddr, cfg.RedisPassword, cfg.RedisDB), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit store %q", cfg.Store)
+	}
+}
+
+// refill returns the tokens in a bucket that held tokens at last and has
+// been refilling since
+func refill(policy Policy, tokens float64, last, now time.Time) float64 {
+	elapsed := now.Sub(last)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	tokens += float64(elapsed) * float64(policy.Limit) / float64(policy.Window)
+	return math.Min(tokens, float64(policy.Limit))
+}
+
+// rate returns the refill rate in tokens per nanosecond
+func rate(policy Policy) float64 {
+	return float64(policy.Limit) / float64(policy.Window)
+}
+
+// result describes a bucket left with tokens after a request was metered
+func result(policy Policy, allowed bool, tokens float64, now time.Time) Result {
+	r := Result{
+		Allowed:   allowed,
+		Limit:     policy.Limit,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     now.Add(time.Duration((float64(policy.Limit) - tokens) / rate(policy))),
+	}
+	if !allowed {
+		r.RetryAfter = time.Duration((1 - tokens) / rate(policy))
+	}
+	return r
+}
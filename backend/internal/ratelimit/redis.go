@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// takeScript refills and takes from a token bucket stored as a hash of
+// tokens and last refill time in milliseconds. It runs atomically on the
+// server so concurrent instances share one bucket per key.
+var takeScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "last")
+local tokens = tonumber(state[1]) or limit
+local last = tonumber(state[2]) or now
+
+local elapsed = math.max(0, now - last)
+tokens = math.min(limit, tokens + elapsed * limit / window)
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "last", tostring(now))
+redis.call("PEXPIRE", KEYS[1], window)
+return {allowed, tostring(tokens)}
+`)
+
+// RedisStore keeps token buckets in Redis or any server speaking its
+// protocol with Lua scripting support, so limits are shared by all instances
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore creates a RedisStore connected to addr
+func NewRedisStore(addr, password string, db int) *RedisStore {
+	return &RedisStore{
+		client: redis.NewClient(&redis.Options{
+			Addr:     addr,
+			Password: password,
+			DB:       db,
+		}),
+	}
+}
+
+// Take meters one request against the bucket for key
+func (s *RedisStore) Take(ctx context.Context, key string, policy Policy, now time.Time) (Result, error) {
+	reply, err := takeScript.Run(ctx, s.client, []string{key},
+		policy.Limit,
+		policy.Window.Milliseconds(),
+		now.UnixMilli(),
+	).Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to take rate limit token: %w", err)
+	}
+	if len(reply) != 2 {
+		return Result{}, fmt.Errorf("unexpected rate limit script reply: %v", reply)
+	}
+
+	allowed, _ := reply[0].(int64)
+	raw, _ := reply[1].(string)
+	tokens, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("invalid rate limit tokens %q: %w", raw, err)
+	}
+
+	return result(policy, allowed == 1, tokens, now), nil
+}
+
+// Close closes the connection to Redis
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,295 @@
+// Package rides publishes, finds and completes rides independently of the
+// transport they are requested over
+package rides
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/booking"
+	"github.com/slowtyper/poolie/backend/ent/ride"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+	"github.com/slowtyper/poolie/backend/internal/cancellation"
+	"github.com/slowtyper/poolie/backend/internal/currency"
+	"github.com/slowtyper/poolie/backend/internal/metrics"
+	"github.com/slowtyper/poolie/backend/internal/promotions"
+)
+
+// Ride statuses
+const (
+	StatusActive    = "active"
+	StatusCancelled = "cancelled"
+	StatusCompleted = "completed"
+)
+
+// Ride types
+const (
+	TypeOneTime   = "one_time"
+	TypeRecurring = "recurring"
+)
+
+var (
+	// ErrNotFound is returned for rides that do not exist
+	ErrNotFound = errors.New("rides: ride not found")
+	// ErrForbidden is returned when a user acts on a ride they don't drive
+	ErrForbidden = errors.New("rides: not the ride's driver")
+	// ErrCannotComplete is returned when completing a ride that is not
+	// active or has not departed yet
+	ErrCannotComplete = errors.New("rides: ride cannot be completed")
+)
+
+// Place is a ride's origin or destination
+type Place struct {
+	City          string
+	Address       string
+	LocationPoint string
+}
+
+// Recurrence is the schedule of a recurring ride. Dates are YYYY-MM-DD.
+type Recurrence struct {
+	DaysOfWeek []string
+	StartDate  string
+	EndDate    string
+}
+
+// NewRide is a ride to publish. Empty RideType, CancellationPolicy and
+// PriceCurrency get the defaults.
+type NewRide struct {
+	DriverID           string
+	RideType           string
+	Recurrence         *Recurrence
+	DepartureTime      time.Time
+	ArrivalTime        *time.Time
+	Origin             Place
+	Destination        Place
+	PriceAmount        int64
+	PriceCurrency      string
+	Seats              int
+	Amenities          map[string]interface{}
+	Description        string
+	CancellationPolicy string
+}
+
+// SearchParams selects active rides departing on Date, a day starting at
+// midnight UTC. Origin and Destination match city names containing them.
+// An empty Type or "all" matches every type.
+type SearchParams struct {
+	Origin      string
+	Destination string
+	Date        time.Time
+	Type        string
+}
+
+// Service publishes, finds and completes rides. Returned rides are loaded
+// with their driver and vehicle.
+type Service struct {
+	db         *ent.Client
+	promotions *promotions.Service
+}
+
+// NewService creates a new rides Service
+func NewService(db *ent.Client, promotions *promotions.Service) *Service {
+	return &Service{
+		db:         db,
+		promotions: promotions,
+	}
+}
+
+// Search returns the active rides matching p
+func (s *Service) Search(ctx context.Context, p SearchParams) ([]*ent.Ride, error) {
+	query := s.db.Ride.Query().
+		Where(
+			ride.StatusEQ(StatusActive),
+			ride.OriginCityContains(p.Origin),
+			ride.DestinationCityContains(p.Destination),
+			ride.DepartureTimeGTE(p.Date),
+			ride.DepartureTimeLT(p.Date.AddDate(0, 0, 1)),
+		).
+		WithDriver().
+		WithVehicle()
+
+	if p.Type != "" && p.Type != "all" {
+		query = query.Where(ride.TypeEQ(p.Type))
+	}
+
+	rides, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search rides: %w", err)
+	}
+	metrics.SearchResults.Observe(float64(len(rides)))
+	return rides, nil
+}
+
+// Get returns a ride by ID
+func (s *Service) Get(ctx context.Context, id string) (*ent.Ride, error) {
+	r, err := s.db.Ride.Query().
+		Where(ride.IDEQ(id)).
+		WithDriver().
+		WithVehicle().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ride: %w", err)
+	}
+	return r, nil
+}
+
+// Create publishes a ride. The duration is derived from the arrival time
+// when one is given. ent validation errors are returned as is.
+func (s *Service) Create(ctx context.Context, n NewRide) (*ent.Ride, error) {
+	if n.RideType == "" {
+		n.RideType = TypeOneTime
+	}
+	if n.CancellationPolicy == "" {
+		n.CancellationPolicy = cancellation.Default
+	}
+	if n.PriceCurrency == "" {
+		n.PriceCurrency = currency.Default
+	}
+
+	builder := s.db.Ride.Create().
+		SetDriverID(n.DriverID).
+		SetType("carpool").
+		SetRideType(n.RideType).
+		SetDepartureTime(n.DepartureTime).
+		SetOriginCity(n.Origin.City).
+		SetOriginAddress(n.Origin.Address).
+		SetDestinationCity(n.Destination.City).
+		SetDestinationAddress(n.Destination.Address).
+		SetPriceAmount(n.PriceAmount).
+		SetPriceCurrency(n.PriceCurrency).
+		SetAvailableSeats(n.Seats).
+		SetTotalSeats(n.Seats).
+		SetCancellationPolicy(n.CancellationPolicy)
+
+	if n.Origin.LocationPoint != "" {
+		builder = builder.SetOriginLocationPoint(n.Origin.LocationPoint)
+	}
+
+	if n.Destination.LocationPoint != "" {
+		builder = builder.SetDestinationLocationPoint(n.Destination.LocationPoint)
+	}
+
+	if n.ArrivalTime != nil {
+		builder = builder.
+			SetArrivalTime(*n.ArrivalTime).
+			SetDurationMinutes(int(n.ArrivalTime.Sub(n.DepartureTime).Minutes()))
+	}
+
+	if n.Amenities != nil {
+		builder = builder.SetAmenities(n.Amenities)
+	}
+
+	if n.Description != "" {
+		builder = builder.SetDescription(n.Description)
+	}
+
+	if n.Recurrence != nil {
+		builder = builder.SetRecurrence(map[string]interface{}{
+			"days_of_week": n.Recurrence.DaysOfWeek,
+			"start_date":   n.Recurrence.StartDate,
+			"end_date":     n.Recurrence.EndDate,
+		})
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		if ent.IsValidationError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to create ride: %w", err)
+	}
+	metrics.RidesPublished.Inc()
+
+	return s.Get(ctx, created.ID)
+}
+
+// Complete completes a departed ride on behalf of its driver. The ride's
+// confirmed bookings are completed with it, ride counts are updated and the
+// referrers of passengers completing their first ride get their credit.
+func (s *Service) Complete(ctx context.Context, driverID, rideID string) (*ent.Ride, error) {
+	r, err := s.db.Ride.Query().
+		Where(ride.IDEQ(rideID)).
+		WithBookings(func(q *ent.BookingQuery) {
+			q.Where(booking.StatusEQ(bookings.StatusConfirmed))
+		}).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ride: %w", err)
+	}
+
+	if r.DriverID != driverID {
+		return nil, ErrForbidden
+	}
+	if r.Status != StatusActive || r.DepartureTime.After(time.Now()) {
+		return nil, ErrCannotComplete
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	completed, err := s.complete(ctx, tx.Client(), r)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to complete ride: %w", err)
+	}
+	if !completed {
+		_ = tx.Rollback()
+		return nil, ErrCannotComplete
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit ride completion: %w", err)
+	}
+
+	return s.Get(ctx, r.ID)
+}
+
+// complete marks the ride and its confirmed bookings completed, updates
+// ride counts and grants referral credits to the referrers of passengers
+// completing their first ride. r must be loaded with its confirmed bookings.
+// complete reports false without changing anything if the ride's status
+// changed since it was loaded, so a ride completed concurrently is only
+// counted once.
+func (s *Service) complete(ctx context.Context, client *ent.Client, r *ent.Ride) (bool, error) {
+	updated, err := client.Ride.Update().
+		Where(ride.IDEQ(r.ID), ride.StatusEQ(r.Status), ride.StatusNEQ(StatusCompleted)).
+		SetStatus(StatusCompleted).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	if updated == 0 {
+		return false, nil
+	}
+
+	if _, err := client.User.UpdateOneID(r.DriverID).AddCompletedRides(1).Save(ctx); err != nil {
+		return false, err
+	}
+
+	for _, b := range r.Edges.Bookings {
+		if _, err := client.Booking.UpdateOne(b).SetStatus(bookings.StatusCompleted).Save(ctx); err != nil {
+			return false, err
+		}
+
+		if _, err := client.User.UpdateOneID(b.PassengerID).AddCompletedRides(1).Save(ctx); err != nil {
+			return false, err
+		}
+
+		if err := s.promotions.GrantReferralCredit(ctx, client, b.PassengerID); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,157 @@
+package rides_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+	"github.com/slowtyper/poolie/backend/internal/config"
+	"github.com/slowtyper/poolie/backend/internal/promotions"
+	"github.com/slowtyper/poolie/backend/internal/rides"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+	"go.uber.org/zap"
+)
+
+func TestComplete(t *testing.T) {
+	db, _ := testutil.NewDB(t)
+	service := rides.NewService(db, promotions.NewService(db, &config.PromotionsConfig{
+		ReferralCreditAmount:   25000,
+		ReferralCreditCurrency: "IDR",
+	}, zap.NewNop()))
+
+	driver := testutil.CreateUser(t, db)
+	passenger := testutil.CreateUser(t, db)
+	departed := func(r *ent.RideCreate) {
+		r.SetDepartureTime(time.Now().Add(-4 * time.Hour))
+	}
+
+	tests := []struct {
+		name     string
+		driverID string
+		ride     func(*ent.RideCreate)
+		err      error
+	}{
+		{name: "departed ride", driverID: driver.ID, ride: departed},
+		{name: "not departed", driverID: driver.ID, ride: func(*ent.RideCreate) {}, err: rides.ErrCannotComplete},
+		{
+			name: "cancelled ride", driverID: driver.ID, err: rides.ErrCannotComplete,
+			ride: func(r *ent.RideCreate) {
+				departed(r)
+				r.SetStatus(rides.StatusCancelled)
+			},
+		},
+		{name: "not the driver", driverID: passenger.ID, ride: departed, err: rides.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			r := testutil.CreateRide(t, db, driver, tt.ride)
+			confirmed := testutil.CreateBooking(t, db, r, passenger, func(b *ent.BookingCreate) {
+				b.SetStatus(bookings.StatusConfirmed)
+			})
+			cancelled := testutil.CreateBooking(t, db, r, passenger, func(b *ent.BookingCreate) {
+				b.SetStatus(bookings.StatusCancelled)
+			})
+			driverRides := db.User.GetX(ctx, driver.ID).CompletedRides
+
+			completed, err := service.Complete(ctx, tt.driverID, r.ID)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				if status := db.Ride.GetX(ctx, r.ID).Status; status == rides.StatusCompleted {
+					t.Error("ride was completed")
+				}
+				return
+			}
+
+			if completed.Status != rides.StatusCompleted {
+				t.Errorf("ride status = %q, want %q", completed.Status, rides.StatusCompleted)
+			}
+			if completed.Edges.Driver == nil {
+				t.Error("completed ride is not loaded with its driver")
+			}
+			if status := db.Booking.GetX(ctx, confirmed.ID).Status; status != bookings.StatusCompleted {
+				t.Errorf("confirmed booking status = %q, want %q", status, bookings.StatusCompleted)
+			}
+			if status := db.Booking.GetX(ctx, cancelled.ID).Status; status != bookings.StatusCancelled {
+				t.Errorf("cancelled booking status = %q, want %q", status, bookings.StatusCancelled)
+			}
+			if got := db.User.GetX(ctx, driver.ID).CompletedRides; got != driverRides+1 {
+				t.Errorf("driver completed rides = %d, want %d", got, driverRides+1)
+			}
+		})
+	}
+
+	if _, err := service.Complete(t.Context(), driver.ID, "ride_missing"); !errors.Is(err, rides.ErrNotFound) {
+		t.Errorf("err = %v, want %v", err, rides.ErrNotFound)
+	}
+}
+
+func TestCompleteConcurrently(t *testing.T) {
+	db, sqlDB := testutil.NewDB(t)
+	service := rides.NewService(db, promotions.NewService(db, &config.PromotionsConfig{}, zap.NewNop()))
+	ctx := t.Context()
+
+	driver := testutil.CreateUser(t, db)
+	passenger := testutil.CreateUser(t, db)
+	r := testutil.CreateRide(t, db, driver, func(r *ent.RideCreate) {
+		r.SetDepartureTime(time.Now().Add(-4 * time.Hour))
+	})
+	testutil.CreateBooking(t, db, r, passenger, func(b *ent.BookingCreate) {
+		b.SetStatus(bookings.StatusConfirmed)
+	})
+
+	// Both completions load the ride while it is still active. Transactions
+	// then take turns on the only connection, since SQLite has no row locks.
+	sqlDB.SetMaxOpenConns(1)
+	var loads sync.WaitGroup
+	loads.Add(2)
+	var queries atomic.Int64
+	db.Ride.Intercept(ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			v, err := next.Query(ctx, q)
+			if queries.Add(1) <= 2 {
+				loads.Done()
+				loads.Wait()
+			}
+			return v, err
+		})
+	}))
+
+	errs := make(chan error, 2)
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Go(func() {
+			_, err := service.Complete(ctx, driver.ID, r.ID)
+			errs <- err
+		})
+	}
+	wg.Wait()
+	close(errs)
+
+	var completed int
+	for err := range errs {
+		switch {
+		case err == nil:
+			completed++
+		case !errors.Is(err, rides.ErrCannotComplete):
+			t.Errorf("Complete() error = %v, want nil or %v", err, rides.ErrCannotComplete)
+		}
+	}
+	if completed != 1 {
+		t.Errorf("%d completions succeeded, want 1", completed)
+	}
+	if got := db.User.GetX(ctx, driver.ID).CompletedRides; got != 1 {
+		t.Errorf("driver completed rides = %d, want 1", got)
+	}
+	if got := db.User.GetX(ctx, passenger.ID).CompletedRides; got != 1 {
+		t.Errorf("passenger completed rides = %d, want 1", got)
+	}
+}
@@ -0,0 +1,418 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+	"github.com/slowtyper/poolie/backend/internal/openapi"
+	"github.com/slowtyper/poolie/backend/internal/payments"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+)
+
+// contractUser is the user authenticated cases are sent as
+const contractUser = "user_789"
+
+// contractEnv is a test server and the OpenAPI document it serves
+type contractEnv struct {
+	srv  *testutil.Server
+	spec *contractSpec
+}
+
+func newContractEnv(t *testing.T) *contractEnv {
+	t.Helper()
+
+	srv := testutil.NewServer(t)
+	return &contractEnv{srv: srv, spec: loadContractSpec(t, srv)}
+}
+
+// contractSpec validates bodies against the served OpenAPI document
+type contractSpec struct {
+	compiler *jsonschema.Compiler
+	doc      *openapi.Document
+}
+
+func loadContractSpec(t *testing.T, srv *testutil.Server) *contractSpec {
+	t.Helper()
+
+	body := srv.Get(t, "/v1/openapi.json", "").Body
+
+	raw, err := jsonschema.UnmarshalJSON(bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(jsonschema.Draft2020)
+	compiler.AssertFormat()
+	if err := compiler.AddResource("openapi.json", raw); err != nil {
+		t.Fatal(err)
+	}
+
+	var doc openapi.Document
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatal(err)
+	}
+	return &contractSpec{compiler: compiler, doc: &doc}
+}
+
+// operation returns the documented operation of a Fiber route
+func (s *contractSpec) operation(t *testing.T, method, route string) *openapi.Operation {
+	t.Helper()
+	op := s.doc.Paths[openapi.Path(route)][strings.ToLower(method)]
+	if op == nil {
+		t.Fatalf("%s %s is not in the spec", method, route)
+	}
+	return op
+}
+
+// validate checks body against the schema at pointer, a JSON pointer into
+// the document whose segments are escaped here
+func (s *contractSpec) validate(t *testing.T, body []byte, pointer ...string) {
+	t.Helper()
+	for i, segment := range pointer {
+		pointer[i] = strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1")
+	}
+	schema, err := s.compiler.Compile("openapi.json#/" + strings.Join(pointer, "/"))
+	if err != nil {
+		t.Fatalf("failed to compile schema: %v", err)
+	}
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("body is not JSON: %v\n%s", err, body)
+	}
+	if err := schema.Validate(instance); err != nil {
+		t.Errorf("body does not match the spec: %v\n%s", err, body)
+	}
+}
+
+// contractCase is a request to a route and the status it must get
+type contractCase struct {
+	name   string
+	method string
+	route  string // Fiber route template, e.g. /v1/rides/:rideId
+	path   string // request path; route when empty
+	body   any
+	as     string // user ID to authenticate as
+	header map[string]string
+	status int
+}
+
+// do sends the case's request, checking the request body against the spec
+// before sending and the response body after
+func (env *contractEnv) do(t *testing.T, tc contractCase) []byte {
+	t.Helper()
+
+	op := env.spec.operation(t, tc.method, tc.route)
+	path := tc.path
+	if path == "" {
+		path = tc.route
+	}
+	method := strings.ToLower(tc.method)
+	specPath := openapi.Path(tc.route)
+
+	var reqBody []byte
+	if tc.body != nil {
+		var err error
+		if reqBody, err = json.Marshal(tc.body); err != nil {
+			t.Fatal(err)
+		}
+		if op.RequestBody == nil {
+			t.Fatalf("%s %s documents no request body", tc.method, tc.route)
+		}
+		env.spec.validate(t, reqBody, "paths", specPath, method, "requestBody", "content", fiber.MIMEApplicationJSON, "schema")
+	}
+
+	var body any
+	if reqBody != nil {
+		body = json.RawMessage(reqBody)
+	}
+	resp := env.srv.Do(t, testutil.Request{
+		Method: tc.method,
+		Path:   path,
+		Body:   body,
+		As:     tc.as,
+		Header: tc.header,
+	})
+
+	if resp.Status != tc.status {
+		t.Fatalf("status = %d, want %d\n%s", resp.Status, tc.status, resp.Body)
+	}
+
+	status := strconv.Itoa(resp.Status)
+	documented, ok := op.Responses[status]
+	if !ok {
+		if resp.Status < fiber.StatusBadRequest {
+			t.Fatalf("status %d is not documented", resp.Status)
+		}
+		status, documented = "default", op.Responses["default"]
+	}
+	if documented.Content == nil {
+		return resp.Body
+	}
+	env.spec.validate(t, resp.Body, "paths", specPath, method, "responses", status, "content", fiber.MIMEApplicationJSON, "schema")
+	return resp.Body
+}
+
+// seedContractData creates the users, rides and bookings the cases refer to
+func seedContractData(t *testing.T, db *ent.Client) {
+	t.Helper()
+	ctx := t.Context()
+	now := time.Now()
+
+	for _, id := range []string{contractUser, "user_contract_driver", "user_contract_passenger"} {
+		db.User.Create().
+			SetID(id).
+			SetName("Contract " + id).
+			SetEmail(id + "@example.com").
+			SetPasswordHash("").
+			SetAge(30).
+			SetBio("Contract test user").
+			SaveX(ctx)
+	}
+	db.Vehicle.Create().
+		SetID("vehicle_contract").
+		SetUserID("user_contract_driver").
+		SetMake("Toyota").
+		SetModel("Avanza").
+		SetColor("Silver").
+		SetLicensePlate("B 1234 CT").
+		SetYear(2022).
+		SaveX(ctx)
+
+	rides := []struct {
+		id, driverID string
+		departure    time.Time
+	}{
+		// Bookable ride of another driver
+		{"ride_contract_open", "user_contract_driver", now.Add(48 * time.Hour)},
+		// Ride of the signed-in user with a booking waiting for a response
+		{"ride_contract_own", contractUser, now.Add(72 * time.Hour)},
+		// Departed ride of the signed-in user, ready to be completed
+		{"ride_contract_departed", contractUser, now.Add(-3 * time.Hour)},
+	}
+	for _, r := range rides {
+		builder := db.Ride.Create().
+			SetID(r.id).
+			SetDriverID(r.driverID).
+			SetDepartureTime(r.departure).
+			SetArrivalTime(r.departure.Add(3 * time.Hour)).
+			SetDurationMinutes(180).
+			SetOriginCity("Jakarta").
+			SetOriginAddress("Jl. M.H. Thamrin No. 1").
+			SetDestinationCity("Bandung").
+			SetDestinationAddress("Jl. Braga No. 10").
+			SetPriceAmount(120000).
+			SetPriceCurrency("IDR").
+			SetAvailableSeats(3).
+			SetTotalSeats(3).
+			SetAmenities(map[string]interface{}{"smoking_allowed": false})
+		if r.driverID == "user_contract_driver" {
+			builder = builder.SetVehicleID("vehicle_contract")
+		}
+		builder.SaveX(ctx)
+	}
+
+	bookingsToCreate := []struct {
+		id, rideID, passengerID string
+	}{
+		{"booking_contract_pending", "ride_contract_own", "user_contract_passenger"},
+		{"booking_contract_mine", "ride_contract_open", contractUser},
+		{"booking_contract_paid", "ride_contract_open", "user_contract_passenger"},
+	}
+	for _, b := range bookingsToCreate {
+		db.Booking.Create().
+			SetID(b.id).
+			SetRideID(b.rideID).
+			SetPassengerID(b.passengerID).
+			SetStatus(bookings.StatusPending).
+			SetPassengerCount(1).
+			SetSubtotalAmount(120000).
+			SetTotalPriceAmount(120000).
+			SetTotalPriceCurrency("IDR").
+			SaveX(ctx)
+	}
+
+	db.Payment.Create().
+		SetID("payment_contract").
+		SetBookingID("booking_contract_paid").
+		SetProvider("fake").
+		SetProviderIntentID("pi_contract").
+		SetStatus(payments.StatusRequiresCapture).
+		SetAmount(120000).
+		SetCurrency("IDR").
+		SaveX(ctx)
+}
+
+// TestContract drives every documented operation and checks that request
+// and response bodies match the OpenAPI document
+func TestContract(t *testing.T) {
+	env := newContractEnv(t)
+	seedContractData(t, env.srv.DB)
+
+	admin := map[string]string{"X-Admin-Token": testutil.AdminToken}
+	departure := time.Now().Add(48 * time.Hour)
+	searchDate := departure.Format("2006-01-02")
+
+	webhookEvent, err := json.Marshal(payments.WebhookEvent{
+		ID:       "evt_contract",
+		Type:     "payment_intent.created",
+		IntentID: "pi_contract",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []contractCase{
+		{name: "liveness", method: fiber.MethodGet, route: "/livez", status: fiber.StatusOK},
+		// SQLite has no goose version table, so migrations read as unknown
+		{name: "readiness", method: fiber.MethodGet, route: "/readyz", status: fiber.StatusServiceUnavailable},
+		{name: "legacy health", method: fiber.MethodGet, route: "/v1/health", status: fiber.StatusOK},
+		{name: "openapi document", method: fiber.MethodGet, route: "/v1/openapi.json", status: fiber.StatusOK},
+		{name: "api reference", method: fiber.MethodGet, route: "/v1/docs", status: fiber.StatusOK},
+		{
+			name: "search", method: fiber.MethodGet, route: "/v1/rides/search",
+			path:   "/v1/rides/search?origin=Jakarta&destination=Bandung&date=" + searchDate + "&currency=USD",
+			status: fiber.StatusOK,
+		},
+		{
+			name: "search without date", method: fiber.MethodGet, route: "/v1/rides/search",
+			path:   "/v1/rides/search?origin=Jakarta&destination=Bandung",
+			status: fiber.StatusBadRequest,
+		},
+		{
+			name: "ride details", method: fiber.MethodGet, route: "/v1/rides/:rideId",
+			path: "/v1/rides/ride_contract_open?currency=USD", status: fiber.StatusOK,
+		},
+		{
+			name: "unknown ride", method: fiber.MethodGet, route: "/v1/rides/:rideId",
+			path: "/v1/rides/ride_missing", status: fiber.StatusNotFound,
+		},
+		{
+			name: "publish ride", method: fiber.MethodPost, route: "/v1/rides", as: contractUser,
+			body: map[string]any{
+				"origin":          map[string]any{"city": "Jakarta", "address": "Jl. Sudirman No. 5"},
+				"destination":     map[string]any{"city": "Bogor", "address": "Jl. Pajajaran No. 3"},
+				"departure_time":  departure,
+				"arrival_time":    departure.Add(90 * time.Minute),
+				"available_seats": 2,
+				"price_per_seat":  map[string]any{"amount": 50000, "currency": "IDR"},
+			},
+			status: fiber.StatusCreated,
+		},
+		{
+			name: "publish invalid ride", method: fiber.MethodPost, route: "/v1/rides", as: contractUser,
+			body: map[string]any{
+				"origin":          map[string]any{"city": "Jakarta", "address": "Jl. Sudirman No. 5"},
+				"destination":     map[string]any{"city": "Bogor", "address": "Jl. Pajajaran No. 3"},
+				"departure_time":  departure,
+				"arrival_time":    departure.Add(-time.Hour),
+				"available_seats": 0,
+				"price_per_seat":  map[string]any{"amount": 50000, "currency": "IDR"},
+			},
+			status: fiber.StatusBadRequest,
+		},
+		{
+			name: "publish without auth", method: fiber.MethodPost, route: "/v1/rides",
+			body: map[string]any{
+				"origin":          map[string]any{"city": "Jakarta", "address": "Jl. Sudirman No. 5"},
+				"destination":     map[string]any{"city": "Bogor", "address": "Jl. Pajajaran No. 3"},
+				"departure_time":  departure,
+				"available_seats": 2,
+				"price_per_seat":  map[string]any{"amount": 50000, "currency": "IDR"},
+			},
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name: "earnings with invalid token", method: fiber.MethodGet, route: "/v1/me/earnings",
+			header: map[string]string{fiber.HeaderAuthorization: "Bearer not-a-token"},
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name: "complete ride", method: fiber.MethodPost, route: "/v1/rides/:rideId/complete", as: contractUser,
+			path: "/v1/rides/ride_contract_departed/complete", status: fiber.StatusOK,
+		},
+		{
+			name: "book ride", method: fiber.MethodPost, route: "/v1/bookings", as: contractUser,
+			body: map[string]any{
+				"ride_id":          "ride_contract_open",
+				"passenger_count":  1,
+				"message":          "Pick me up at the station please",
+				"display_currency": "USD",
+			},
+			status: fiber.StatusCreated,
+		},
+		{
+			name: "respond to booking", method: fiber.MethodPost, route: "/v1/bookings/:bookingId/respond", as: contractUser,
+			path:   "/v1/bookings/booking_contract_pending/respond",
+			body:   map[string]any{"action": "accept", "message": "See you there"},
+			status: fiber.StatusOK,
+		},
+		{
+			name: "cancel booking", method: fiber.MethodPost, route: "/v1/bookings/:bookingId/cancel", as: contractUser,
+			path:   "/v1/bookings/booking_contract_mine/cancel",
+			body:   map[string]any{"reason": "Plans changed"},
+			status: fiber.StatusOK,
+		},
+		{
+			name: "user profile", method: fiber.MethodGet, route: "/v1/users/:userId/profile",
+			path: "/v1/users/user_contract_driver/profile", status: fiber.StatusOK,
+		},
+		{
+			name: "graphql query", method: fiber.MethodPost, route: "/v1/graphql", as: contractUser,
+			body: map[string]any{
+				"query":     `query($id: ID!) { ride(id: $id) { id driver { name } bookings(first: 5) { totalCount } } }`,
+				"variables": map[string]any{"id": "ride_contract_own"},
+			},
+			status: fiber.StatusOK,
+		},
+		{
+			name: "invalid graphql query", method: fiber.MethodPost, route: "/v1/graphql",
+			body:   map[string]any{"query": "{ ride { missing } }"},
+			status: fiber.StatusUnprocessableEntity,
+		},
+		{
+			name: "earnings", method: fiber.MethodGet, route: "/v1/me/earnings", as: contractUser,
+			path: "/v1/me/earnings?limit=10", status: fiber.StatusOK,
+		},
+		{
+			name: "payment webhook", method: fiber.MethodPost, route: "/v1/payments/webhook",
+			header: map[string]string{payments.SignatureHeader: env.srv.Payments.Sign(webhookEvent)},
+			body:   json.RawMessage(webhookEvent),
+			status: fiber.StatusOK,
+		},
+		{name: "get exchange rates", method: fiber.MethodGet, route: "/v1/admin/exchange-rates", header: admin, status: fiber.StatusOK},
+		{
+			name: "replace exchange rates", method: fiber.MethodPut, route: "/v1/admin/exchange-rates", header: admin,
+			body:   map[string]any{"base": "IDR", "rates": map[string]string{"USD": "0.000063", "SGD": "0.000085"}},
+			status: fiber.StatusOK,
+		},
+		{
+			name: "exchange rates without token", method: fiber.MethodGet, route: "/v1/admin/exchange-rates",
+			status: fiber.StatusForbidden,
+		},
+	}
+
+	exercised := map[string]bool{}
+	for _, tc := range cases {
+		exercised[openapi.Key(tc.method, openapi.Path(tc.route))] = true
+		t.Run(tc.name, func(t *testing.T) {
+			env.do(t, tc)
+		})
+	}
+
+	for path, ops := range env.spec.doc.Paths {
+		for method := range ops {
+			key := openapi.Key(strings.ToUpper(method), path)
+			if !exercised[key] {
+				t.Errorf("%s has no contract case", key)
+			}
+		}
+	}
+}
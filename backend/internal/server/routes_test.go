@@ -0,0 +1,153 @@
+package server_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/internal/middleware"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/ratelimit"
+	"github.com/slowtyper/poolie/backend/internal/server"
+	"github.com/slowtyper/poolie/backend/internal/testutil"
+	"go.uber.org/zap"
+)
+
+// routeLimit is the number of requests each limited route group allows
+const routeLimit = 2
+
+// newLimitedServer starts a test server whose route groups allow routeLimit
+// requests per hour each
+func newLimitedServer(t *testing.T) *testutil.Server {
+	t.Helper()
+
+	store := ratelimit.NewMemoryStore()
+	limit := func(name string) fiber.Handler {
+		return middleware.RateLimit(store, ratelimit.Policy{Name: name, Limit: routeLimit, Window: time.Hour}, zap.NewNop())
+	}
+	return testutil.NewServer(t, func(o *testutil.Options) {
+		o.Limits = server.Limits{
+			Authenticated: limit("authenticated"),
+			Search:        limit("search"),
+			Public:        limit("public"),
+		}
+	})
+}
+
+func TestRouteMiddleware(t *testing.T) {
+	search := "/v1/rides/search?" + url.Values{
+		"origin":      {"Jakarta"},
+		"destination": {"Bandung"},
+		"date":        {time.Now().AddDate(0, 0, 3).Format("2006-01-02")},
+	}.Encode()
+	createRide := models.CreateRideRequest{
+		Origin:         models.Location{City: "Jakarta", Address: "Jl. Sudirman No. 5"},
+		Destination:    models.Location{City: "Bogor", Address: "Jl. Pajajaran No. 3"},
+		DepartureTime:  time.Now().Add(48 * time.Hour),
+		AvailableSeats: 2,
+		PricePerSeat:   models.Price{Amount: 50000, Currency: "IDR"},
+	}
+
+	// Each request is sent until the limit is exceeded; the last response
+	// must have status
+	tests := []struct {
+		name    string
+		request func(driver *ent.User, r *ent.Ride) testutil.Request
+		status  int
+	}{
+		{
+			name: "get ride",
+			request: func(_ *ent.User, r *ent.Ride) testutil.Request {
+				return testutil.Request{Method: fiber.MethodGet, Path: "/v1/rides/" + r.ID}
+			},
+			status: fiber.StatusTooManyRequests,
+		},
+		{
+			name: "get profile",
+			request: func(driver *ent.User, _ *ent.Ride) testutil.Request {
+				return testutil.Request{Method: fiber.MethodGet, Path: "/v1/users/" + driver.ID + "/profile"}
+			},
+			status: fiber.StatusTooManyRequests,
+		},
+		{
+			name: "search",
+			request: func(driver *ent.User, _ *ent.Ride) testutil.Request {
+				return testutil.Request{Method: fiber.MethodGet, Path: search, As: driver.ID}
+			},
+			status: fiber.StatusTooManyRequests,
+		},
+		{
+			name: "create ride",
+			request: func(driver *ent.User, _ *ent.Ride) testutil.Request {
+				return testutil.Request{Method: fiber.MethodPost, Path: "/v1/rides", As: driver.ID, Body: createRide}
+			},
+			status: fiber.StatusTooManyRequests,
+		},
+		{
+			// Auth runs before the limiter and the handler
+			name: "create ride unauthenticated",
+			request: func(*ent.User, *ent.Ride) testutil.Request {
+				return testutil.Request{Method: fiber.MethodPost, Path: "/v1/rides", Body: createRide}
+			},
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name: "complete ride unauthenticated",
+			request: func(_ *ent.User, r *ent.Ride) testutil.Request {
+				return testutil.Request{Method: fiber.MethodPost, Path: "/v1/rides/" + r.ID + "/complete"}
+			},
+			status: fiber.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newLimitedServer(t)
+			driver := testutil.CreateUser(t, srv.DB)
+			r := testutil.CreateRide(t, srv.DB, driver)
+			rides := srv.DB.Ride.Query().CountX(t.Context())
+
+			var resp *testutil.Response
+			for range routeLimit + 1 {
+				resp = srv.Do(t, tt.request(driver, r))
+			}
+			if resp.Status != tt.status {
+				t.Fatalf("status = %d, want %d\n%s", resp.Status, tt.status, resp.Body)
+			}
+			if tt.status == fiber.StatusUnauthorized {
+				if n := srv.DB.Ride.Query().CountX(t.Context()); n != rides {
+					t.Errorf("rides = %d, want %d", n, rides)
+				}
+				if got := srv.DB.Ride.GetX(t.Context(), r.ID).Status; got != r.Status {
+					t.Errorf("ride status = %q, want %q", got, r.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchLimitedPerUser(t *testing.T) {
+	srv := newLimitedServer(t)
+	path := "/v1/rides/search?" + url.Values{
+		"origin":      {"Jakarta"},
+		"destination": {"Bandung"},
+		"date":        {time.Now().AddDate(0, 0, 3).Format("2006-01-02")},
+	}.Encode()
+	first := testutil.CreateUser(t, srv.DB)
+	second := testutil.CreateUser(t, srv.DB)
+
+	for range routeLimit {
+		if resp := srv.Get(t, path, first.ID); resp.Status != fiber.StatusOK {
+			t.Fatalf("status = %d, want %d\n%s", resp.Status, fiber.StatusOK, resp.Body)
+		}
+	}
+	if resp := srv.Get(t, path, first.ID); resp.Status != fiber.StatusTooManyRequests {
+		t.Fatalf("status = %d, want %d", resp.Status, fiber.StatusTooManyRequests)
+	}
+	// Both users share the test client's IP
+	if resp := srv.Get(t, path, second.ID); resp.Status != fiber.StatusOK {
+		t.Errorf("other user: status = %d, want %d\n%s", resp.Status, fiber.StatusOK, resp.Body)
+	}
+}
@@ -0,0 +1,139 @@
+// Package server assembles the Fiber app: global middleware, routes and
+// their OpenAPI documentation
+package server
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/cors"
+	"github.com/gofiber/fiber/v3/middleware/recover"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/auth"
+	"github.com/slowtyper/poolie/backend/internal/config"
+	"github.com/slowtyper/poolie/backend/internal/handlers"
+	"github.com/slowtyper/poolie/backend/internal/idempotency"
+	"github.com/slowtyper/poolie/backend/internal/middleware"
+	"github.com/slowtyper/poolie/backend/internal/openapi"
+	"go.uber.org/zap"
+)
+
+// Handlers are the HTTP handlers served by the API
+type Handlers struct {
+	Rides    *handlers.RideHandler
+	Bookings *handlers.BookingHandler
+	Users    *handlers.UserHandler
+	Payments *handlers.PaymentHandler
+	Earnings *handlers.EarningsHandler
+	Currency *handlers.CurrencyHandler
+	Health   *handlers.HealthHandler
+	GraphQL  *handlers.GraphQLHandler
+}
+
+// Limits are the rate limiters of each route group. A nil limiter lets
+// every request through.
+type Limits struct {
+	Authenticated fiber.Handler
+	Search        fiber.Handler
+	Public        fiber.Handler
+}
+
+// Auth holds what routes need to authenticate their callers
+type Auth struct {
+	Tokens     *auth.Tokens
+	AdminToken string
+}
+
+// New creates the Fiber app with the global middleware installed
+func New(cfg *config.ServerConfig, log *zap.Logger) *fiber.App {
+	app := fiber.New(fiber.Config{
+		ErrorHandler: apperr.Handler(),
+		ReadTimeout:  time.Duration(cfg.ReadTimeout) * time.Second,
+		WriteTimeout: time.Duration(cfg.WriteTimeout) * time.Second,
+		AppName:      "Poolie API v1.0.0",
+	})
+
+	app.Use(recover.New())
+	app.Use(cors.New(cors.Config{
+		AllowOrigins:     []string{"*"},
+		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", middleware.RequestIDHeader, middleware.IdempotencyKeyHeader, "traceparent", "tracestate"},
+		ExposeHeaders:    []string{"X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset", "Retry-After", middleware.RequestIDHeader, middleware.IdempotentReplayedHeader},
+		AllowCredentials: false,
+	}))
+	app.Use(middleware.RequestID(log))
+	app.Use(middleware.Tracing())
+	app.Use(middleware.Logger())
+	app.Use(middleware.Metrics())
+	app.Use(middleware.RequestContext(time.Duration(cfg.RequestTimeout) * time.Second))
+
+	return app
+}
+
+// Routes registers the API's routes. Every route must also be described in
+// Spec. POSTs creating rides and bookings accept an Idempotency-Key header
+// when keys is not nil.
+func Routes(app *fiber.App, h Handlers, limits Limits, a Auth, keys *idempotency.Service) {
+	authenticatedLimit := orPass(limits.Authenticated)
+	searchLimit := orPass(limits.Search)
+	publicLimit := orPass(limits.Public)
+	idempotent := orPass(nil)
+	if keys != nil {
+		idempotent = middleware.Idempotency(keys)
+	}
+
+	// Probes for orchestrators and load balancers (no auth required)
+	app.Get("/livez", h.Health.Livez)
+	app.Get("/readyz", h.Health.Readyz)
+
+	// API routes
+	api := app.Group("/v1")
+
+	// Health check endpoint, kept for existing clients
+	api.Get("/health", h.Health.Livez)
+
+	// API documentation
+	api.Get("/openapi.json", openapi.Handler(Spec))
+	api.Get("/docs", openapi.UI("/v1/openapi.json"))
+
+	// Rides endpoints
+	rides := api.Group("/rides")
+	// Fiber v3 takes the handler first and runs route middleware before it
+	rides.Get("/search", h.Rides.SearchRides, middleware.OptionalAuth(a.Tokens), searchLimit)
+	rides.Get("/:rideId", h.Rides.GetRide, publicLimit)
+	rides.Post("", h.Rides.CreateRide, middleware.AuthMiddleware(a.Tokens), authenticatedLimit, idempotent)
+	rides.Post("/:rideId/complete", h.Rides.CompleteRide, middleware.AuthMiddleware(a.Tokens), authenticatedLimit)
+
+	// Bookings endpoints
+	bookings := api.Group("/bookings", middleware.AuthMiddleware(a.Tokens), authenticatedLimit)
+	bookings.Post("", h.Bookings.CreateBooking, idempotent)
+	bookings.Post("/:bookingId/respond", h.Bookings.RespondToBooking)
+	bookings.Post("/:bookingId/cancel", h.Bookings.CancelBooking)
+
+	// Users endpoints
+	users := api.Group("/users")
+	users.Get("/:userId/profile", h.Users.GetUserProfile, publicLimit)
+
+	// GraphQL endpoint, authorizing fields like the REST endpoints
+	api.Post("/graphql", h.GraphQL.Serve, middleware.OptionalAuth(a.Tokens), publicLimit)
+
+	// Current user endpoints
+	me := api.Group("/me", middleware.AuthMiddleware(a.Tokens), authenticatedLimit)
+	me.Get("/earnings", h.Earnings.GetEarnings)
+
+	// Payments endpoints (authenticated by webhook signature)
+	payments := api.Group("/payments")
+	payments.Post("/webhook", h.Payments.HandleWebhook)
+
+	// Admin endpoints (authenticated by admin token)
+	admin := api.Group("/admin", middleware.AdminAuth(a.AdminToken))
+	admin.Get("/exchange-rates", h.Currency.GetRates)
+	admin.Put("/exchange-rates", h.Currency.UpdateRates)
+}
+
+func orPass(limit fiber.Handler) fiber.Handler {
+	if limit == nil {
+		return func(c fiber.Ctx) error { return c.Next() }
+	}
+	return limit
+}
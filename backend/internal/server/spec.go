@@ -0,0 +1,173 @@
+package server
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/currency"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/openapi"
+	"github.com/slowtyper/poolie/backend/internal/payments"
+)
+
+// displayCurrencyQuery is the query of routes that can show prices in
+// another currency
+type displayCurrencyQuery struct {
+	Currency string `query:"currency"`
+}
+
+// idempotencyHeader is the header of routes that are safe to retry
+type idempotencyHeader struct {
+	IdempotencyKey string `header:"Idempotency-Key"`
+}
+
+// idempotencyDescription explains the Idempotency-Key header
+const idempotencyDescription = "Retries sent with the same Idempotency-Key get the stored response of the first request " +
+	"with an Idempotent-Replayed header until the key expires, after 24 hours by default. " +
+	"Reusing a key for a different request fails with 409."
+
+// earningsQuery is the query of GET /v1/me/earnings
+type earningsQuery struct {
+	Limit  int    `query:"limit"`
+	Before string `query:"before"`
+}
+
+// Spec documents every route registered by Routes and is served at
+// /v1/openapi.json
+var Spec = &openapi.Spec{
+	Info: openapi.Info{
+		Title:   "Poolie API",
+		Version: "1.0.0",
+		Description: "Ride sharing API for publishing, searching and booking carpool rides. " +
+			"Prices are in minor currency units.",
+	},
+	Error: models.ErrorResponse{},
+	Routes: []openapi.Route{
+		{
+			Method: fiber.MethodGet, Path: "/livez", Tag: "health",
+			Summary:   "Liveness probe",
+			Responses: map[int]any{fiber.StatusOK: models.LivenessResponse{}},
+		},
+		{
+			Method: fiber.MethodGet, Path: "/readyz", Tag: "health",
+			Summary:     "Readiness probe",
+			Description: "Fails while the database is unreachable or migrations are pending, and during shutdown.",
+			Responses: map[int]any{
+				fiber.StatusOK:                 models.ReadinessResponse{},
+				fiber.StatusServiceUnavailable: models.ReadinessResponse{},
+			},
+		},
+		{
+			Method: fiber.MethodGet, Path: "/v1/health", Tag: "health",
+			Summary:   "Liveness probe, kept for existing clients",
+			Responses: map[int]any{fiber.StatusOK: models.LivenessResponse{}},
+		},
+		{
+			Method: fiber.MethodGet, Path: "/v1/openapi.json", Tag: "docs",
+			Summary:   "This OpenAPI document",
+			Responses: map[int]any{fiber.StatusOK: map[string]any{}},
+		},
+		{
+			Method: fiber.MethodGet, Path: "/v1/docs", Tag: "docs",
+			Summary:   "API reference rendering this document",
+			Responses: map[int]any{fiber.StatusOK: nil},
+		},
+		{
+			Method: fiber.MethodGet, Path: "/v1/rides/search", Tag: "rides",
+			Summary:   "Search rides departing on a date",
+			Security:  openapi.OptionalBearer,
+			Query:     models.SearchRidesRequest{},
+			Responses: map[int]any{fiber.StatusOK: models.SearchRidesResponse{}},
+		},
+		{
+			Method: fiber.MethodGet, Path: "/v1/rides/:rideId", Tag: "rides",
+			Summary:   "Get ride details",
+			Query:     displayCurrencyQuery{},
+			Responses: map[int]any{fiber.StatusOK: models.RideDetail{}},
+		},
+		{
+			Method: fiber.MethodPost, Path: "/v1/rides", Tag: "rides",
+			Summary:     "Publish a ride",
+			Description: idempotencyDescription,
+			Security:    openapi.Bearer,
+			Header:      idempotencyHeader{},
+			Body:        models.CreateRideRequest{},
+			Responses:   map[int]any{fiber.StatusCreated: models.RideDetail{}},
+		},
+		{
+			Method: fiber.MethodPost, Path: "/v1/rides/:rideId/complete", Tag: "rides",
+			Summary:     "Complete a departed ride",
+			Description: "Confirmed bookings become completed.",
+			Security:    openapi.Bearer,
+			Responses:   map[int]any{fiber.StatusOK: models.RideDetail{}},
+		},
+		{
+			Method: fiber.MethodPost, Path: "/v1/bookings", Tag: "bookings",
+			Summary:     "Book seats on a ride",
+			Description: idempotencyDescription,
+			Security:    openapi.Bearer,
+			Header:      idempotencyHeader{},
+			Body:        models.CreateBookingRequest{},
+			Responses:   map[int]any{fiber.StatusCreated: models.BookingResponse{}},
+		},
+		{
+			Method: fiber.MethodPost, Path: "/v1/bookings/:bookingId/respond", Tag: "bookings",
+			Summary:   "Accept or reject a booking as the driver",
+			Security:  openapi.Bearer,
+			Body:      models.RespondToBookingRequest{},
+			Responses: map[int]any{fiber.StatusOK: models.BookingResponse{}},
+		},
+		{
+			Method: fiber.MethodPost, Path: "/v1/bookings/:bookingId/cancel", Tag: "bookings",
+			Summary:      "Cancel a booking as the passenger or driver",
+			Security:     openapi.Bearer,
+			Body:         models.CancelBookingRequest{},
+			OptionalBody: true,
+			Responses:    map[int]any{fiber.StatusOK: models.BookingResponse{}},
+		},
+		{
+			Method: fiber.MethodGet, Path: "/v1/users/:userId/profile", Tag: "users",
+			Summary:   "Get a user's public profile",
+			Responses: map[int]any{fiber.StatusOK: models.UserProfile{}},
+		},
+		{
+			Method: fiber.MethodPost, Path: "/v1/graphql", Tag: "graphql",
+			Summary: "Run a GraphQL query",
+			Description: "Rides, bookings and users with Relay-style connections. " +
+				"Errors are reported alongside the data with a 200, " +
+				"except for malformed or invalid operations which are rejected with a 4xx.",
+			Security: openapi.OptionalBearer,
+			Body:     models.GraphQLRequest{},
+			Responses: map[int]any{
+				fiber.StatusOK:                  models.GraphQLResponse{},
+				fiber.StatusBadRequest:          models.GraphQLResponse{},
+				fiber.StatusUnprocessableEntity: models.GraphQLResponse{},
+			},
+		},
+		{
+			Method: fiber.MethodGet, Path: "/v1/me/earnings", Tag: "users",
+			Summary:   "Get the caller's driver earnings",
+			Security:  openapi.Bearer,
+			Query:     earningsQuery{},
+			Responses: map[int]any{fiber.StatusOK: models.EarningsStatement{}},
+		},
+		{
+			Method: fiber.MethodPost, Path: "/v1/payments/webhook", Tag: "payments",
+			Summary:   "Receive a payment provider event",
+			Security:  openapi.Webhook,
+			Body:      payments.WebhookEvent{},
+			Responses: map[int]any{fiber.StatusOK: models.WebhookResponse{}},
+		},
+		{
+			Method: fiber.MethodGet, Path: "/v1/admin/exchange-rates", Tag: "admin",
+			Summary:   "Get exchange rates",
+			Security:  openapi.Admin,
+			Responses: map[int]any{fiber.StatusOK: currency.RateTable{}},
+		},
+		{
+			Method: fiber.MethodPut, Path: "/v1/admin/exchange-rates", Tag: "admin",
+			Summary:   "Replace exchange rates",
+			Security:  openapi.Admin,
+			Body:      currency.RateTable{},
+			Responses: map[int]any{fiber.StatusOK: currency.RateTable{}},
+		},
+	},
+}
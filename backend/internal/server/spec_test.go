@@ -0,0 +1,69 @@
+package server_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/openapi"
+	"github.com/slowtyper/poolie/backend/internal/server"
+)
+
+// newRoutedApp registers the real routes. Handlers are never called, so
+// they can be nil.
+func newRoutedApp() *fiber.App {
+	app := fiber.New()
+	server.Routes(app, server.Handlers{}, server.Limits{}, server.Auth{}, nil)
+	return app
+}
+
+func TestSpecCoversRoutes(t *testing.T) {
+	registered := newRoutedApp().GetRoutes(true)
+
+	for _, route := range server.Spec.Undocumented(registered) {
+		t.Errorf("route %s has no entry in Spec", route)
+	}
+	for _, route := range server.Spec.Unregistered(registered) {
+		t.Errorf("Spec documents %s, which is not registered", route)
+	}
+}
+
+func TestSpecIsServed(t *testing.T) {
+	resp, err := newRoutedApp().Test(httptest.NewRequest(fiber.MethodGet, "/v1/openapi.json", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, fiber.StatusOK)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc openapi.Document
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("invalid document: %v", err)
+	}
+	if doc.OpenAPI != openapi.Version {
+		t.Errorf("openapi = %q, want %q", doc.OpenAPI, openapi.Version)
+	}
+
+	search := doc.Paths["/v1/rides/search"]["get"]
+	if search == nil {
+		t.Fatal("GET /v1/rides/search is missing")
+	}
+	if len(search.Parameters) == 0 {
+		t.Error("GET /v1/rides/search has no query parameters")
+	}
+	if doc.Paths["/v1/rides/{rideId}"]["get"] == nil {
+		t.Error("path parameters are not converted to OpenAPI syntax")
+	}
+	for _, name := range []string{"RideDetail", "BookingResponse", "ErrorResponse"} {
+		if doc.Components.Schemas[name] == nil {
+			t.Errorf("schema %s is missing", name)
+		}
+	}
+}
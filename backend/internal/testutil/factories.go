@@ -0,0 +1,95 @@
+package testutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+	"github.com/slowtyper/poolie/backend/internal/ids"
+)
+
+// Factories create rows with valid defaults. Options run after the defaults
+// are set, so they can override any field:
+//
+//	testutil.CreateRide(t, db, driver, func(r *ent.RideCreate) {
+//		r.SetAvailableSeats(1)
+//	})
+
+// CreateUser creates a user
+func CreateUser(t testing.TB, db *ent.Client, opts ...func(*ent.UserCreate)) *ent.User {
+	t.Helper()
+
+	id := ids.New(ids.User)
+	create := db.User.Create().
+		SetID(id).
+		SetName("Test User " + id).
+		SetEmail(id + "@example.com").
+		SetPasswordHash("").
+		SetAge(30)
+	for _, opt := range opts {
+		opt(create)
+	}
+	return create.SaveX(t.Context())
+}
+
+// CreateVehicle creates a vehicle owned by owner
+func CreateVehicle(t testing.TB, db *ent.Client, owner *ent.User, opts ...func(*ent.VehicleCreate)) *ent.Vehicle {
+	t.Helper()
+
+	create := db.Vehicle.Create().
+		SetUserID(owner.ID).
+		SetMake("Toyota").
+		SetModel("Avanza").
+		SetColor("Silver").
+		SetLicensePlate("B 1234 TST").
+		SetYear(2022)
+	for _, opt := range opts {
+		opt(create)
+	}
+	return create.SaveX(t.Context())
+}
+
+// CreateRide creates an active Jakarta to Bandung ride by driver with 3 of 3
+// seats free, departing in two days at 120,000 IDR per seat
+func CreateRide(t testing.TB, db *ent.Client, driver *ent.User, opts ...func(*ent.RideCreate)) *ent.Ride {
+	t.Helper()
+
+	departure := time.Now().Add(48 * time.Hour).Truncate(time.Minute)
+	create := db.Ride.Create().
+		SetDriverID(driver.ID).
+		SetDepartureTime(departure).
+		SetArrivalTime(departure.Add(3 * time.Hour)).
+		SetDurationMinutes(180).
+		SetOriginCity("Jakarta").
+		SetOriginAddress("Jl. M.H. Thamrin No. 1").
+		SetDestinationCity("Bandung").
+		SetDestinationAddress("Jl. Braga No. 10").
+		SetPriceAmount(120000).
+		SetPriceCurrency("IDR").
+		SetAvailableSeats(3).
+		SetTotalSeats(3)
+	for _, opt := range opts {
+		opt(create)
+	}
+	return create.SaveX(t.Context())
+}
+
+// CreateBooking creates a pending booking of one seat on r by passenger,
+// priced at the ride's fare. No payment is authorized for it.
+func CreateBooking(t testing.TB, db *ent.Client, r *ent.Ride, passenger *ent.User, opts ...func(*ent.BookingCreate)) *ent.Booking {
+	t.Helper()
+
+	create := db.Booking.Create().
+		SetRideID(r.ID).
+		SetPassengerID(passenger.ID).
+		SetStatus(bookings.StatusPending).
+		SetPassengerCount(1).
+		SetSubtotalAmount(r.PriceAmount).
+		SetTotalPriceAmount(r.PriceAmount).
+		SetTotalPriceCurrency(r.PriceCurrency)
+	for _, opt := range opts {
+		opt(create)
+	}
+	return create.SaveX(t.Context())
+}
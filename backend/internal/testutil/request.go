@@ -0,0 +1,117 @@
+package testutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// Request is a request to a test server
+type Request struct {
+	Method string
+	Path   string
+	// Body is sent as JSON unless nil. A []byte or json.RawMessage is sent
+	// as is.
+	Body any
+	// As authenticates the request with a bearer token for this user ID
+	As     string
+	Header map[string]string
+}
+
+// Response is a test server's response
+type Response struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// Decode unmarshals the response body into v
+func (r *Response) Decode(t testing.TB, v any) {
+	t.Helper()
+	if err := json.Unmarshal(r.Body, v); err != nil {
+		t.Fatalf("failed to decode response: %v\n%s", err, r.Body)
+	}
+}
+
+// ErrorCode returns the code of an error response, or "" for other bodies
+func (r *Response) ErrorCode() string {
+	var body struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(r.Body, &body); err != nil {
+		return ""
+	}
+	return body.Error.Code
+}
+
+// Token returns a bearer token authenticating userID
+func (s *Server) Token(t testing.TB, userID string) string {
+	t.Helper()
+	token, err := s.Tokens.Issue(userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// Do sends req and reads the whole response
+func (s *Server) Do(t testing.TB, req Request) *Response {
+	t.Helper()
+
+	var body []byte
+	switch b := req.Body.(type) {
+	case nil:
+	case []byte:
+		body = b
+	case json.RawMessage:
+		body = b
+	default:
+		var err error
+		if body, err = json.Marshal(b); err != nil {
+			t.Fatalf("failed to encode request body: %v", err)
+		}
+	}
+
+	httpReq := httptest.NewRequest(req.Method, req.Path, bytes.NewReader(body))
+	if req.Body != nil {
+		httpReq.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+	if req.As != "" {
+		httpReq.Header.Set(fiber.HeaderAuthorization, "Bearer "+s.Token(t, req.As))
+	}
+	for k, v := range req.Header {
+		httpReq.Header.Set(k, v)
+	}
+
+	resp, err := s.App.Test(httpReq, 10*time.Second)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", req.Method, req.Path, err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read response: %v", err)
+	}
+	return &Response{Status: resp.StatusCode, Header: resp.Header, Body: respBody}
+}
+
+// Get sends a GET request as userID, or anonymously when userID is empty
+func (s *Server) Get(t testing.TB, path, userID string) *Response {
+	t.Helper()
+	return s.Do(t, Request{Method: fiber.MethodGet, Path: path, As: userID})
+}
+
+// Post sends a POST request with a JSON body as userID, or anonymously when
+// userID is empty
+func (s *Server) Post(t testing.TB, path, userID string, body any) *Response {
+	t.Helper()
+	return s.Do(t, Request{Method: fiber.MethodPost, Path: path, Body: body, As: userID})
+}
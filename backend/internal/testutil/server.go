@@ -0,0 +1,142 @@
+// Package testutil runs the API with its real routes, services and
+// middleware against an in-memory SQLite database, for handler tests.
+// SQLite needs cgo.
+package testutil
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/slowtyper/poolie/backend/ent"
+	"github.com/slowtyper/poolie/backend/ent/enttest"
+	"github.com/slowtyper/poolie/backend/internal/auth"
+	"github.com/slowtyper/poolie/backend/internal/bookings"
+	"github.com/slowtyper/poolie/backend/internal/config"
+	"github.com/slowtyper/poolie/backend/internal/currency"
+	"github.com/slowtyper/poolie/backend/internal/graph"
+	"github.com/slowtyper/poolie/backend/internal/handlers"
+	"github.com/slowtyper/poolie/backend/internal/idempotency"
+	"github.com/slowtyper/poolie/backend/internal/ledger"
+	"github.com/slowtyper/poolie/backend/internal/payments"
+	"github.com/slowtyper/poolie/backend/internal/promotions"
+	"github.com/slowtyper/poolie/backend/internal/rides"
+	"github.com/slowtyper/poolie/backend/internal/server"
+	"github.com/slowtyper/poolie/backend/internal/users"
+	"go.uber.org/zap"
+)
+
+const (
+	// AdminToken is accepted by the admin endpoints of a test server
+	AdminToken = "test-admin-token"
+	// WebhookSecret signs payment webhooks sent to a test server
+	WebhookSecret = "test-webhook-secret"
+	// USDRate is the amount of USD worth one IDR on a test server
+	USDRate = "0.000064"
+	// GraphQLMaxPageSize is the largest connection page of a test server
+	GraphQLMaxPageSize = 50
+	// GraphQLComplexityLimit is the most complex query a test server runs
+	GraphQLComplexityLimit = 1000
+)
+
+// Server is the API wired like in production. Rates are quoted against IDR
+// and the payment provider is the fake one. The services behind the routes
+// are exposed for serving them over other transports.
+type Server struct {
+	App      *fiber.App
+	DB       *ent.Client
+	SQLDB    *sql.DB
+	Tokens   *auth.Tokens
+	Payments *payments.FakeProvider
+	Rates    *currency.Rates
+	Rides    *rides.Service
+	Bookings *bookings.Service
+	Users    *users.Service
+}
+
+// NewDB opens an empty in-memory SQLite database with the ent schema
+// created. It is closed when the test ends.
+func NewDB(t testing.TB) (*ent.Client, *sql.DB) {
+	t.Helper()
+
+	// Each database gets its own name so parallel tests don't share tables
+	dsn := "file:" + uuid.New().String() + "?mode=memory&cache=shared&_fk=1"
+	sqlDB, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialect.SQLite, sqlDB))))
+	t.Cleanup(func() { client.Close() })
+	return client, sqlDB
+}
+
+// Options change how a test server is wired
+type Options struct {
+	// Limits rate limit the routes. By default nothing is limited.
+	Limits server.Limits
+}
+
+// NewServer starts a server on a fresh database
+func NewServer(t testing.TB, opts ...func(*Options)) *Server {
+	t.Helper()
+
+	var o Options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	client, sqlDB := NewDB(t)
+	log := zap.NewNop()
+
+	rates := currency.NewRates()
+	if err := rates.Set(currency.RateTable{Base: "IDR", Rates: map[string]string{"USD": USDRate}}); err != nil {
+		t.Fatalf("failed to set exchange rates: %v", err)
+	}
+
+	bookLedger := ledger.New(client, 1000, log)
+	provider := payments.NewFakeProvider(WebhookSecret)
+	paymentService := payments.NewService(client, provider, bookLedger, log)
+	promotionService := promotions.NewService(client, &config.PromotionsConfig{
+		ReferralCreditAmount:   25000,
+		ReferralCreditCurrency: "IDR",
+	}, log)
+	bookingService := bookings.NewService(client, paymentService, bookLedger, promotionService, rates, log)
+	rideService := rides.NewService(client, promotionService)
+	userService := users.NewService(client)
+	tokens := auth.NewTokens(&config.JWTConfig{Secret: "test-jwt-secret", Expiration: 3600})
+
+	app := server.New(&config.ServerConfig{RequestTimeout: 10}, log)
+	server.Routes(app, server.Handlers{
+		Rides:    handlers.NewRideHandler(rideService, rates),
+		Bookings: handlers.NewBookingHandler(bookingService),
+		Users:    handlers.NewUserHandler(userService),
+		Payments: handlers.NewPaymentHandler(paymentService),
+		Earnings: handlers.NewEarningsHandler(bookLedger),
+		Currency: handlers.NewCurrencyHandler(rates, currency.NewStore(client, rates, log)),
+		Health:   handlers.NewHealthHandler(sqlDB, 1, time.Second),
+		GraphQL: handlers.NewGraphQLHandler(graph.NewServer(client, &config.GraphQLConfig{
+			ComplexityLimit: GraphQLComplexityLimit,
+			MaxPageSize:     GraphQLMaxPageSize,
+		})),
+	}, o.Limits, server.Auth{
+		Tokens:     tokens,
+		AdminToken: AdminToken,
+	}, idempotency.NewService(client, &config.IdempotencyConfig{KeyTTL: 86400}, log))
+
+	return &Server{
+		App:      app,
+		DB:       client,
+		SQLDB:    sqlDB,
+		Tokens:   tokens,
+		Payments: provider,
+		Rates:    rates,
+		Rides:    rideService,
+		Bookings: bookingService,
+		Users:    userService,
+	}
+}
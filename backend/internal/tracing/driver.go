@@ -0,0 +1,149 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Driver wraps an ent driver so every query and transaction runs in a
+// client span that is a child of the span carried by the query's context
+type Driver struct {
+	dialect.Driver
+	tracer trace.Tracer
+}
+
+// WrapDriver returns drv instrumented with tracing
+func WrapDriver(drv dialect.Driver) *Driver {
+	return &Driver{Driver: drv, tracer: Tracer()}
+}
+
+// Exec executes a statement in a span
+func (d *Driver) Exec(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, d.tracer, query, func(ctx context.Context) error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+// Query executes a query in a span
+func (d *Driver) Query(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, d.tracer, query, func(ctx context.Context) error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+// Tx starts a traced transaction
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+// BeginTx starts a traced transaction with options. The span covers the
+// transaction until it is committed or rolled back.
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	ctx, span := d.tracer.Start(ctx, "transaction",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemNamePostgreSQL),
+	)
+
+	var (
+		tx  dialect.Tx
+		err error
+	)
+	if b, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}); ok {
+		tx, err = b.BeginTx(ctx, opts)
+	} else {
+		tx, err = d.Driver.Tx(ctx)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
+		return nil, err
+	}
+
+	return &Tx{Tx: tx, tracer: d.tracer, span: span}, nil
+}
+
+// Tx is a transaction whose statements are traced as children of the
+// transaction span
+type Tx struct {
+	dialect.Tx
+	tracer trace.Tracer
+	span   trace.Span
+}
+
+// Exec executes a statement in a span
+func (t *Tx) Exec(ctx context.Context, query string, args, v any) error {
+	return traceQuery(t.context(ctx), t.tracer, query, func(ctx context.Context) error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+// Query executes a query in a span
+func (t *Tx) Query(ctx context.Context, query string, args, v any) error {
+	return traceQuery(t.context(ctx), t.tracer, query, func(ctx context.Context) error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+// Commit commits the transaction and ends its span
+func (t *Tx) Commit() error {
+	err := t.Tx.Commit()
+	t.end("commit", err)
+	return err
+}
+
+// Rollback rolls back the transaction and ends its span
+func (t *Tx) Rollback() error {
+	err := t.Tx.Rollback()
+	t.end("rollback", err)
+	return err
+}
+
+// context parents statement spans under the transaction span
+func (t *Tx) context(ctx context.Context) context.Context {
+	return trace.ContextWithSpan(ctx, t.span)
+}
+
+func (t *Tx) end(outcome string, err error) {
+	t.span.SetAttributes(attribute.String("db.transaction.outcome", outcome))
+	if err != nil {
+		t.span.RecordError(err)
+		t.span.SetStatus(codes.Error, err.Error())
+	}
+	t.span.End()
+}
+
+func traceQuery(ctx context.Context, tracer trace.Tracer, query string, fn func(context.Context) error) error {
+	operation := operationName(query)
+	ctx, span := tracer.Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(query),
+		),
+	)
+	defer span.End()
+
+	err := fn(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// operationName returns the SQL verb of query
+func operationName(query string) string {
+	verb, _, _ := strings.Cut(strings.TrimSpace(query), " ")
+	return strings.ToUpper(verb)
+}
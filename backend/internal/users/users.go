@@ -0,0 +1,38 @@
+// Package users looks up user accounts independently of the transport they
+// are requested over
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/slowtyper/poolie/backend/ent"
+)
+
+// ErrNotFound is returned for users that do not exist
+var ErrNotFound = errors.New("users: user not found")
+
+// Service looks up users
+type Service struct {
+	db *ent.Client
+}
+
+// NewService creates a new users Service
+func NewService(db *ent.Client) *Service {
+	return &Service{
+		db: db,
+	}
+}
+
+// Get returns a user by ID
+func (s *Service) Get(ctx context.Context, id string) (*ent.User, error) {
+	u, err := s.db.User.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return u, nil
+}
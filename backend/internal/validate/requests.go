@@ -0,0 +1,118 @@
+package validate
+
+import (
+	"time"
+
+	"github.com/slowtyper/poolie/backend/internal/cancellation"
+	"github.com/slowtyper/poolie/backend/internal/models"
+)
+
+const (
+	// maxRideSeats bounds the seats a carpool ride can offer
+	maxRideSeats = 8
+	// maxMessageLength bounds free-text messages and reasons
+	maxMessageLength = 500
+	// maxDescriptionLength bounds ride descriptions
+	maxDescriptionLength = 2000
+	// maxNameLength bounds short text fields stored as VARCHAR(255)
+	maxNameLength = 255
+)
+
+var weekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// SearchRides checks a ride search and returns the parsed date.
+// The currency must already be normalized.
+func SearchRides(req *models.SearchRidesRequest) (time.Time, error) {
+	v := New()
+	v.Required("origin", req.Origin)
+	v.Required("destination", req.Destination)
+	date, _ := v.Date("date", req.Date)
+	v.Range("passengers", req.Passengers, 0, maxRideSeats)
+	if req.Type != "" {
+		v.OneOf("type", req.Type, "all", "carpool", "bus")
+	}
+	v.Currency("currency", req.Currency)
+	return date, v.Err()
+}
+
+// CreateRide checks a new ride. Defaults and normalization must
+// already be applied.
+func CreateRide(req *models.CreateRideRequest, now time.Time) error {
+	v := New()
+
+	if v.OneOf("ride_type", req.RideType, "one_time", "recurring") && req.RideType == "recurring" {
+		if v.Check(req.Recurrence != nil, "recurrence", "is required for recurring rides") {
+			recurrence(v, req.Recurrence)
+		}
+	}
+
+	location(v, "origin", req.Origin)
+	location(v, "destination", req.Destination)
+
+	if v.Future("departure_time", req.DepartureTime, now) && req.ArrivalTime != nil {
+		// duration_minutes is stored in whole minutes and must be positive
+		v.Check(req.ArrivalTime.Sub(req.DepartureTime) >= time.Minute, "arrival_time",
+			"must be at least one minute after departure_time")
+	}
+
+	v.Range("available_seats", req.AvailableSeats, 1, maxRideSeats)
+	v.Positive("price_per_seat.amount", req.PricePerSeat.Amount)
+	v.Currency("price_per_seat.currency", req.PricePerSeat.Currency)
+	v.MaxLength("description", req.Description, maxDescriptionLength)
+	v.OneOf("cancellation_policy", req.CancellationPolicy, cancellation.Names()...)
+
+	return v.Err()
+}
+
+// location checks a ride origin or destination
+func location(v *Validator, field string, loc models.Location) {
+	if v.Required(field+".city", loc.City) {
+		v.MaxLength(field+".city", loc.City, maxNameLength)
+	}
+	v.Required(field+".address", loc.Address)
+	v.MaxLength(field+".location_point", loc.LocationPoint, maxNameLength)
+}
+
+// recurrence checks the schedule of a recurring ride
+func recurrence(v *Validator, r *models.Recurrence) {
+	if v.Check(len(r.DaysOfWeek) > 0, "recurrence.days_of_week", "must list at least one day") {
+		for _, day := range r.DaysOfWeek {
+			if !v.OneOf("recurrence.days_of_week", day, weekdays...) {
+				break
+			}
+		}
+	}
+
+	start, startOK := v.Date("recurrence.start_date", r.StartDate)
+	end, endOK := v.Date("recurrence.end_date", r.EndDate)
+	if startOK && endOK {
+		v.Check(!end.Before(start), "recurrence.end_date", "must not be before start_date")
+	}
+}
+
+// CreateBooking checks a booking request. The display currency
+// must already be normalized.
+func CreateBooking(req *models.CreateBookingRequest) error {
+	v := New()
+	v.Required("ride_id", req.RideID)
+	v.Range("passenger_count", req.PassengerCount, 1, maxRideSeats)
+	v.MaxLength("message", req.Message, maxMessageLength)
+	v.MaxLength("promo_code", req.PromoCode, maxNameLength)
+	v.Currency("display_currency", req.DisplayCurrency)
+	return v.Err()
+}
+
+// RespondToBooking checks a driver's response to a booking
+func RespondToBooking(req *models.RespondToBookingRequest) error {
+	v := New()
+	v.OneOf("action", req.Action, "accept", "reject")
+	v.MaxLength("message", req.Message, maxMessageLength)
+	return v.Err()
+}
+
+// CancelBooking checks a cancellation
+func CancelBooking(req *models.CancelBookingRequest) error {
+	v := New()
+	v.MaxLength("reason", req.Reason, maxMessageLength)
+	return v.Err()
+}
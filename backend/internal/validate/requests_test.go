@@ -0,0 +1,244 @@
+package validate_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/models"
+	"github.com/slowtyper/poolie/backend/internal/validate"
+)
+
+func TestSearchRides(t *testing.T) {
+	valid := func() models.SearchRidesRequest {
+		return models.SearchRidesRequest{Origin: "Jakarta", Destination: "Bandung", Date: "2025-11-03"}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(r *models.SearchRidesRequest)
+		want   []apperr.FieldError
+	}{
+		{name: "valid", modify: func(r *models.SearchRidesRequest) {}},
+		{
+			name: "all filters",
+			modify: func(r *models.SearchRidesRequest) {
+				r.Passengers = 8
+				r.Type = "bus"
+				r.Currency = "USD"
+			},
+		},
+		{
+			name:   "missing route and date",
+			modify: func(r *models.SearchRidesRequest) { *r = models.SearchRidesRequest{} },
+			want: []apperr.FieldError{
+				{Field: "origin", Message: "is required"},
+				{Field: "destination", Message: "is required"},
+				{Field: "date", Message: "is required"},
+			},
+		},
+		{
+			name: "invalid filters",
+			modify: func(r *models.SearchRidesRequest) {
+				r.Date = "2025-13-01"
+				r.Passengers = 9
+				r.Type = "train"
+				r.Currency = "XYZ"
+			},
+			want: []apperr.FieldError{
+				{Field: "date", Message: "must be a date in YYYY-MM-DD format"},
+				{Field: "passengers", Message: "must be between 0 and 8"},
+				{Field: "type", Message: "must be one of: all, carpool, bus"},
+				{Field: "currency", Message: "must be an ISO 4217 currency code"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(&req)
+			date, err := validate.SearchRides(&req)
+			if got := failures(t, err); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("failures = %+v, want %+v", got, tt.want)
+			}
+			if err == nil && date.Format(validate.DateLayout) != req.Date {
+				t.Errorf("date = %s, want %s", date, req.Date)
+			}
+		})
+	}
+}
+
+func TestCreateRide(t *testing.T) {
+	now := time.Date(2025, 11, 1, 9, 0, 0, 0, time.UTC)
+	departure := now.Add(48 * time.Hour)
+	valid := func() models.CreateRideRequest {
+		return models.CreateRideRequest{
+			RideType:           "one_time",
+			Origin:             models.Location{City: "Jakarta", Address: "Jl. Sudirman No. 5"},
+			Destination:        models.Location{City: "Bandung", Address: "Jl. Braga No. 10"},
+			DepartureTime:      departure,
+			AvailableSeats:     3,
+			PricePerSeat:       models.Price{Amount: 120000, Currency: "IDR"},
+			CancellationPolicy: "moderate",
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(r *models.CreateRideRequest)
+		want   []apperr.FieldError
+	}{
+		{name: "valid", modify: func(r *models.CreateRideRequest) {}},
+		{
+			name: "recurring",
+			modify: func(r *models.CreateRideRequest) {
+				r.RideType = "recurring"
+				r.Recurrence = &models.Recurrence{DaysOfWeek: []string{"monday", "friday"}, StartDate: "2025-11-03", EndDate: "2025-11-03"}
+			},
+		},
+		{
+			name: "nested fields",
+			modify: func(r *models.CreateRideRequest) {
+				r.Origin = models.Location{City: strings.Repeat("x", 256), LocationPoint: strings.Repeat("x", 256)}
+				r.Destination.City = " "
+			},
+			want: []apperr.FieldError{
+				{Field: "origin.city", Message: "must be at most 255 characters"},
+				{Field: "origin.address", Message: "is required"},
+				{Field: "origin.location_point", Message: "must be at most 255 characters"},
+				{Field: "destination.city", Message: "is required"},
+			},
+		},
+		{
+			name:   "recurring without recurrence",
+			modify: func(r *models.CreateRideRequest) { r.RideType = "recurring" },
+			want:   []apperr.FieldError{{Field: "recurrence", Message: "is required for recurring rides"}},
+		},
+		{
+			name: "invalid recurrence",
+			modify: func(r *models.CreateRideRequest) {
+				r.RideType = "recurring"
+				r.Recurrence = &models.Recurrence{DaysOfWeek: []string{"monday", "funday", "someday"}, StartDate: "2025-11-10", EndDate: "2025-11-03"}
+			},
+			want: []apperr.FieldError{
+				{Field: "recurrence.days_of_week", Message: "must be one of: monday, tuesday, wednesday, thursday, friday, saturday, sunday"},
+				{Field: "recurrence.end_date", Message: "must not be before start_date"},
+			},
+		},
+		{
+			name: "no days",
+			modify: func(r *models.CreateRideRequest) {
+				r.RideType = "recurring"
+				r.Recurrence = &models.Recurrence{StartDate: "2025-11-03", EndDate: "someday"}
+			},
+			want: []apperr.FieldError{
+				{Field: "recurrence.days_of_week", Message: "must list at least one day"},
+				{Field: "recurrence.end_date", Message: "must be a date in YYYY-MM-DD format"},
+			},
+		},
+		{
+			name: "schedule",
+			modify: func(r *models.CreateRideRequest) {
+				arrival := departure.Add(30 * time.Second)
+				r.ArrivalTime = &arrival
+			},
+			want: []apperr.FieldError{{Field: "arrival_time", Message: "must be at least one minute after departure_time"}},
+		},
+		{
+			name:   "departed",
+			modify: func(r *models.CreateRideRequest) { r.DepartureTime = now },
+			want:   []apperr.FieldError{{Field: "departure_time", Message: "must be in the future"}},
+		},
+		{
+			name: "offer",
+			modify: func(r *models.CreateRideRequest) {
+				r.RideType = "daily"
+				r.AvailableSeats = 9
+				r.PricePerSeat = models.Price{Amount: 0, Currency: "XYZ"}
+				r.Description = strings.Repeat("x", 2001)
+				r.CancellationPolicy = "lenient"
+			},
+			want: []apperr.FieldError{
+				{Field: "ride_type", Message: "must be one of: one_time, recurring"},
+				{Field: "available_seats", Message: "must be between 1 and 8"},
+				{Field: "price_per_seat.amount", Message: "must be greater than 0"},
+				{Field: "price_per_seat.currency", Message: "must be an ISO 4217 currency code"},
+				{Field: "description", Message: "must be at most 2000 characters"},
+				{Field: "cancellation_policy", Message: "must be one of: flexible, moderate, strict"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid()
+			tt.modify(&req)
+			if got := failures(t, validate.CreateRide(&req, now)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("failures = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBookingRequests(t *testing.T) {
+	long := strings.Repeat("x", 501)
+
+	tests := []struct {
+		name string
+		err  error
+		want []apperr.FieldError
+	}{
+		{
+			name: "create",
+			err:  validate.CreateBooking(&models.CreateBookingRequest{RideID: "ride_1", PassengerCount: 1, DisplayCurrency: "USD"}),
+		},
+		{
+			name: "create invalid",
+			err: validate.CreateBooking(&models.CreateBookingRequest{
+				PassengerCount:  0,
+				Message:         long,
+				PromoCode:       strings.Repeat("X", 256),
+				DisplayCurrency: "XYZ",
+			}),
+			want: []apperr.FieldError{
+				{Field: "ride_id", Message: "is required"},
+				{Field: "passenger_count", Message: "must be between 1 and 8"},
+				{Field: "message", Message: "must be at most 500 characters"},
+				{Field: "promo_code", Message: "must be at most 255 characters"},
+				{Field: "display_currency", Message: "must be an ISO 4217 currency code"},
+			},
+		},
+		{
+			name: "respond",
+			err:  validate.RespondToBooking(&models.RespondToBookingRequest{Action: "reject", Message: "Sorry"}),
+		},
+		{
+			name: "respond invalid",
+			err:  validate.RespondToBooking(&models.RespondToBookingRequest{Action: "maybe", Message: long}),
+			want: []apperr.FieldError{
+				{Field: "action", Message: "must be one of: accept, reject"},
+				{Field: "message", Message: "must be at most 500 characters"},
+			},
+		},
+		{
+			name: "cancel",
+			err:  validate.CancelBooking(&models.CancelBookingRequest{Reason: "Plans changed"}),
+		},
+		{
+			name: "cancel invalid",
+			err:  validate.CancelBooking(&models.CancelBookingRequest{Reason: long}),
+			want: []apperr.FieldError{{Field: "reason", Message: "must be at most 500 characters"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := failures(t, tt.err); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("failures = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Package validate collects field-level validation failures for API requests
+package validate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/currency"
+)
+
+// DateLayout is the format of date-only request fields
+const DateLayout = "2006-01-02"
+
+// Validator accumulates failures so a single response can list every
+// failing field. Field names are the JSON or query names clients send,
+// with nested fields joined by dots, e.g. "origin.city".
+type Validator struct {
+	fields []apperr.FieldError
+}
+
+// New creates an empty Validator
+func New() *Validator {
+	return &Validator{}
+}
+
+// Fail records a failure for field
+func (v *Validator) Fail(field, message string) {
+	v.fields = append(v.fields, apperr.FieldError{Field: field, Message: message})
+}
+
+// Check records a failure for field unless ok holds, and reports ok
+func (v *Validator) Check(ok bool, field, message string) bool {
+	if !ok {
+		v.Fail(field, message)
+	}
+	return ok
+}
+
+// Err returns a VALIDATION_FAILED error listing every failure, or nil
+func (v *Validator) Err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return apperr.Validation(v.fields...)
+}
+
+// Required checks that value is not blank
+func (v *Validator) Required(field, value string) bool {
+	return v.Check(strings.TrimSpace(value) != "", field, "is required")
+}
+
+// MaxLength checks that value has at most max characters
+func (v *Validator) MaxLength(field, value string, max int) bool {
+	return v.Check(utf8.RuneCountInString(value) <= max, field,
+		fmt.Sprintf("must be at most %d characters", max))
+}
+
+// Range checks that min <= value <= max
+func (v *Validator) Range(field string, value, min, max int) bool {
+	return v.Check(value >= min && value <= max, field,
+		fmt.Sprintf("must be between %d and %d", min, max))
+}
+
+// Positive checks that value is greater than zero
+func (v *Validator) Positive(field string, value int64) bool {
+	return v.Check(value > 0, field, "must be greater than 0")
+}
+
+// OneOf checks that value is one of allowed
+func (v *Validator) OneOf(field, value string, allowed ...string) bool {
+	return v.Check(slices.Contains(allowed, value), field,
+		"must be one of: "+strings.Join(allowed, ", "))
+}
+
+// Currency checks that a non-empty value is an ISO 4217 currency code
+func (v *Validator) Currency(field, value string) bool {
+	if value == "" {
+		return true
+	}
+	return v.Check(currency.Validate(value) == nil, field, "must be an ISO 4217 currency code")
+}
+
+// Date parses a required YYYY-MM-DD value
+func (v *Validator) Date(field, value string) (time.Time, bool) {
+	if !v.Required(field, value) {
+		return time.Time{}, false
+	}
+	date, err := time.Parse(DateLayout, value)
+	return date, v.Check(err == nil, field, "must be a date in YYYY-MM-DD format")
+}
+
+// Future checks that a required timestamp is after now
+func (v *Validator) Future(field string, value, now time.Time) bool {
+	if !v.Check(!value.IsZero(), field, "is required") {
+		return false
+	}
+	return v.Check(value.After(now), field, "must be in the future")
+}
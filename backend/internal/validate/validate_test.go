@@ -0,0 +1,101 @@
+package validate_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/slowtyper/poolie/backend/internal/apperr"
+	"github.com/slowtyper/poolie/backend/internal/validate"
+)
+
+// failures returns the fields listed by a validation error
+func failures(t *testing.T, err error) []apperr.FieldError {
+	t.Helper()
+
+	if err == nil {
+		return nil
+	}
+	var e *apperr.Error
+	if !errors.As(err, &e) || e.Code != "VALIDATION_FAILED" {
+		t.Fatalf("error = %v, want a validation error", err)
+	}
+	details, ok := e.Details.(apperr.ValidationDetails)
+	if !ok {
+		t.Fatalf("details = %#v, want ValidationDetails", e.Details)
+	}
+	return details.Fields
+}
+
+func TestRules(t *testing.T) {
+	now := time.Date(2025, 11, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		check       func(v *validate.Validator) bool
+		wantMessage string
+	}{
+		{name: "required", check: func(v *validate.Validator) bool { return v.Required("f", "Jakarta") }},
+		{name: "required blank", check: func(v *validate.Validator) bool { return v.Required("f", "  ") }, wantMessage: "is required"},
+		{name: "max length", check: func(v *validate.Validator) bool { return v.MaxLength("f", "abc", 3) }},
+		{name: "max length counts characters", check: func(v *validate.Validator) bool { return v.MaxLength("f", "Żółw", 4) }},
+		{name: "max length exceeded", check: func(v *validate.Validator) bool { return v.MaxLength("f", "abcd", 3) }, wantMessage: "must be at most 3 characters"},
+		{name: "range lower bound", check: func(v *validate.Validator) bool { return v.Range("f", 1, 1, 8) }},
+		{name: "range upper bound", check: func(v *validate.Validator) bool { return v.Range("f", 8, 1, 8) }},
+		{name: "range below", check: func(v *validate.Validator) bool { return v.Range("f", 0, 1, 8) }, wantMessage: "must be between 1 and 8"},
+		{name: "range above", check: func(v *validate.Validator) bool { return v.Range("f", 9, 1, 8) }, wantMessage: "must be between 1 and 8"},
+		{name: "positive", check: func(v *validate.Validator) bool { return v.Positive("f", 1) }},
+		{name: "positive zero", check: func(v *validate.Validator) bool { return v.Positive("f", 0) }, wantMessage: "must be greater than 0"},
+		{name: "one of", check: func(v *validate.Validator) bool { return v.OneOf("f", "bus", "carpool", "bus") }},
+		{name: "one of other", check: func(v *validate.Validator) bool { return v.OneOf("f", "train", "carpool", "bus") }, wantMessage: "must be one of: carpool, bus"},
+		{name: "currency", check: func(v *validate.Validator) bool { return v.Currency("f", "IDR") }},
+		{name: "currency empty", check: func(v *validate.Validator) bool { return v.Currency("f", "") }},
+		{name: "currency unknown", check: func(v *validate.Validator) bool { return v.Currency("f", "XYZ") }, wantMessage: "must be an ISO 4217 currency code"},
+		{name: "date", check: func(v *validate.Validator) bool { _, ok := v.Date("f", "2025-11-01"); return ok }},
+		{name: "date missing", check: func(v *validate.Validator) bool { _, ok := v.Date("f", ""); return ok }, wantMessage: "is required"},
+		{name: "date malformed", check: func(v *validate.Validator) bool { _, ok := v.Date("f", "01/11/2025"); return ok }, wantMessage: "must be a date in YYYY-MM-DD format"},
+		{name: "future", check: func(v *validate.Validator) bool { return v.Future("f", now.Add(time.Minute), now) }},
+		{name: "future missing", check: func(v *validate.Validator) bool { return v.Future("f", time.Time{}, now) }, wantMessage: "is required"},
+		{name: "future now", check: func(v *validate.Validator) bool { return v.Future("f", now, now) }, wantMessage: "must be in the future"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := validate.New()
+			ok := tt.check(v)
+			if want := tt.wantMessage == ""; ok != want {
+				t.Errorf("check = %v, want %v", ok, want)
+			}
+
+			var want []apperr.FieldError
+			if tt.wantMessage != "" {
+				want = []apperr.FieldError{{Field: "f", Message: tt.wantMessage}}
+			}
+			if got := failures(t, v.Err()); !reflect.DeepEqual(got, want) {
+				t.Errorf("failures = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestValidatorListsEveryFailure(t *testing.T) {
+	v := validate.New()
+	v.Required("origin", "")
+	v.Range("passengers", 9, 0, 8)
+	v.MaxLength("message", strings.Repeat("x", 4), 3)
+
+	want := []apperr.FieldError{
+		{Field: "origin", Message: "is required"},
+		{Field: "passengers", Message: "must be between 0 and 8"},
+		{Field: "message", Message: "must be at most 3 characters"},
+	}
+	if got := failures(t, v.Err()); !reflect.DeepEqual(got, want) {
+		t.Errorf("failures = %+v, want %+v", got, want)
+	}
+	if status := apperr.Status(v.Err()); status != fiber.StatusBadRequest {
+		t.Errorf("status = %d, want %d", status, fiber.StatusBadRequest)
+	}
+}
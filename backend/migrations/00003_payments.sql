@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Create payments table
+CREATE TABLE IF NOT EXISTS payments (
+    id VARCHAR(255) PRIMARY KEY,
+    booking_id VARCHAR(255) UNIQUE NOT NULL,
+    provider VARCHAR(50) NOT NULL,
+    provider_intent_id VARCHAR(255) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency VARCHAR(10) DEFAULT 'IDR',
+    status VARCHAR(50) DEFAULT 'requires_capture',
+    captured_at TIMESTAMP,
+    voided_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (booking_id) REFERENCES bookings(id) ON DELETE CASCADE,
+    UNIQUE (provider, provider_intent_id)
+);
+
+-- Create payment_events table for idempotent webhook handling
+CREATE TABLE IF NOT EXISTS payment_events (
+    id VARCHAR(255) PRIMARY KEY,
+    provider VARCHAR(50) NOT NULL,
+    type VARCHAR(100) NOT NULL,
+    provider_intent_id VARCHAR(255) NOT NULL,
+    processed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_payments_status ON payments(status);
+
+CREATE TRIGGER update_payments_updated_at BEFORE UPDATE ON payments
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS update_payments_updated_at ON payments;
+DROP INDEX IF EXISTS idx_payments_status;
+DROP TABLE IF EXISTS payment_events;
+DROP TABLE IF EXISTS payments;
+-- +goose StatementEnd
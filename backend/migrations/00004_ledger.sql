@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Create ledger_entries table; entries sharing a transaction_id sum to zero
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id VARCHAR(255) PRIMARY KEY,
+    transaction_id VARCHAR(255) NOT NULL,
+    kind VARCHAR(50) NOT NULL,
+    account VARCHAR(50) NOT NULL,
+    user_id VARCHAR(255),
+    booking_id VARCHAR(255),
+    payout_id VARCHAR(255),
+    amount BIGINT NOT NULL,
+    currency VARCHAR(10) DEFAULT 'IDR',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create payouts table
+CREATE TABLE IF NOT EXISTS payouts (
+    id VARCHAR(255) PRIMARY KEY,
+    driver_id VARCHAR(255) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency VARCHAR(10) DEFAULT 'IDR',
+    status VARCHAR(50) DEFAULT 'pending',
+    provider VARCHAR(50) NOT NULL,
+    provider_reference VARCHAR(255),
+    failure_reason TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_account ON ledger_entries(account, user_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_transaction ON ledger_entries(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_ledger_entries_booking ON ledger_entries(booking_id);
+CREATE INDEX IF NOT EXISTS idx_payouts_driver ON payouts(driver_id);
+CREATE INDEX IF NOT EXISTS idx_payouts_status ON payouts(status);
+
+CREATE TRIGGER update_payouts_updated_at BEFORE UPDATE ON payouts
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS update_payouts_updated_at ON payouts;
+DROP INDEX IF EXISTS idx_payouts_status;
+DROP INDEX IF EXISTS idx_payouts_driver;
+DROP INDEX IF EXISTS idx_ledger_entries_booking;
+DROP INDEX IF EXISTS idx_ledger_entries_transaction;
+DROP INDEX IF EXISTS idx_ledger_entries_account;
+DROP TABLE IF EXISTS payouts;
+DROP TABLE IF EXISTS ledger_entries;
+-- +goose StatementEnd
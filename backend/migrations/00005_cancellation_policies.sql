@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Replace the placeholder cancellation policy with the named policies
+ALTER TABLE rides ALTER COLUMN cancellation_policy SET DEFAULT 'moderate';
+UPDATE rides SET cancellation_policy = 'moderate'
+    WHERE cancellation_policy NOT IN ('flexible', 'moderate', 'strict');
+
+-- Record cancellation outcome on bookings
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMP;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS cancelled_by VARCHAR(50);
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS cancellation_reason TEXT;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS refund_amount BIGINT DEFAULT 0 CHECK (refund_amount >= 0);
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS penalty_amount BIGINT DEFAULT 0 CHECK (penalty_amount >= 0);
+
+-- Track refunds against captured payments
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS refunded_amount BIGINT DEFAULT 0 CHECK (refunded_amount >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE payments DROP COLUMN IF EXISTS refunded_amount;
+ALTER TABLE bookings DROP COLUMN IF EXISTS penalty_amount;
+ALTER TABLE bookings DROP COLUMN IF EXISTS refund_amount;
+ALTER TABLE bookings DROP COLUMN IF EXISTS cancellation_reason;
+ALTER TABLE bookings DROP COLUMN IF EXISTS cancelled_by;
+ALTER TABLE bookings DROP COLUMN IF EXISTS cancelled_at;
+ALTER TABLE rides ALTER COLUMN cancellation_policy SET DEFAULT 'never_cancels';
+-- +goose StatementEnd
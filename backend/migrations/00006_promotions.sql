@@ -0,0 +1,92 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Create promo_codes table
+CREATE TABLE IF NOT EXISTS promo_codes (
+    id VARCHAR(255) PRIMARY KEY,
+    code VARCHAR(100) UNIQUE NOT NULL,
+    description TEXT,
+    discount_type VARCHAR(50) DEFAULT 'percentage',
+    discount_value BIGINT NOT NULL CHECK (discount_value > 0),
+    max_discount BIGINT CHECK (max_discount > 0),
+    currency VARCHAR(10) DEFAULT 'IDR',
+    min_spend BIGINT DEFAULT 0 CHECK (min_spend >= 0),
+    max_uses INTEGER CHECK (max_uses > 0),
+    max_uses_per_user INTEGER CHECK (max_uses_per_user > 0),
+    used_count INTEGER DEFAULT 0 CHECK (used_count >= 0),
+    valid_from TIMESTAMP,
+    valid_until TIMESTAMP,
+    origin_city VARCHAR(255),
+    destination_city VARCHAR(255),
+    ride_type VARCHAR(50),
+    active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create promo_redemptions table
+CREATE TABLE IF NOT EXISTS promo_redemptions (
+    id VARCHAR(255) PRIMARY KEY,
+    promo_code_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    booking_id VARCHAR(255) UNIQUE NOT NULL,
+    discount_amount BIGINT NOT NULL CHECK (discount_amount >= 0),
+    currency VARCHAR(10) DEFAULT 'IDR',
+    released_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (promo_code_id) REFERENCES promo_codes(id) ON DELETE CASCADE
+);
+
+-- Create credits table; a user's balance is the sum of their rows
+CREATE TABLE IF NOT EXISTS credits (
+    id VARCHAR(255) PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL,
+    kind VARCHAR(50) NOT NULL,
+    amount BIGINT NOT NULL,
+    currency VARCHAR(10) DEFAULT 'IDR',
+    source_user_id VARCHAR(255),
+    booking_id VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Referral attribution
+ALTER TABLE users ADD COLUMN IF NOT EXISTS referral_code VARCHAR(100) UNIQUE;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS referred_by VARCHAR(255);
+
+-- Price breakdown on bookings; fully discounted bookings may be free
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS subtotal_amount BIGINT DEFAULT 0 CHECK (subtotal_amount >= 0);
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS promo_code VARCHAR(100);
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS discount_amount BIGINT DEFAULT 0 CHECK (discount_amount >= 0);
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS credit_amount BIGINT DEFAULT 0 CHECK (credit_amount >= 0);
+UPDATE bookings SET subtotal_amount = total_price_amount WHERE subtotal_amount = 0;
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_total_price_amount_check;
+ALTER TABLE bookings ADD CONSTRAINT bookings_total_price_amount_check CHECK (total_price_amount >= 0);
+
+CREATE INDEX IF NOT EXISTS idx_promo_redemptions_code_user ON promo_redemptions(promo_code_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_credits_user ON credits(user_id, currency);
+CREATE INDEX IF NOT EXISTS idx_credits_booking ON credits(booking_id);
+CREATE INDEX IF NOT EXISTS idx_credits_source ON credits(kind, source_user_id);
+
+CREATE TRIGGER update_promo_codes_updated_at BEFORE UPDATE ON promo_codes
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS update_promo_codes_updated_at ON promo_codes;
+DROP INDEX IF EXISTS idx_credits_source;
+DROP INDEX IF EXISTS idx_credits_booking;
+DROP INDEX IF EXISTS idx_credits_user;
+DROP INDEX IF EXISTS idx_promo_redemptions_code_user;
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_total_price_amount_check;
+ALTER TABLE bookings ADD CONSTRAINT bookings_total_price_amount_check CHECK (total_price_amount > 0);
+ALTER TABLE bookings DROP COLUMN IF EXISTS credit_amount;
+ALTER TABLE bookings DROP COLUMN IF EXISTS discount_amount;
+ALTER TABLE bookings DROP COLUMN IF EXISTS promo_code;
+ALTER TABLE bookings DROP COLUMN IF EXISTS subtotal_amount;
+ALTER TABLE users DROP COLUMN IF EXISTS referred_by;
+ALTER TABLE users DROP COLUMN IF EXISTS referral_code;
+DROP TABLE IF EXISTS credits;
+DROP TABLE IF EXISTS promo_redemptions;
+DROP TABLE IF EXISTS promo_codes;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Rides created without a currency were priced in rupiah
+UPDATE rides SET price_currency = 'IDR' WHERE price_currency IS NULL OR price_currency = '';
+UPDATE bookings SET total_price_currency = 'IDR' WHERE total_price_currency IS NULL OR total_price_currency = '';
+
+-- Exchange rate snapshot for bookings made with a display currency
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS display_currency VARCHAR(10);
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS display_total_amount BIGINT;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS exchange_rate VARCHAR(50);
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS exchange_rate_as_of TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bookings DROP COLUMN IF EXISTS exchange_rate_as_of;
+ALTER TABLE bookings DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE bookings DROP COLUMN IF EXISTS display_total_amount;
+ALTER TABLE bookings DROP COLUMN IF EXISTS display_currency;
+-- +goose StatementEnd
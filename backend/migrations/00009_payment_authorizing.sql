@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Payments are recorded as authorizing before the provider creates their
+-- intent, so the intent ID starts out unset
+ALTER TABLE payments ALTER COLUMN provider_intent_id DROP NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM payments WHERE provider_intent_id IS NULL;
+ALTER TABLE payments ALTER COLUMN provider_intent_id SET NOT NULL;
+-- +goose StatementEnd
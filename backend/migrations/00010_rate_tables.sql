@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Exchange rate tables set through the admin API. Every server converts
+-- with the newest one, which takes precedence over the rates file.
+CREATE TABLE IF NOT EXISTS rate_tables (
+    id VARCHAR(255) PRIMARY KEY,
+    base VARCHAR(10) NOT NULL,
+    rates JSONB NOT NULL,
+    as_of TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_tables_created_at ON rate_tables(created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_rate_tables_created_at;
+DROP TABLE IF EXISTS rate_tables;
+-- +goose StatementEnd
@@ -23,17 +23,9 @@ docker-compose up -d postgres
 echo "⏳ Waiting for PostgreSQL to be ready..."
 sleep 5
 
-# Run migrations
+# Run migrations (the server retries while PostgreSQL finishes starting)
 echo "🔄 Running database migrations..."
-export DATABASE_URL="host=localhost port=5432 user=postgres password=postgres dbname=poolie sslmode=disable"
-
-# Check if goose is installed
-if ! command -v goose &> /dev/null; then
-    echo "📥 Installing goose..."
-    go install github.com/pressly/goose/v3/cmd/goose@latest
-fi
-
-goose -dir migrations postgres "$DATABASE_URL" up
+go run ./cmd/server migrate up
 
 echo "✅ Setup complete!"
 echo ""
@@ -41,7 +33,7 @@ echo "To run the server:"
 echo "  make run"
 echo ""
 echo "Or:"
-echo "  go run cmd/server/main.go"
+echo "  go run ./cmd/server"
 echo ""
 echo "API will be available at: http://localhost:8080"
 echo "Health check: http://localhost:8080/v1/health"